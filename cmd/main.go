@@ -18,7 +18,9 @@ import (
 
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/config"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/logging"
 )
@@ -36,7 +38,14 @@ func run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
-	obs, err := initObservability(ctx)
+	platform, err := config.LoadPlatform()
+	if err != nil {
+		slog.Error("failed to load platform configuration", slog.String("error", err.Error()))
+
+		return err
+	}
+
+	obs, err := initObservability(ctx, platform)
 	if err != nil {
 		slog.Error("failed to initialize observability", slog.String("error", err.Error()))
 
@@ -74,6 +83,67 @@ func run() error {
 		return err
 	}
 
+	// Custom task types must be registered before the policies below look
+	// them up.
+	if err := registerTaskTypes(cfg.TaskTypes); err != nil {
+		slog.ErrorContext(ctx, "task type configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	rateLimitPolicy, err := newRateLimitPolicy(cfg.RateLimit)
+	if err != nil {
+		slog.ErrorContext(ctx, "rate limit configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	windowPolicy, err := newWindowPolicy(cfg.Window)
+	if err != nil {
+		slog.ErrorContext(ctx, "window policy configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	densityPolicy, err := newDensityPolicy(cfg.Density)
+	if err != nil {
+		slog.ErrorContext(ctx, "density configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	timePolicy, err := newTimeNormalizationPolicy(cfg.Times)
+	if err != nil {
+		slog.ErrorContext(ctx, "remind times configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
+	adaptivePolicy, err := newAdaptiveWindowPolicy(cfg.Adaptive)
+	if err != nil {
+		slog.ErrorContext(ctx, "adaptive window configuration error",
+			slog.String("event", "config.validate.fail"),
+			slog.String("error", err.Error()),
+		)
+
+		return err
+	}
+
 	// Create cancellable context for cleanup
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -128,13 +198,71 @@ func run() error {
 	}
 	defer closePublisher()
 
-	// Create repository, use case, and handler
+	// Create repositories, use cases, and handlers
 	remindRepo := repository.NewRemindRepository(db)
-	remindUseCase := app.NewRemindUseCase(remindRepo, publisher)
+	prefsRepo := repository.NewUserPreferencesRepository(db)
+	templateRepo := repository.NewRemindTemplateRepository(db)
+
+	var statsRepo domain.WindowStatsRepository
+	if adaptivePolicy != nil {
+		statsRepo = repository.NewWindowStatsRepository(db)
+	}
+
+	remindUseCase := app.NewRemindUseCase(
+		remindRepo,
+		prefsRepo,
+		templateRepo,
+		rateLimitPolicy,
+		windowPolicy,
+		densityPolicy,
+		timePolicy,
+		adaptivePolicy,
+		statsRepo,
+		publisher,
+	)
 	remindHandler := handler.NewRemindHandler(remindUseCase)
+	transactor := repository.NewTransactor(db)
+	prefsUseCase := app.NewUserPreferencesUseCase(transactor, prefsRepo)
+	prefsHandler := handler.NewUserPreferencesHandler(prefsUseCase)
+	pauseUseCase := app.NewPauseUseCase(transactor, prefsRepo, publisher)
+	pauseHandler := handler.NewPauseHandler(pauseUseCase)
+	templateUseCase := app.NewRemindTemplateUseCase(templateRepo)
+	templateHandler := handler.NewRemindTemplateHandler(templateUseCase)
+
+	if cfg.Escalation.Enabled {
+		escalationJob := app.NewEscalationJob(remindUseCase, cfg.Escalation.Interval, cfg.Escalation.BatchSize)
+		go escalationJob.Run(ctx)
+	}
+
+	if cfg.AutoResume.Enabled {
+		autoResumeJob := app.NewAutoResumeJob(pauseUseCase, cfg.AutoResume.Interval, cfg.AutoResume.BatchSize)
+		go autoResumeJob.Run(ctx)
+	}
+
+	if adaptivePolicy != nil {
+		adaptiveJob := app.NewAdaptiveWindowJob(remindUseCase, cfg.Adaptive.Interval, cfg.Adaptive.Lookback)
+		go adaptiveJob.Run(ctx)
+	}
+
+	var backfillHandler *handler.WidthBackfillHandler
+
+	if cfg.Backfill.Enabled {
+		backfillJob := app.NewWidthBackfillJob(
+			remindUseCase,
+			repository.NewWidthBackfillRunRepository(db),
+			cfg.Backfill.BatchSize,
+			cfg.Backfill.MaxChanges,
+			cfg.Backfill.Lease,
+		)
+		go backfillJob.Run(ctx)
+
+		backfillHandler = handler.NewWidthBackfillHandler(backfillJob)
+	}
 
 	// Setup router
-	router := setupRouter(remindHandler)
+	router := setupRouter(remindHandler, prefsHandler, pauseHandler, templateHandler)
+	registerDebugRoutes(router, cfg, publisher)
+	registerAdminRoutes(router, backfillHandler)
 
 	server := &http.Server{
 		Addr:              cfg.Server.Address(),
@@ -207,3 +335,169 @@ func initDatabase(cfg config.DatabaseConfig) (*gorm.DB, error) {
 
 	return db, nil
 }
+
+func eventConfig(cfg *config.Config) (pubsub.EventConfig, error) {
+	mode, err := pubsub.ParseEventMode(cfg.PubSub.EventMode)
+	if err != nil {
+		return pubsub.EventConfig{}, err
+	}
+
+	return pubsub.EventConfig{
+		Mode:   mode,
+		Source: cfg.PubSub.EventSource,
+	}, nil
+}
+
+// newRateLimitPolicy builds the per-task-type rate limits; it returns nil,
+// leaving reminds unlimited, when rate limiting is disabled.
+func newRateLimitPolicy(cfg config.RateLimitConfig) (*domain.RateLimitPolicy, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil
+	}
+
+	limits := make(map[domain.Type]domain.RateLimit, len(cfg.MaxPerTaskType))
+	for name, maxReminds := range cfg.MaxPerTaskType {
+		taskType, err := domain.NewType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		limit, err := domain.NewRateLimit(maxReminds, cfg.Window)
+		if err != nil {
+			return nil, fmt.Errorf("rate limit for %s: %w", name, err)
+		}
+
+		limits[taskType] = limit
+	}
+
+	return domain.NewRateLimitPolicy(limits), nil
+}
+
+// newWindowPolicy builds the configured slide window widths; it returns nil,
+// leaving the built-in widths, when the window policy is disabled.
+func newWindowPolicy(cfg config.WindowPolicyConfig) (domain.WindowPolicy, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil
+	}
+
+	params := make(map[domain.Type]domain.WindowParams, len(cfg.ParamsPerTaskType))
+	for name, p := range cfg.ParamsPerTaskType {
+		taskType, err := domain.NewType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		windowParams, err := domain.NewWindowParams(
+			p.TargetWidth,
+			p.IntermediateRatio,
+			p.IntermediateMinWidth,
+			p.IntermediateMaxWidth,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("window params for %s: %w", name, err)
+		}
+
+		params[taskType] = windowParams
+	}
+
+	return domain.NewParameterizedWindowPolicy(params), nil
+}
+
+// newDensityPolicy builds the density widening policy; it returns nil, leaving
+// widths independent of other reminds, when density widening is disabled.
+func newDensityPolicy(cfg config.DensityConfig) (*domain.DensityPolicy, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil
+	}
+
+	taskTypes := make([]domain.Type, 0, len(cfg.TaskTypes))
+	for _, name := range cfg.TaskTypes {
+		taskType, err := domain.NewType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		taskTypes = append(taskTypes, taskType)
+	}
+
+	return domain.NewDensityPolicy(cfg.HotThreshold, cfg.MaxFactor, taskTypes)
+}
+
+// newTimeNormalizationPolicy builds the normalization of requested remind times.
+func newTimeNormalizationPolicy(cfg config.RemindTimesConfig) (*domain.TimeNormalizationPolicy, error) {
+	minSpacing := make(map[domain.Type]time.Duration, len(cfg.MinSpacingPerTaskType))
+	for name, spacing := range cfg.MinSpacingPerTaskType {
+		taskType, err := domain.NewType(name)
+		if err != nil {
+			return nil, err
+		}
+
+		minSpacing[taskType] = spacing
+	}
+
+	return domain.NewTimeNormalizationPolicy(cfg.Truncation, cfg.MaxTimes, cfg.Horizon, minSpacing)
+}
+
+// newAdaptiveWindowPolicy builds the adaptive window policy; it returns nil,
+// leaving widths independent of delivery history, when it is disabled.
+func newAdaptiveWindowPolicy(cfg config.AdaptiveWindowConfig) (*domain.AdaptiveWindowPolicy, error) {
+	if !cfg.Enabled {
+		return nil, nil //nolint:nilnil
+	}
+
+	return domain.NewAdaptiveWindowPolicy(
+		cfg.MinSamples,
+		cfg.OnTimeThreshold,
+		cfg.CollisionThreshold,
+		cfg.NarrowFactor,
+		cfg.WidenFactor,
+	)
+}
+
+// registerTaskTypes adds the configured task types to the domain registry.
+func registerTaskTypes(cfgs []config.TaskTypeConfig) error {
+	for _, cfg := range cfgs {
+		window, err := domain.NewWindowParams(
+			cfg.Window.TargetWidth,
+			cfg.Window.IntermediateRatio,
+			cfg.Window.IntermediateMinWidth,
+			cfg.Window.IntermediateMaxWidth,
+		)
+		if err != nil {
+			return fmt.Errorf("window params for %s: %w", cfg.Name, err)
+		}
+
+		var escalation domain.EscalationPolicy
+
+		if cfg.EscalationAfter != 0 {
+			steps := make([]domain.EscalationAction, 0, len(cfg.EscalationSteps))
+			for _, step := range cfg.EscalationSteps {
+				steps = append(steps, domain.EscalationAction(step))
+			}
+
+			escalation, err = domain.NewEscalationPolicy(cfg.EscalationAfter, steps)
+			if err != nil {
+				return fmt.Errorf("escalation for %s: %w", cfg.Name, err)
+			}
+		}
+
+		if err := domain.TaskTypes().Register(domain.TaskTypeDefinition{
+			Type:        domain.Type(cfg.Name),
+			EnumValue:   cfg.EnumValue,
+			Window:      window,
+			Escalation:  escalation,
+			Schedule:    cfg.Schedule,
+			QuietHours:  domain.QuietHoursAction(cfg.QuietHours),
+			Acknowledge: domain.AcknowledgeAction(cfg.Acknowledge),
+		}); err != nil {
+			return err
+		}
+
+		slog.Info("task type registered",
+			slog.String("task_type", cfg.Name),
+			slog.Int("enum_value", int(cfg.EnumValue)),
+		)
+	}
+
+	return nil
+}
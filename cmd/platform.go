@@ -0,0 +1,272 @@
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/config"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/logging"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/metrics"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/middleware"
+)
+
+func initPublisher(ctx context.Context, cfg *config.Config) (pubsub.Publisher, error) {
+	eventCfg, err := eventConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	registry := pubsub.NewRegistry()
+	registry.Register(pubsub.BackendNATS, func(ctx context.Context) (pubsub.Publisher, error) {
+		return newNATSPublisher(ctx, cfg, eventCfg)
+	})
+	registry.Register(pubsub.BackendGCloud, func(ctx context.Context) (pubsub.Publisher, error) {
+		return pubsub.NewGCloudPublisher(ctx, pubsub.GCloudPublisherConfig{
+			ProjectID: cfg.PubSub.GCloudProjectID,
+			Event:     eventCfg,
+		})
+	})
+	registry.Register(pubsub.BackendGoChannel, func(_ context.Context) (pubsub.Publisher, error) {
+		return pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{
+			HistorySize: 0,
+			Event:       eventCfg,
+		}), nil
+	})
+
+	if len(cfg.PubSub.Backends) == 1 {
+		return newBackendPublisher(ctx, registry, cfg, cfg.PubSub.Backends[0])
+	}
+
+	targets := make([]pubsub.FanOutTarget, 0, len(cfg.PubSub.Backends))
+
+	closeTargets := func() {
+		for _, target := range targets {
+			if err := target.Publisher.Close(); err != nil {
+				slog.Warn("failed to close publisher", slog.String("backend", target.Name), slog.String("error", err.Error()))
+			}
+		}
+	}
+
+	for _, backend := range cfg.PubSub.Backends {
+		publisher, err := newBackendPublisher(ctx, registry, cfg, backend)
+		if err != nil {
+			closeTargets()
+
+			return nil, err
+		}
+
+		policy := pubsub.FailurePolicyRequired
+		if cfg.PubSub.IsBestEffort(backend) {
+			policy = pubsub.FailurePolicyBestEffort
+		}
+
+		targets = append(targets, pubsub.FanOutTarget{
+			Name:      backend,
+			Publisher: publisher,
+			Policy:    policy,
+		})
+	}
+
+	publishMetrics, err := metrics.NewPublishMetrics()
+	if err != nil {
+		slog.Warn("failed to initialize publish metrics",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+		Targets: targets,
+		Metrics: publishMetrics,
+	})
+	if err != nil {
+		closeTargets()
+
+		return nil, err
+	}
+
+	slog.Info("fan-out publisher initialized",
+		"backends", cfg.PubSub.Backends,
+		"best_effort_backends", cfg.PubSub.BestEffortBackends,
+	)
+
+	return publisher, nil
+}
+
+func newBackendPublisher(ctx context.Context, registry *pubsub.Registry, cfg *config.Config, backend string) (pubsub.Publisher, error) {
+	publisher, err := registry.New(ctx, backend)
+	if err != nil {
+		return nil, err
+	}
+
+	if backend == pubsub.BackendGoChannel {
+		slog.Warn("publishing events to in-memory channel")
+	}
+
+	slog.Info("publisher initialized",
+		"backend", backend,
+		"platform", cfg.Platform.Name,
+	)
+
+	return publisher, nil
+}
+
+func newNATSPublisher(ctx context.Context, cfg *config.Config, eventCfg pubsub.EventConfig) (pubsub.Publisher, error) {
+	streamCfg := cfg.PubSub.NatsStream
+
+	retention, err := pubsub.ParseRetentionPolicy(streamCfg.Retention)
+	if err != nil {
+		return nil, err
+	}
+
+	storage, err := pubsub.ParseStorageType(streamCfg.Storage)
+	if err != nil {
+		return nil, err
+	}
+
+	authCfg := cfg.PubSub.NatsAuth
+
+	return pubsub.NewNATSPublisherWithStream(ctx, pubsub.NATSPublisherConfig{
+		URL: cfg.PubSub.NatsURL,
+		Stream: pubsub.NATSStreamConfig{
+			Name:                   streamCfg.Name,
+			Subjects:               streamCfg.Subjects,
+			RemindCancelledSubject: streamCfg.RemindCancelledSubject,
+			RemindsPausedSubject:   streamCfg.RemindsPausedSubject,
+			RemindsResumedSubject:  streamCfg.RemindsResumedSubject,
+			Retention:              retention,
+			MaxAge:                 streamCfg.MaxAge,
+			MaxBytes:               streamCfg.MaxBytes,
+			Storage:                storage,
+			Replicas:               streamCfg.Replicas,
+		},
+		Auth: pubsub.NATSAuthConfig{
+			CredsFile:    authCfg.CredsFile,
+			NKeySeedFile: authCfg.NKeySeedFile,
+			TLSCertFile:  authCfg.TLSCertFile,
+			TLSKeyFile:   authCfg.TLSKeyFile,
+			TLSCAFile:    authCfg.TLSCAFile,
+		},
+		Event: eventCfg,
+	})
+}
+
+func initObservability(ctx context.Context, platform config.PlatformConfig) (*observability.Resources, error) {
+	serviceName := os.Getenv("K_SERVICE")
+	if serviceName == "" {
+		serviceName = os.Getenv("SERVICE_NAME")
+	}
+
+	if serviceName == "" {
+		serviceName = "time-mgmt"
+	}
+
+	env := logging.EnvDev
+	projectID := ""
+
+	if platform.Name == config.PlatformGCloud {
+		env = logging.EnvProd
+
+		projectID = os.Getenv("GOOGLE_CLOUD_PROJECT")
+		if projectID == "" {
+			projectID = os.Getenv("GCLOUD_PROJECT_ID")
+		}
+	}
+
+	if e := os.Getenv("ENV"); e != "" {
+		env = logging.Environment(e)
+	}
+
+	obs, err := observability.Init(ctx, observability.Config{
+		ServiceInfo: logging.ServiceInfo{
+			Name:     serviceName,
+			Version:  Version,
+			Revision: os.Getenv("K_REVISION"),
+		},
+		Environment:    env,
+		GCPProjectID:   projectID,
+		SamplingRate:   1.0,
+		DefaultModule:  logging.Module("remind"),
+		TraceExporter:  platform.TraceExporter,
+		MetricExporter: platform.MetricExporter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return obs, nil
+}
+
+func registerDebugRoutes(router *gin.Engine, cfg *config.Config, publisher pubsub.Publisher) {
+	if !cfg.PubSub.DebugEndpoint {
+		return
+	}
+
+	goChannelPublisher, ok := publisher.(*pubsub.GoChannelPublisher)
+	if !ok {
+		slog.Warn("PUBSUB_DEBUG_ENDPOINT is only supported by the in-memory publisher")
+
+		return
+	}
+
+	router.GET("/debug/pubsub/messages", gin.WrapH(goChannelPublisher))
+}
+
+// registerAdminRoutes exposes the width backfill when it is enabled.
+func registerAdminRoutes(router *gin.Engine, backfillHandler *handler.WidthBackfillHandler) {
+	if backfillHandler == nil {
+		return
+	}
+
+	backfillHandler.RegisterRoutes(router.Group("/api/v1"))
+}
+
+func setupRouter(
+	remindHandler *handler.RemindHandler,
+	prefsHandler *handler.UserPreferencesHandler,
+	pauseHandler *handler.PauseHandler,
+	templateHandler *handler.RemindTemplateHandler,
+) *gin.Engine {
+	gin.SetMode(gin.ReleaseMode)
+
+	router := gin.New()
+
+	httpMetrics, err := metrics.NewHTTPMetrics()
+	if err != nil {
+		slog.Warn("failed to initialize HTTP metrics",
+			slog.String("error", err.Error()),
+		)
+	}
+
+	// Add observability middleware
+	router.Use(
+		middleware.Gin(middleware.GinConfig{
+			SkipPaths:       []string{"/ping", "/health", "/healthz", "/metrics"},
+			Module:          logging.Module("remind"),
+			ModuleResolver:  nil,
+			Worker:          false,
+			JobNameResolver: nil,
+			TracerName:      "github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/middleware",
+			HTTPMetrics:     httpMetrics,
+		}),
+		middleware.PanicRecoveryGin(),
+	)
+
+	router.GET("/ping", func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"message": "pong"})
+	})
+
+	v1 := router.Group("/api/v1")
+	remindHandler.RegisterRoutes(v1)
+	prefsHandler.RegisterRoutes(v1)
+	pauseHandler.RegisterRoutes(v1)
+	templateHandler.RegisterRoutes(v1)
+
+	return router
+}
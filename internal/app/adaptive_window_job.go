@@ -0,0 +1,60 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// AdaptiveWindowJob periodically recomputes the delivery statistics of the
+// adaptive window policy from the reminds of the lookback.
+type AdaptiveWindowJob struct {
+	useCase  RemindUseCase
+	interval time.Duration
+	lookback time.Duration
+}
+
+func NewAdaptiveWindowJob(useCase RemindUseCase, interval, lookback time.Duration) *AdaptiveWindowJob {
+	return &AdaptiveWindowJob{
+		useCase:  useCase,
+		interval: interval,
+		lookback: lookback,
+	}
+}
+
+// Run refreshes once at start so new instances do not wait an interval, then
+// blocks until ctx is cancelled.
+func (j *AdaptiveWindowJob) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "adaptive window job started",
+		"interval", j.interval,
+		"lookback", j.lookback,
+	)
+
+	j.RunOnce(ctx, time.Now())
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "adaptive window job stopped")
+
+			return
+		case now := <-ticker.C:
+			j.RunOnce(ctx, now)
+		}
+	}
+}
+
+// RunOnce refreshes the statistics from the reminds of [now-lookback, now).
+func (j *AdaptiveWindowJob) RunOnce(ctx context.Context, now time.Time) {
+	if _, err := j.useCase.RefreshWindowStats(ctx, RefreshWindowStatsInput{
+		Since: now.Add(-j.lookback),
+		Until: now,
+	}); err != nil {
+		slog.ErrorContext(ctx, "window stats refresh failed",
+			"error", err,
+		)
+	}
+}
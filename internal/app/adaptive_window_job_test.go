@@ -0,0 +1,85 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+)
+
+type fakeWindowStatsUseCase struct {
+	app.RemindUseCase
+
+	err    error
+	inputs []app.RefreshWindowStatsInput
+}
+
+func (f *fakeWindowStatsUseCase) RefreshWindowStats(
+	_ context.Context,
+	input app.RefreshWindowStatsInput,
+) (app.RefreshWindowStatsOutput, error) {
+	f.inputs = append(f.inputs, input)
+
+	if f.err != nil {
+		return app.RefreshWindowStatsOutput{}, f.err
+	}
+
+	return app.RefreshWindowStatsOutput{Stats: 1}, nil
+}
+
+func TestAdaptiveWindowJobRunOnceSuccess(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+	}{
+		{
+			name: "refreshes the lookback",
+		},
+		{
+			name: "survives errors",
+			err:  errors.New("db down"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &fakeWindowStatsUseCase{err: tt.err}
+			job := app.NewAdaptiveWindowJob(useCase, time.Hour, 24*time.Hour)
+			now := time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)
+
+			job.RunOnce(context.Background(), now)
+
+			assert.Equal(t, []app.RefreshWindowStatsInput{
+				{Since: now.Add(-24 * time.Hour), Until: now},
+			}, useCase.inputs)
+		})
+	}
+}
+
+func TestAdaptiveWindowJobRunStopsOnCancelSuccess(t *testing.T) {
+	useCase := &fakeWindowStatsUseCase{}
+	job := app.NewAdaptiveWindowJob(useCase, time.Hour, 24*time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("adaptive window job did not stop")
+	}
+
+	assert.Len(t, useCase.inputs, 1, "refreshes once at start")
+}
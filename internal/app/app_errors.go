@@ -10,6 +10,10 @@ var (
 	ErrNotFound      = errors.New("resource not found")
 	ErrInternalError = errors.New("internal error")
 	ErrAlreadyExists = errors.New("resource already exists")
+
+	// ErrUserPaused is returned when deleting the preferences of a paused
+	// user, whose auto-resume time lives in them.
+	ErrUserPaused = errors.New("user is paused; resume the user before deleting the preferences")
 )
 
 type ValidationError struct {
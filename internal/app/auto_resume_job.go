@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// AutoResumeJob periodically resumes paused users whose resume time has passed.
+type AutoResumeJob struct {
+	useCase   PauseUseCase
+	interval  time.Duration
+	batchSize int
+}
+
+func NewAutoResumeJob(useCase PauseUseCase, interval time.Duration, batchSize int) *AutoResumeJob {
+	return &AutoResumeJob{
+		useCase:   useCase,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (j *AutoResumeJob) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "auto-resume job started",
+		"interval", j.interval,
+		"batch_size", j.batchSize,
+	)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "auto-resume job stopped")
+
+			return
+		case now := <-ticker.C:
+			j.RunOnce(ctx, now)
+		}
+	}
+}
+
+// RunOnce resumes batches until no due user is left.
+func (j *AutoResumeJob) RunOnce(ctx context.Context, now time.Time) {
+	for ctx.Err() == nil {
+		output, err := j.useCase.ResumeDueUsers(ctx, ResumeDueUsersInput{
+			Now:       now,
+			BatchSize: j.batchSize,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "auto-resume run failed",
+				"error", err,
+			)
+
+			return
+		}
+
+		// Failed users stay due, so stop instead of fetching them again.
+		if output.Failed > 0 || output.Resumed < j.batchSize {
+			return
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+)
+
+type fakeAutoResumeUseCase struct {
+	app.PauseUseCase
+
+	outputs []app.ResumeDueUsersOutput
+	err     error
+	calls   int
+}
+
+func (f *fakeAutoResumeUseCase) ResumeDueUsers(
+	_ context.Context,
+	_ app.ResumeDueUsersInput,
+) (app.ResumeDueUsersOutput, error) {
+	f.calls++
+
+	if f.err != nil {
+		return app.ResumeDueUsersOutput{}, f.err
+	}
+
+	if len(f.outputs) == 0 {
+		return app.ResumeDueUsersOutput{}, nil
+	}
+
+	output := f.outputs[0]
+	f.outputs = f.outputs[1:]
+
+	return output, nil
+}
+
+func TestAutoResumeJobRunOnceSuccess(t *testing.T) {
+	tests := []struct {
+		name          string
+		outputs       []app.ResumeDueUsersOutput
+		err           error
+		expectedCalls int
+	}{
+		{
+			name:          "nothing due",
+			outputs:       nil,
+			expectedCalls: 1,
+		},
+		{
+			name: "full batches are drained",
+			outputs: []app.ResumeDueUsersOutput{
+				{Resumed: 2},
+				{Resumed: 1},
+			},
+			expectedCalls: 2,
+		},
+		{
+			name: "stops after failures",
+			outputs: []app.ResumeDueUsersOutput{
+				{Resumed: 1, Failed: 1},
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:          "stops on error",
+			err:           errors.New("db down"),
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &fakeAutoResumeUseCase{outputs: tt.outputs, err: tt.err}
+			job := app.NewAutoResumeJob(useCase, time.Minute, 2)
+
+			job.RunOnce(context.Background(), time.Now())
+
+			assert.Equal(t, tt.expectedCalls, useCase.calls)
+		})
+	}
+}
+
+func TestAutoResumeJobRunStopsOnCancelSuccess(t *testing.T) {
+	useCase := &fakeAutoResumeUseCase{}
+	job := app.NewAutoResumeJob(useCase, 10*time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("auto-resume job did not stop")
+	}
+
+	assert.Positive(t, useCase.calls)
+}
@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// EscalationJob periodically escalates reminds that stay unacknowledged.
+type EscalationJob struct {
+	useCase   RemindUseCase
+	interval  time.Duration
+	batchSize int
+}
+
+func NewEscalationJob(useCase RemindUseCase, interval time.Duration, batchSize int) *EscalationJob {
+	return &EscalationJob{
+		useCase:   useCase,
+		interval:  interval,
+		batchSize: batchSize,
+	}
+}
+
+// Run blocks until ctx is cancelled.
+func (j *EscalationJob) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "escalation job started",
+		"interval", j.interval,
+		"batch_size", j.batchSize,
+	)
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "escalation job stopped")
+
+			return
+		case now := <-ticker.C:
+			j.RunOnce(ctx, now)
+		}
+	}
+}
+
+// RunOnce escalates batches until no due remind is left.
+func (j *EscalationJob) RunOnce(ctx context.Context, now time.Time) {
+	for ctx.Err() == nil {
+		output, err := j.useCase.EscalateOverdueReminds(ctx, EscalateOverdueRemindsInput{
+			Now:       now,
+			BatchSize: j.batchSize,
+		})
+		if err != nil {
+			slog.ErrorContext(ctx, "escalation run failed",
+				"error", err,
+			)
+
+			return
+		}
+
+		// Failed reminds stay due, so stop instead of fetching them again.
+		if output.Failed > 0 || output.Escalated < j.batchSize {
+			return
+		}
+	}
+}
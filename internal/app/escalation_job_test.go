@@ -0,0 +1,111 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+)
+
+type fakeEscalationUseCase struct {
+	app.RemindUseCase
+
+	outputs []app.EscalateOverdueRemindsOutput
+	err     error
+	calls   int
+}
+
+func (f *fakeEscalationUseCase) EscalateOverdueReminds(
+	_ context.Context,
+	_ app.EscalateOverdueRemindsInput,
+) (app.EscalateOverdueRemindsOutput, error) {
+	f.calls++
+
+	if f.err != nil {
+		return app.EscalateOverdueRemindsOutput{}, f.err
+	}
+
+	if len(f.outputs) == 0 {
+		return app.EscalateOverdueRemindsOutput{}, nil
+	}
+
+	output := f.outputs[0]
+	f.outputs = f.outputs[1:]
+
+	return output, nil
+}
+
+func TestEscalationJobRunOnceSuccess(t *testing.T) {
+	tests := []struct {
+		name          string
+		outputs       []app.EscalateOverdueRemindsOutput
+		err           error
+		expectedCalls int
+	}{
+		{
+			name:          "nothing due",
+			outputs:       nil,
+			expectedCalls: 1,
+		},
+		{
+			name: "full batches are drained",
+			outputs: []app.EscalateOverdueRemindsOutput{
+				{Escalated: 2},
+				{Escalated: 2},
+				{Escalated: 1},
+			},
+			expectedCalls: 3,
+		},
+		{
+			name: "stops after failures",
+			outputs: []app.EscalateOverdueRemindsOutput{
+				{Escalated: 1, Failed: 1},
+			},
+			expectedCalls: 1,
+		},
+		{
+			name:          "stops on error",
+			err:           errors.New("db down"),
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &fakeEscalationUseCase{outputs: tt.outputs, err: tt.err}
+			job := app.NewEscalationJob(useCase, time.Minute, 2)
+
+			job.RunOnce(context.Background(), time.Now())
+
+			assert.Equal(t, tt.expectedCalls, useCase.calls)
+		})
+	}
+}
+
+func TestEscalationJobRunStopsOnCancelSuccess(t *testing.T) {
+	useCase := &fakeEscalationUseCase{}
+	job := app.NewEscalationJob(useCase, 10*time.Millisecond, 10)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("escalation job did not stop")
+	}
+
+	assert.Positive(t, useCase.calls)
+}
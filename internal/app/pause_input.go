@@ -0,0 +1,21 @@
+package app
+
+import "time"
+
+// PauseUserInput pauses the user's future reminds; a nil ResumeAt keeps them
+// paused until ResumeUser is called.
+type PauseUserInput struct {
+	UserID   string
+	ResumeAt *time.Time
+}
+
+// ResumeUserInput resumes the user's paused reminds; an empty Policy means skip_past.
+type ResumeUserInput struct {
+	UserID string
+	Policy string
+}
+
+type ResumeDueUsersInput struct {
+	Now       time.Time
+	BatchSize int
+}
@@ -0,0 +1,22 @@
+package app
+
+import "time"
+
+type PauseUserOutput struct {
+	UserID          string
+	PausedRemindIDs []string
+	ResumeAt        *time.Time
+}
+
+// ResumeUserOutput lists the restored reminds and those deleted because their
+// time passed during the pause.
+type ResumeUserOutput struct {
+	UserID            string
+	RestoredRemindIDs []string
+	SkippedRemindIDs  []string
+}
+
+type ResumeDueUsersOutput struct {
+	Resumed int
+	Failed  int
+}
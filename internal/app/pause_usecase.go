@@ -0,0 +1,11 @@
+package app
+
+import (
+	"context"
+)
+
+type PauseUseCase interface {
+	PauseUser(ctx context.Context, input PauseUserInput) (PauseUserOutput, error)
+	ResumeUser(ctx context.Context, input ResumeUserInput) (ResumeUserOutput, error)
+	ResumeDueUsers(ctx context.Context, input ResumeDueUsersInput) (ResumeDueUsersOutput, error)
+}
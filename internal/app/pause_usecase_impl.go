@@ -0,0 +1,285 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+)
+
+type pauseUseCaseImpl struct {
+	transactor domain.Transactor
+	prefsRepo  domain.UserPreferencesRepository
+	publisher  pubsub.Publisher
+}
+
+func NewPauseUseCase(
+	transactor domain.Transactor,
+	prefsRepo domain.UserPreferencesRepository,
+	publisher pubsub.Publisher,
+) PauseUseCase {
+	return &pauseUseCaseImpl{
+		transactor: transactor,
+		prefsRepo:  prefsRepo,
+		publisher:  publisher,
+	}
+}
+
+func (uc *pauseUseCaseImpl) PauseUser(ctx context.Context, input PauseUserInput) (PauseUserOutput, error) {
+	slog.Debug("pausing user",
+		"user_id", input.UserID,
+		"resume_at", input.ResumeAt,
+	)
+
+	userID, err := domain.UserIDFromString(input.UserID)
+	if err != nil {
+		return PauseUserOutput{}, NewValidationError("user_id", err.Error())
+	}
+
+	now := time.Now()
+
+	var pausedIDs []domain.RemindID
+
+	if err := uc.transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		prefs, err := repos.Preferences.FindByUserIDForUpdate(ctx, userID)
+		if errors.Is(err, domain.ErrUserPreferencesNotFound) {
+			prefs = domain.NewUserPreferences(userID, nil, domain.UTCTimezone(), nil, nil)
+		} else if err != nil {
+			return err
+		}
+
+		if err := prefs.Pause(now, input.ResumeAt); err != nil {
+			return err
+		}
+
+		if err := repos.Preferences.Save(ctx, prefs); err != nil {
+			return err
+		}
+
+		pausedIDs, err = repos.Reminds.PauseByUserIDAfter(ctx, userID, now)
+
+		return err
+	}); err != nil {
+		if errors.Is(err, domain.ErrInvalidResumeAt) {
+			return PauseUserOutput{}, NewValidationError("resume_at", err.Error())
+		}
+
+		slog.Error("failed to pause user",
+			"error", err,
+			"user_id", input.UserID,
+		)
+
+		return PauseUserOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	uc.publishRemindsPaused(ctx, input.UserID, pausedIDs, now, input.ResumeAt)
+
+	slog.Info("user paused",
+		"user_id", input.UserID,
+		"paused_count", len(pausedIDs),
+		"resume_at", input.ResumeAt,
+	)
+
+	return PauseUserOutput{
+		UserID:          input.UserID,
+		PausedRemindIDs: remindIDStrings(pausedIDs),
+		ResumeAt:        input.ResumeAt,
+	}, nil
+}
+
+func (uc *pauseUseCaseImpl) ResumeUser(ctx context.Context, input ResumeUserInput) (ResumeUserOutput, error) {
+	slog.Debug("resuming user",
+		"user_id", input.UserID,
+		"policy", input.Policy,
+	)
+
+	userID, err := domain.UserIDFromString(input.UserID)
+	if err != nil {
+		return ResumeUserOutput{}, NewValidationError("user_id", err.Error())
+	}
+
+	policy, err := domain.NewResumePolicy(input.Policy)
+	if err != nil {
+		return ResumeUserOutput{}, NewValidationError("policy", err.Error())
+	}
+
+	output, _, err := uc.resume(ctx, userID, policy, time.Now(), false)
+
+	return output, err
+}
+
+func (uc *pauseUseCaseImpl) ResumeDueUsers(
+	ctx context.Context,
+	input ResumeDueUsersInput,
+) (ResumeDueUsersOutput, error) {
+	due, err := uc.prefsRepo.FindResumeDue(ctx, input.Now, input.BatchSize)
+	if err != nil {
+		slog.Error("failed to find users due for resume",
+			"error", err,
+		)
+
+		return ResumeDueUsersOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	var output ResumeDueUsersOutput
+
+	for _, prefs := range due {
+		if _, resumed, err := uc.resume(ctx, prefs.UserID(), domain.ResumeSkipPast, input.Now, true); err != nil {
+			output.Failed++
+		} else if resumed {
+			output.Resumed++
+		}
+	}
+
+	if len(due) > 0 {
+		slog.Info("paused users resumed automatically",
+			"resumed", output.Resumed,
+			"failed", output.Failed,
+		)
+	}
+
+	return output, nil
+}
+
+// resume restores the user's paused reminds, deleting past ones when the policy
+// says so. Reminds stay paused under preferences that were deleted, so they are
+// resumed even without stored preferences. With onlyIfDue, a pause that was
+// extended since it was found due is left alone and false is returned.
+func (uc *pauseUseCaseImpl) resume(
+	ctx context.Context,
+	userID domain.UserID,
+	policy domain.ResumePolicy,
+	now time.Time,
+	onlyIfDue bool,
+) (ResumeUserOutput, bool, error) {
+	var (
+		restoredIDs []domain.RemindID
+		skippedIDs  []domain.RemindID
+		wasPaused   bool
+	)
+
+	if err := uc.transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		prefs, err := repos.Preferences.FindByUserIDForUpdate(ctx, userID)
+		if err != nil && !errors.Is(err, domain.ErrUserPreferencesNotFound) {
+			return err
+		}
+
+		if onlyIfDue && (prefs == nil || !prefs.IsResumeDue(now)) {
+			return nil
+		}
+
+		if prefs != nil && prefs.IsPaused() {
+			wasPaused = true
+
+			prefs.Resume()
+
+			if err := repos.Preferences.Save(ctx, prefs); err != nil {
+				return err
+			}
+		}
+
+		if policy.SkipsPast() {
+			skippedIDs, err = repos.Reminds.DeletePausedByUserIDBefore(ctx, userID, now)
+			if err != nil {
+				return err
+			}
+		}
+
+		restoredIDs, err = repos.Reminds.ResumeByUserID(ctx, userID)
+
+		return err
+	}); err != nil {
+		slog.Error("failed to resume user",
+			"error", err,
+			"user_id", userID.String(),
+		)
+
+		return ResumeUserOutput{}, false, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	resumed := wasPaused || len(restoredIDs) > 0 || len(skippedIDs) > 0
+	if !resumed {
+		slog.Info("user not paused, nothing to resume (idempotency)",
+			"user_id", userID.String(),
+		)
+	} else {
+		uc.publishRemindsResumed(ctx, userID.String(), restoredIDs, skippedIDs, now)
+
+		slog.Info("user resumed",
+			"user_id", userID.String(),
+			"policy", string(policy),
+			"restored_count", len(restoredIDs),
+			"skipped_count", len(skippedIDs),
+		)
+	}
+
+	return ResumeUserOutput{
+		UserID:            userID.String(),
+		RestoredRemindIDs: remindIDStrings(restoredIDs),
+		SkippedRemindIDs:  remindIDStrings(skippedIDs),
+	}, resumed, nil
+}
+
+// publishRemindsPaused notifies downstream services so queued notifications
+// are held back. Failures are logged only; the pause has already been committed.
+func (uc *pauseUseCaseImpl) publishRemindsPaused(
+	ctx context.Context,
+	userID string,
+	pausedIDs []domain.RemindID,
+	pausedAt time.Time,
+	resumeAt *time.Time,
+) {
+	if uc.publisher == nil {
+		return
+	}
+
+	req := &remindv1.RemindsPausedEvent{
+		UserId:    userID,
+		RemindIds: remindIDStrings(pausedIDs),
+		PausedAt:  timestamppb.New(pausedAt),
+	}
+	if resumeAt != nil {
+		req.ResumeAt = timestamppb.New(*resumeAt)
+	}
+
+	if pubErr := uc.publisher.PublishRemindsPaused(ctx, req); pubErr != nil {
+		slog.Error("failed to publish reminds paused event",
+			"user_id", userID,
+			"error", pubErr.Error(),
+		)
+	}
+}
+
+// publishRemindsResumed notifies downstream services of restored and skipped
+// reminds. Failures are logged only; the resume has already been committed.
+func (uc *pauseUseCaseImpl) publishRemindsResumed(
+	ctx context.Context,
+	userID string,
+	restoredIDs []domain.RemindID,
+	skippedIDs []domain.RemindID,
+	resumedAt time.Time,
+) {
+	if uc.publisher == nil {
+		return
+	}
+
+	req := &remindv1.RemindsResumedEvent{
+		UserId:            userID,
+		RestoredRemindIds: remindIDStrings(restoredIDs),
+		SkippedRemindIds:  remindIDStrings(skippedIDs),
+		ResumedAt:         timestamppb.New(resumedAt),
+	}
+	if pubErr := uc.publisher.PublishRemindsResumed(ctx, req); pubErr != nil {
+		slog.Error("failed to publish reminds resumed event",
+			"user_id", userID,
+			"error", pubErr.Error(),
+		)
+	}
+}
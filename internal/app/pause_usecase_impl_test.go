@@ -0,0 +1,323 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+type pauseTestEnv struct {
+	pause      app.PauseUseCase
+	reminds    app.RemindUseCase
+	remindRepo domain.RemindRepository
+	prefsRepo  domain.UserPreferencesRepository
+}
+
+func setupPauseUseCaseTest(t *testing.T, publisher pubsub.Publisher) (pauseTestEnv, func()) {
+	t.Helper()
+	testDB := testutil.SetupTestDB(t)
+	remindRepo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	env := pauseTestEnv{
+		pause:      app.NewPauseUseCase(repository.NewTransactor(testDB.DB), prefsRepo, publisher),
+		reminds:    app.NewRemindUseCase(remindRepo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil),
+		remindRepo: remindRepo,
+		prefsRepo:  prefsRepo,
+	}
+
+	return env, func() {
+		testDB.CleanTable(t)
+		testDB.TeardownTestDB(t)
+	}
+}
+
+func createRemindsForPause(t *testing.T, env pauseTestEnv, userID string, times ...time.Time) app.RemindsOutput {
+	t.Helper()
+
+	created, err := env.reminds.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    times,
+		UserID:   userID,
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "relaxed",
+	})
+	require.NoError(t, err)
+
+	return created.RemindsOutput
+}
+
+func TestPauseUserSuccess(t *testing.T) {
+	env, cleanup := setupPauseUseCaseTest(t, nil)
+	defer cleanup()
+
+	ctx := context.Background()
+	userID := generateUUIDv7String()
+	now := time.Now()
+
+	created := createRemindsForPause(t, env, userID, now.Add(time.Hour), now.Add(2*time.Hour))
+	resumeAt := now.Add(24 * time.Hour)
+
+	output, err := env.pause.PauseUser(ctx, app.PauseUserInput{UserID: userID, ResumeAt: &resumeAt})
+	require.NoError(t, err)
+	assert.Equal(t, userID, output.UserID)
+	assert.ElementsMatch(t, []string{created.Reminds[0].ID, created.Reminds[1].ID}, output.PausedRemindIDs)
+	assert.Equal(t, &resumeAt, output.ResumeAt)
+
+	// Paused reminds are not listed for dispatch.
+	listed, err := env.reminds.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+		Start: now,
+		End:   now.Add(3 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Empty(t, listed.Reminds)
+
+	// Reminds created during the pause are paused as well.
+	later := createRemindsForPause(t, env, userID, now.Add(3*time.Hour))
+	assert.True(t, later.Reminds[0].Paused)
+
+	uid, err := domain.UserIDFromString(userID)
+	require.NoError(t, err)
+
+	prefs, err := env.prefsRepo.FindByUserID(ctx, uid)
+	require.NoError(t, err)
+	assert.True(t, prefs.IsPaused())
+	require.NotNil(t, prefs.ResumeAt())
+	assert.WithinDuration(t, resumeAt, *prefs.ResumeAt(), time.Millisecond)
+}
+
+func TestPauseUserError(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		input         app.PauseUserInput
+		expectedField string
+	}{
+		{
+			name:          "invalid user id",
+			input:         app.PauseUserInput{UserID: "invalid", ResumeAt: nil},
+			expectedField: "user_id",
+		},
+		{
+			name:          "resume time in the past",
+			input:         app.PauseUserInput{UserID: generateUUIDv7String(), ResumeAt: &past},
+			expectedField: "resume_at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, cleanup := setupPauseUseCaseTest(t, nil)
+			defer cleanup()
+
+			_, err := env.pause.PauseUser(context.Background(), tt.input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestResumeUserSuccess(t *testing.T) {
+	tests := []struct {
+		name            string
+		policy          string
+		expectedSkipped int
+	}{
+		{
+			name:            "default policy skips past reminds",
+			policy:          "",
+			expectedSkipped: 1,
+		},
+		{
+			name:            "restore_all keeps past reminds",
+			policy:          "restore_all",
+			expectedSkipped: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, cleanup := setupPauseUseCaseTest(t, nil)
+			defer cleanup()
+
+			ctx := context.Background()
+			userID := generateUUIDv7String()
+			now := time.Now()
+
+			created := createRemindsForPause(t, env, userID, now.Add(time.Hour), now.Add(2*time.Hour))
+
+			_, err := env.pause.PauseUser(ctx, app.PauseUserInput{UserID: userID, ResumeAt: nil})
+			require.NoError(t, err)
+
+			// Move the first remind into the past as if the pause had lasted.
+			first, err := domain.RemindIDFromString(created.Reminds[0].ID)
+			require.NoError(t, err)
+			remind, err := env.remindRepo.FindByID(ctx, first)
+			require.NoError(t, err)
+
+			uid, err := domain.UserIDFromString(userID)
+			require.NoError(t, err)
+
+			moved := domain.Reconstitute(
+				remind.ID(),
+				now.Add(-time.Hour),
+				uid,
+				remind.Devices(),
+				remind.TaskID(),
+				remind.TaskType(),
+				remind.IsThrottled(),
+				remind.SlideWindowWidth(),
+				remind.AcknowledgedAt(),
+				remind.EscalationPolicy(),
+				remind.EscalationLevel(),
+				remind.IsPaused(),
+				domain.Payload{},
+				remind.CreatedAt(),
+				remind.UpdatedAt(),
+			)
+			require.NoError(t, env.remindRepo.Update(ctx, moved))
+
+			output, err := env.pause.ResumeUser(ctx, app.ResumeUserInput{UserID: userID, Policy: tt.policy})
+			require.NoError(t, err)
+			assert.Len(t, output.SkippedRemindIDs, tt.expectedSkipped)
+			assert.Len(t, output.RestoredRemindIDs, 2-tt.expectedSkipped)
+
+			prefs, err := env.prefsRepo.FindByUserID(ctx, uid)
+			require.NoError(t, err)
+			assert.False(t, prefs.IsPaused())
+
+			// Resuming again is a no-op.
+			again, err := env.pause.ResumeUser(ctx, app.ResumeUserInput{UserID: userID, Policy: tt.policy})
+			require.NoError(t, err)
+			assert.Empty(t, again.RestoredRemindIDs)
+			assert.Empty(t, again.SkippedRemindIDs)
+		})
+	}
+}
+
+func TestResumeUserError(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         app.ResumeUserInput
+		expectedField string
+	}{
+		{
+			name:          "invalid user id",
+			input:         app.ResumeUserInput{UserID: "invalid", Policy: ""},
+			expectedField: "user_id",
+		},
+		{
+			name:          "unknown policy",
+			input:         app.ResumeUserInput{UserID: generateUUIDv7String(), Policy: "restore_some"},
+			expectedField: "policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			env, cleanup := setupPauseUseCaseTest(t, nil)
+			defer cleanup()
+
+			_, err := env.pause.ResumeUser(context.Background(), tt.input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestResumeDueUsersSuccess(t *testing.T) {
+	env, cleanup := setupPauseUseCaseTest(t, nil)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	dueUser := generateUUIDv7String()
+	openEndedUser := generateUUIDv7String()
+
+	createRemindsForPause(t, env, dueUser, now.Add(2*time.Hour))
+
+	resumeAt := now.Add(time.Hour)
+	_, err := env.pause.PauseUser(ctx, app.PauseUserInput{UserID: dueUser, ResumeAt: &resumeAt})
+	require.NoError(t, err)
+
+	_, err = env.pause.PauseUser(ctx, app.PauseUserInput{UserID: openEndedUser, ResumeAt: nil})
+	require.NoError(t, err)
+
+	output, err := env.pause.ResumeDueUsers(ctx, app.ResumeDueUsersInput{Now: now.Add(90 * time.Minute), BatchSize: 10})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Resumed)
+	assert.Zero(t, output.Failed)
+
+	listed, err := env.reminds.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+		Start: now,
+		End:   now.Add(3 * time.Hour),
+	})
+	require.NoError(t, err)
+	assert.Len(t, listed.Reminds, 1)
+
+	uid, err := domain.UserIDFromString(openEndedUser)
+	require.NoError(t, err)
+
+	prefs, err := env.prefsRepo.FindByUserID(ctx, uid)
+	require.NoError(t, err)
+	assert.True(t, prefs.IsPaused())
+}
+
+func TestPauseAndResumeUser_PublishEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPublisher := pubsub.NewMockPublisher(ctrl)
+
+	userID := generateUUIDv7String()
+
+	gomock.InOrder(
+		mockPublisher.EXPECT().
+			PublishRemindsPaused(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *remindv1.RemindsPausedEvent) error {
+				assert.Equal(t, userID, req.GetUserId())
+				assert.Len(t, req.GetRemindIds(), 1)
+				assert.Nil(t, req.GetResumeAt())
+
+				return nil
+			}),
+		mockPublisher.EXPECT().
+			PublishRemindsResumed(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, req *remindv1.RemindsResumedEvent) error {
+				assert.Equal(t, userID, req.GetUserId())
+				assert.Len(t, req.GetRestoredRemindIds(), 1)
+				assert.Empty(t, req.GetSkippedRemindIds())
+
+				return nil
+			}),
+	)
+
+	env, cleanup := setupPauseUseCaseTest(t, mockPublisher)
+	defer cleanup()
+
+	ctx := context.Background()
+	createRemindsForPause(t, env, userID, time.Now().Add(time.Hour))
+
+	_, err := env.pause.PauseUser(ctx, app.PauseUserInput{UserID: userID, ResumeAt: nil})
+	require.NoError(t, err)
+
+	_, err = env.pause.ResumeUser(ctx, app.ResumeUserInput{UserID: userID, Policy: ""})
+	require.NoError(t, err)
+}
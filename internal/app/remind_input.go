@@ -2,12 +2,38 @@ package app
 
 import "time"
 
+// CreateRemindInput gives either Times, or TargetAt with either the name of a
+// stored template or AutoSchedule to generate the times.
 type CreateRemindInput struct {
 	Times    []time.Time
 	UserID   string
 	Devices  []DeviceInput
 	TaskID   string
 	TaskType string
+	// Escalation overrides the task type's default escalation policy when set.
+	Escalation *EscalationInput
+	// Payload is the notification content stored with every remind of the task.
+	Payload *PayloadInput
+	// TargetAt is the deadline the generated times count back from.
+	TargetAt *time.Time
+	Template string
+	// AutoSchedule generates the recommended times for the task type.
+	AutoSchedule bool
+	// CatchUp names the catch-up policy for past times; empty rejects them.
+	CatchUp string
+}
+
+type PayloadInput struct {
+	Title    string
+	Body     string
+	DeepLink string
+	Locale   string
+	Data     map[string]string
+}
+
+type EscalationInput struct {
+	AfterSeconds int32
+	Steps        []string
 }
 
 type DeviceInput struct {
@@ -15,11 +41,22 @@ type DeviceInput struct {
 	FCMToken string
 }
 
+type PreviewScheduleInput struct {
+	Deadline time.Time
+	TaskType string
+}
+
 type GetRemindsByTimeRangeInput struct {
 	Start time.Time
 	End   time.Time
 }
 
+// GetDueRemindsInput asks for the reminds that may be delivered at At.
+type GetDueRemindsInput struct {
+	At               time.Time
+	ExcludeThrottled bool
+}
+
 type UpdateThrottledInput struct {
 	ID        string
 	Throttled bool
@@ -33,3 +70,29 @@ type CancelRemindByTaskIDInput struct {
 	TaskID string
 	UserID string
 }
+
+type AcknowledgeRemindInput struct {
+	ID string
+}
+
+type EscalateOverdueRemindsInput struct {
+	Now       time.Time
+	BatchSize int
+}
+
+// BackfillSlideWindowWidthsInput selects the next batch of tasks whose future
+// reminds get their widths recalculated.
+type BackfillSlideWindowWidthsInput struct {
+	Now         time.Time
+	AfterTaskID string // empty starts from the first task
+	BatchSize   int    // tasks per batch
+	DryRun      bool   // report the changes without storing them
+}
+
+// RefreshWindowStatsInput selects the reminds the delivery statistics are
+// computed from: those scheduled since Since whose windows closed before
+// Until.
+type RefreshWindowStatsInput struct {
+	Since time.Time
+	Until time.Time
+}
@@ -15,15 +15,139 @@ type RemindOutput struct {
 	TaskType         string
 	Throttled        bool
 	SlideWindowWidth int32 // slide window width in seconds
+	AcknowledgedAt   *time.Time
+	EscalationLevel  int32
+	Paused           bool
+	Payload          *PayloadOutput // nil when the remind has no payload
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 }
 
+type PayloadOutput struct {
+	Title    string
+	Body     string
+	DeepLink string
+	Locale   string
+	Data     map[string]string
+}
+
 type DeviceOutput struct {
 	DeviceID string
 	FCMToken string
 }
 
+// AcknowledgeRemindOutput lists the upcoming reminds of the same task that were
+// cancelled by the acknowledgment.
+type AcknowledgeRemindOutput struct {
+	Remind             RemindOutput
+	CancelledRemindIDs []string
+}
+
+type EscalateOverdueRemindsOutput struct {
+	Escalated int
+	FollowUps int
+	Failed    int
+}
+
+// DigestOutput is a group of one user's reminds that can be delivered as a
+// single notification at RepresentativeTime.
+type DigestOutput struct {
+	UserID             string
+	RepresentativeTime time.Time
+	WindowStart        time.Time
+	WindowEnd          time.Time
+	RemindIDs          []string
+}
+
+type DigestsOutput struct {
+	Digests []DigestOutput
+	Count   int32
+}
+
+// ScheduledTimeOutput is one remind time of a generated schedule.
+type ScheduledTimeOutput struct {
+	Time             time.Time
+	SlideWindowWidth int32 // seconds
+}
+
+type ScheduleOutput struct {
+	Times []ScheduledTimeOutput
+}
+
+// Policy adjustments reported for a previewed remind.
+const (
+	AdjustmentQuietHours = "quiet_hours"
+	AdjustmentRateLimit  = "rate_limit"
+	AdjustmentDensity    = "density"
+	AdjustmentCatchUp    = "catch_up"
+	AdjustmentAdaptive   = "adaptive"
+	AdjustmentOverlap    = "overlap"
+)
+
+// PreviewedRemindOutput is one remind a create request would store.
+type PreviewedRemindOutput struct {
+	Time             time.Time
+	SlideWindowWidth int32 // seconds
+	Category         string
+	Adjustments      []string // policies that moved, widened or shrank the remind
+	Paused           bool
+}
+
+// RemindPreviewOutput is the dry run of a create request.
+type RemindPreviewOutput struct {
+	Reminds        []PreviewedRemindOutput
+	RequestedTimes []time.Time // times before catch-up, quiet hours and rate limiting
+	DroppedTimes   []time.Time // requested times dropped as past, for quiet hours, by the rate limit, for ending up closer than the minimum spacing or for overlapping windows
+	TimeOutcomes   []TimeOutcomeOutput
+	// WindowExplanation says why the adaptive window policy scaled the
+	// widths or left them alone; empty when the policy is disabled.
+	WindowExplanation string
+	Count             int32
+}
+
+// TimeOutcomeOutput is what the catch-up policy did to one requested time.
+// A time dropped by a later policy is reported as dropped too.
+type TimeOutcomeOutput struct {
+	RequestedTime time.Time
+	Result        string
+	Time          time.Time // where the remind landed; zero when the time was dropped
+}
+
+// CreateRemindOutput is the created reminds with the catch-up outcome of
+// each requested time, the requested times that were dropped and the times
+// whose windows were shrunk for overlapping. All three are empty when the
+// reminds already existed.
+type CreateRemindOutput struct {
+	RemindsOutput
+	TimeOutcomes []TimeOutcomeOutput
+	DroppedTimes []time.Time
+	ShrunkTimes  []time.Time
+}
+
+// WidthChangeOutput is one remind whose stored width differs from the one
+// the current policies give it.
+type WidthChangeOutput struct {
+	RemindID string
+	TaskID   string
+	Time     time.Time
+	OldWidth int32 // seconds
+	NewWidth int32 // seconds
+}
+
+type BackfillSlideWindowWidthsOutput struct {
+	Tasks      int
+	Reminds    int // future reminds checked
+	Changes    []WidthChangeOutput
+	Overlap    int    // future reminds left unchanged as no width avoids overlapping the next one
+	Skipped    int    // changes not stored as the remind changed since it was read
+	Failed     int    // tasks whose update failed
+	LastTaskID string // empty when the batch was empty
+}
+
+type RefreshWindowStatsOutput struct {
+	Stats int // user and task type pairs with statistics
+}
+
 type RemindsOutput struct {
 	Reminds []RemindOutput
 	Count   int32
@@ -47,11 +171,29 @@ func FromEntity(remind *domain.Remind) RemindOutput {
 		TaskType:         string(remind.TaskType()),
 		Throttled:        remind.IsThrottled(),
 		SlideWindowWidth: remind.SlideWindowWidth().Seconds(),
+		AcknowledgedAt:   remind.AcknowledgedAt(),
+		EscalationLevel:  int32(remind.EscalationLevel()), // #nosec G115
+		Paused:           remind.IsPaused(),
+		Payload:          fromPayload(remind.Payload()),
 		CreatedAt:        remind.CreatedAt(),
 		UpdatedAt:        remind.UpdatedAt(),
 	}
 }
 
+func fromPayload(payload domain.Payload) *PayloadOutput {
+	if payload.IsEmpty() {
+		return nil
+	}
+
+	return &PayloadOutput{
+		Title:    payload.Title(),
+		Body:     payload.Body(),
+		DeepLink: payload.DeepLink(),
+		Locale:   payload.Locale(),
+		Data:     payload.Data(),
+	}
+}
+
 func FromEntities(reminds []*domain.Remind) RemindsOutput {
 	outputs := make([]RemindOutput, 0, len(reminds))
 	for _, r := range reminds {
@@ -63,3 +205,96 @@ func FromEntities(reminds []*domain.Remind) RemindsOutput {
 		Count:   int32(len(outputs)), //nolint:gosec
 	}
 }
+
+func FromDigests(digests []domain.Digest) DigestsOutput {
+	outputs := make([]DigestOutput, 0, len(digests))
+	for _, d := range digests {
+		remindIDs := make([]string, 0, d.Size())
+		for _, id := range d.RemindIDs() {
+			remindIDs = append(remindIDs, id.String())
+		}
+
+		outputs = append(outputs, DigestOutput{
+			UserID:             d.UserID().String(),
+			RepresentativeTime: d.RepresentativeTime(),
+			WindowStart:        d.Window().Start,
+			WindowEnd:          d.Window().End,
+			RemindIDs:          remindIDs,
+		})
+	}
+
+	return DigestsOutput{
+		Digests: outputs,
+		Count:   int32(len(outputs)), //nolint:gosec
+	}
+}
+
+func FromSchedule(schedule []domain.ScheduledTime) ScheduleOutput {
+	times := make([]ScheduledTimeOutput, 0, len(schedule))
+	for _, s := range schedule {
+		times = append(times, ScheduledTimeOutput{
+			Time:             s.Time,
+			SlideWindowWidth: s.Width.Seconds(),
+		})
+	}
+
+	return ScheduleOutput{
+		Times: times,
+	}
+}
+
+func (p remindPlan) toPreviewOutput() RemindPreviewOutput {
+	var target time.Time
+	for _, r := range p.reminds {
+		if r.Time().After(target) {
+			target = r.Time()
+		}
+	}
+
+	reminds := make([]PreviewedRemindOutput, 0, len(p.reminds))
+	for _, r := range p.reminds {
+		category := domain.WindowCategoryIntermediate
+		if r.Time().Equal(target) {
+			category = domain.WindowCategoryTarget
+		}
+
+		reminds = append(reminds, PreviewedRemindOutput{
+			Time:             r.Time(),
+			SlideWindowWidth: r.SlideWindowWidth().Seconds(),
+			Category:         string(category),
+			Adjustments:      p.adjustments[r.Time()],
+			Paused:           r.IsPaused(),
+		})
+	}
+
+	return RemindPreviewOutput{
+		Reminds:           reminds,
+		RequestedTimes:    p.requested,
+		DroppedTimes:      p.dropped,
+		TimeOutcomes:      fromCatchUpOutcomes(p.outcomes),
+		WindowExplanation: p.windowExplanation,
+		Count:             int32(len(reminds)), //nolint:gosec
+	}
+}
+
+func (p remindPlan) toCreateOutput() CreateRemindOutput {
+	return CreateRemindOutput{
+		RemindsOutput: FromEntities(p.reminds),
+		TimeOutcomes:  fromCatchUpOutcomes(p.outcomes),
+		DroppedTimes:  p.dropped,
+		ShrunkTimes:   p.shrunk,
+	}
+}
+
+func fromCatchUpOutcomes(outcomes []domain.CatchUpOutcome) []TimeOutcomeOutput {
+	outputs := make([]TimeOutcomeOutput, 0, len(outcomes))
+	for _, o := range outcomes {
+		outputs = append(outputs, TimeOutcomeOutput{
+			RequestedTime: o.Requested,
+			Result:        string(o.Result),
+			Time:          o.Time,
+		})
+	}
+
+	return outputs
+}
@@ -62,6 +62,11 @@ func createValidRemind(t *testing.T, deviceCount int, throttled bool) *domain.Re
 		domain.TypeNear,
 		throttled,
 		domain.MustSlideWindowWidth(5*time.Minute),
+		nil,
+		domain.EscalationPolicy{},
+		0,
+		false,
+		domain.Payload{},
 		time.Now().Add(-1*time.Hour),
 		time.Now(),
 	)
@@ -236,3 +241,22 @@ func TestFromEntitiesWithMixedThrottledSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestFromDigestsSuccess(t *testing.T) {
+	first := createValidRemind(t, 1, false)
+	second := createValidRemind(t, 1, false)
+
+	output := app.FromDigests(domain.BuildDigests([]*domain.Remind{first}))
+	require.Len(t, output.Digests, 1)
+
+	digest := output.Digests[0]
+	assert.Equal(t, int32(1), output.Count)
+	assert.Equal(t, first.UserID().String(), digest.UserID)
+	assert.Equal(t, []string{first.ID().String()}, digest.RemindIDs)
+	assert.True(t, first.Time().Equal(digest.RepresentativeTime))
+	assert.True(t, digest.WindowStart.Before(digest.RepresentativeTime))
+	assert.True(t, digest.WindowEnd.After(digest.RepresentativeTime))
+
+	assert.Equal(t, int32(2), app.FromDigests(domain.BuildDigests([]*domain.Remind{first, second})).Count)
+	assert.Empty(t, app.FromDigests(nil).Digests)
+}
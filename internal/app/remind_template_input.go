@@ -0,0 +1,20 @@
+package app
+
+type GetRemindTemplateInput struct {
+	Name string
+}
+
+// PutRemindTemplateInput creates the named template or replaces all of its offsets.
+type PutRemindTemplateInput struct {
+	Name    string
+	Offsets []TemplateOffsetsInput
+}
+
+type TemplateOffsetsInput struct {
+	TaskType      string
+	OffsetSeconds []int32 // seconds before the target time
+}
+
+type DeleteRemindTemplateInput struct {
+	Name string
+}
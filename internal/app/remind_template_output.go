@@ -0,0 +1,65 @@
+package app
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type RemindTemplateOutput struct {
+	Name      string
+	Offsets   []TemplateOffsetsOutput
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type TemplateOffsetsOutput struct {
+	TaskType      string
+	OffsetSeconds []int32
+}
+
+type RemindTemplatesOutput struct {
+	Templates []RemindTemplateOutput
+	Count     int32
+}
+
+func FromRemindTemplateEntity(template *domain.RemindTemplate) RemindTemplateOutput {
+	offsets := make([]TemplateOffsetsOutput, 0, len(template.Offsets()))
+	for t, durations := range template.Offsets() {
+		seconds := make([]int32, 0, len(durations))
+		for _, d := range durations {
+			seconds = append(seconds, int32(d/time.Second)) // #nosec G115
+		}
+
+		offsets = append(offsets, TemplateOffsetsOutput{
+			TaskType:      string(t),
+			OffsetSeconds: seconds,
+		})
+	}
+
+	// Map iteration order is random; keep responses stable.
+	slices.SortFunc(offsets, func(a, b TemplateOffsetsOutput) int {
+		return strings.Compare(a.TaskType, b.TaskType)
+	})
+
+	return RemindTemplateOutput{
+		Name:      template.Name().String(),
+		Offsets:   offsets,
+		CreatedAt: template.CreatedAt(),
+		UpdatedAt: template.UpdatedAt(),
+	}
+}
+
+func FromRemindTemplateEntities(templates []*domain.RemindTemplate) RemindTemplatesOutput {
+	outputs := make([]RemindTemplateOutput, 0, len(templates))
+	for _, t := range templates {
+		outputs = append(outputs, FromRemindTemplateEntity(t))
+	}
+
+	return RemindTemplatesOutput{
+		Templates: outputs,
+		Count:     int32(len(outputs)), //nolint:gosec
+	}
+}
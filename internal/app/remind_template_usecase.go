@@ -0,0 +1,12 @@
+package app
+
+import (
+	"context"
+)
+
+type RemindTemplateUseCase interface {
+	GetRemindTemplate(ctx context.Context, input GetRemindTemplateInput) (RemindTemplateOutput, error)
+	ListRemindTemplates(ctx context.Context) (RemindTemplatesOutput, error)
+	PutRemindTemplate(ctx context.Context, input PutRemindTemplateInput) (RemindTemplateOutput, error)
+	DeleteRemindTemplate(ctx context.Context, input DeleteRemindTemplateInput) error
+}
@@ -0,0 +1,176 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type remindTemplateUseCaseImpl struct {
+	repo domain.RemindTemplateRepository
+}
+
+func NewRemindTemplateUseCase(repo domain.RemindTemplateRepository) RemindTemplateUseCase {
+	return &remindTemplateUseCaseImpl{
+		repo: repo,
+	}
+}
+
+func (uc *remindTemplateUseCaseImpl) GetRemindTemplate(
+	ctx context.Context,
+	input GetRemindTemplateInput,
+) (RemindTemplateOutput, error) {
+	slog.Debug("getting remind template",
+		"name", input.Name,
+	)
+
+	name, err := domain.NewTemplateName(input.Name)
+	if err != nil {
+		return RemindTemplateOutput{}, NewValidationError("name", err.Error())
+	}
+
+	template, err := uc.repo.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrRemindTemplateNotFound) {
+			return RemindTemplateOutput{}, fmt.Errorf("%w: %v", ErrNotFound, err)
+		}
+
+		slog.Error("failed to get remind template",
+			"error", err,
+			"name", input.Name,
+		)
+
+		return RemindTemplateOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return FromRemindTemplateEntity(template), nil
+}
+
+func (uc *remindTemplateUseCaseImpl) ListRemindTemplates(ctx context.Context) (RemindTemplatesOutput, error) {
+	slog.Debug("listing remind templates")
+
+	templates, err := uc.repo.FindAll(ctx)
+	if err != nil {
+		slog.Error("failed to list remind templates",
+			"error", err,
+		)
+
+		return RemindTemplatesOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return FromRemindTemplateEntities(templates), nil
+}
+
+func (uc *remindTemplateUseCaseImpl) PutRemindTemplate(
+	ctx context.Context,
+	input PutRemindTemplateInput,
+) (RemindTemplateOutput, error) {
+	slog.Debug("putting remind template",
+		"name", input.Name,
+	)
+
+	name, err := domain.NewTemplateName(input.Name)
+	if err != nil {
+		return RemindTemplateOutput{}, NewValidationError("name", err.Error())
+	}
+
+	offsets, err := toTemplateOffsets(input.Offsets)
+	if err != nil {
+		return RemindTemplateOutput{}, err
+	}
+
+	template, err := uc.repo.FindByName(ctx, name)
+	if err != nil && !errors.Is(err, domain.ErrRemindTemplateNotFound) {
+		slog.Error("failed to load remind template",
+			"error", err,
+			"name", input.Name,
+		)
+
+		return RemindTemplateOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	if template == nil {
+		template, err = domain.NewRemindTemplate(name, offsets)
+	} else {
+		err = template.Replace(offsets)
+	}
+
+	if err != nil {
+		return RemindTemplateOutput{}, NewValidationError("offsets", err.Error())
+	}
+
+	if err := uc.repo.Save(ctx, template); err != nil {
+		slog.Error("failed to save remind template",
+			"error", err,
+			"name", input.Name,
+		)
+
+		return RemindTemplateOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	slog.Debug("remind template saved",
+		"name", input.Name,
+	)
+
+	return FromRemindTemplateEntity(template), nil
+}
+
+func toTemplateOffsets(inputs []TemplateOffsetsInput) (domain.TemplateOffsets, error) {
+	offsets := make(domain.TemplateOffsets, len(inputs))
+	for i, o := range inputs {
+		field := fmt.Sprintf("offsets[%d]", i)
+
+		taskType, err := domain.NewType(o.TaskType)
+		if err != nil {
+			return nil, NewValidationError(field, err.Error())
+		}
+
+		if _, ok := offsets[taskType]; ok {
+			return nil, NewValidationError(field, "duplicate task type")
+		}
+
+		durations := make([]time.Duration, 0, len(o.OffsetSeconds))
+		for _, s := range o.OffsetSeconds {
+			durations = append(durations, time.Duration(s)*time.Second)
+		}
+
+		offsets[taskType] = durations
+	}
+
+	return offsets, nil
+}
+
+func (uc *remindTemplateUseCaseImpl) DeleteRemindTemplate(
+	ctx context.Context,
+	input DeleteRemindTemplateInput,
+) error {
+	slog.Debug("deleting remind template",
+		"name", input.Name,
+	)
+
+	name, err := domain.NewTemplateName(input.Name)
+	if err != nil {
+		return NewValidationError("name", err.Error())
+	}
+
+	if err := uc.repo.Delete(ctx, name); err != nil {
+		if !errors.Is(err, domain.ErrRemindTemplateNotFound) {
+			slog.Error("failed to delete remind template",
+				"error", err,
+				"name", input.Name,
+			)
+
+			return fmt.Errorf("%w: %v", ErrInternalError, err)
+		}
+
+		slog.Info("remind template not found for deletion (idempotency)",
+			"name", input.Name,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func setupRemindTemplateUseCaseTest(t *testing.T) (app.RemindTemplateUseCase, func()) {
+	t.Helper()
+	testDB := testutil.SetupTestDB(t)
+	repo := repository.NewRemindTemplateRepository(testDB.DB)
+	useCase := app.NewRemindTemplateUseCase(repo)
+
+	return useCase, func() {
+		testDB.CleanTable(t)
+		testDB.TeardownTestDB(t)
+	}
+}
+
+func TestPutRemindTemplateSuccess(t *testing.T) {
+	useCase, cleanup := setupRemindTemplateUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	created, err := useCase.PutRemindTemplate(ctx, app.PutRemindTemplateInput{
+		Name: "standard",
+		Offsets: []app.TemplateOffsetsInput{
+			{TaskType: "scheduled", OffsetSeconds: []int32{600, 86400, 3600}},
+			{TaskType: "near", OffsetSeconds: []int32{0}},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "standard", created.Name)
+	assert.Equal(t, []app.TemplateOffsetsOutput{
+		{TaskType: "near", OffsetSeconds: []int32{0}},
+		{TaskType: "scheduled", OffsetSeconds: []int32{86400, 3600, 600}},
+	}, created.Offsets)
+
+	replaced, err := useCase.PutRemindTemplate(ctx, app.PutRemindTemplateInput{
+		Name:    "standard",
+		Offsets: []app.TemplateOffsetsInput{{TaskType: "short", OffsetSeconds: []int32{300}}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []app.TemplateOffsetsOutput{{TaskType: "short", OffsetSeconds: []int32{300}}}, replaced.Offsets)
+
+	found, err := useCase.GetRemindTemplate(ctx, app.GetRemindTemplateInput{Name: "standard"})
+	require.NoError(t, err)
+	assert.Equal(t, replaced.Offsets, found.Offsets)
+	assert.WithinDuration(t, created.CreatedAt, found.CreatedAt, time.Millisecond)
+
+	list, err := useCase.ListRemindTemplates(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), list.Count)
+}
+
+func TestPutRemindTemplateError(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         app.PutRemindTemplateInput
+		expectedField string
+	}{
+		{
+			name: "invalid name",
+			input: app.PutRemindTemplateInput{
+				Name:    "Standard Schedule",
+				Offsets: []app.TemplateOffsetsInput{{TaskType: "near", OffsetSeconds: []int32{60}}},
+			},
+			expectedField: "name",
+		},
+		{
+			name: "unknown task type",
+			input: app.PutRemindTemplateInput{
+				Name:    "standard",
+				Offsets: []app.TemplateOffsetsInput{{TaskType: "urgent", OffsetSeconds: []int32{60}}},
+			},
+			expectedField: "offsets[0]",
+		},
+		{
+			name: "duplicate task type",
+			input: app.PutRemindTemplateInput{
+				Name: "standard",
+				Offsets: []app.TemplateOffsetsInput{
+					{TaskType: "near", OffsetSeconds: []int32{60}},
+					{TaskType: "near", OffsetSeconds: []int32{120}},
+				},
+			},
+			expectedField: "offsets[1]",
+		},
+		{
+			name: "no offsets",
+			input: app.PutRemindTemplateInput{
+				Name:    "standard",
+				Offsets: nil,
+			},
+			expectedField: "offsets",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupRemindTemplateUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.PutRemindTemplate(context.Background(), tt.input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestGetRemindTemplateError(t *testing.T) {
+	useCase, cleanup := setupRemindTemplateUseCaseTest(t)
+	defer cleanup()
+
+	_, err := useCase.GetRemindTemplate(context.Background(), app.GetRemindTemplateInput{Name: "missing"})
+
+	assert.ErrorIs(t, err, app.ErrNotFound)
+}
+
+func TestDeleteRemindTemplateSuccess(t *testing.T) {
+	useCase, cleanup := setupRemindTemplateUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+
+	_, err := useCase.PutRemindTemplate(ctx, app.PutRemindTemplateInput{
+		Name:    "standard",
+		Offsets: []app.TemplateOffsetsInput{{TaskType: "near", OffsetSeconds: []int32{60}}},
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, useCase.DeleteRemindTemplate(ctx, app.DeleteRemindTemplateInput{Name: "standard"}))
+
+	// Deleting again is idempotent.
+	require.NoError(t, useCase.DeleteRemindTemplate(ctx, app.DeleteRemindTemplateInput{Name: "standard"}))
+
+	_, err = useCase.GetRemindTemplate(ctx, app.GetRemindTemplateInput{Name: "standard"})
+	assert.ErrorIs(t, err, app.ErrNotFound)
+}
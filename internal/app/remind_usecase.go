@@ -5,9 +5,20 @@ import (
 )
 
 type RemindUseCase interface {
-	CreateRemind(ctx context.Context, input CreateRemindInput) (RemindsOutput, error)
+	CreateRemind(ctx context.Context, input CreateRemindInput) (CreateRemindOutput, error)
+	PreviewRemind(ctx context.Context, input CreateRemindInput) (RemindPreviewOutput, error)
+	PreviewSchedule(ctx context.Context, input PreviewScheduleInput) (ScheduleOutput, error)
 	GetRemindsByTimeRange(ctx context.Context, input GetRemindsByTimeRangeInput) (RemindsOutput, error)
+	GetRemindDigestsByTimeRange(ctx context.Context, input GetRemindsByTimeRangeInput) (DigestsOutput, error)
+	GetDueReminds(ctx context.Context, input GetDueRemindsInput) (RemindsOutput, error)
 	UpdateThrottled(ctx context.Context, input UpdateThrottledInput) (RemindOutput, error)
 	DeleteRemind(ctx context.Context, input DeleteRemindInput) error
 	CancelRemindByTaskID(ctx context.Context, input CancelRemindByTaskIDInput) error
+	AcknowledgeRemind(ctx context.Context, input AcknowledgeRemindInput) (AcknowledgeRemindOutput, error)
+	EscalateOverdueReminds(ctx context.Context, input EscalateOverdueRemindsInput) (EscalateOverdueRemindsOutput, error)
+	BackfillSlideWindowWidths(
+		ctx context.Context,
+		input BackfillSlideWindowWidthsInput,
+	) (BackfillSlideWindowWidthsOutput, error)
+	RefreshWindowStats(ctx context.Context, input RefreshWindowStatsInput) (RefreshWindowStatsOutput, error)
 }
@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
+	"time"
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
@@ -14,36 +17,85 @@ import (
 )
 
 type remindUseCaseImpl struct {
-	repo      domain.RemindRepository
-	publisher pubsub.Publisher
+	repo              domain.RemindRepository
+	prefsRepo         domain.UserPreferencesRepository
+	templateRepo      domain.RemindTemplateRepository
+	quietHoursPolicy  *domain.QuietHoursPolicy
+	acknowledgePolicy *domain.AcknowledgePolicy
+	rateLimitPolicy   *domain.RateLimitPolicy
+	densityPolicy     *domain.DensityPolicy
+	timePolicy        *domain.TimeNormalizationPolicy
+	adaptivePolicy    *domain.AdaptiveWindowPolicy
+	statsRepo         domain.WindowStatsRepository
+	calculator        *domain.SlideWindowWidthCalculator
+	scheduleGenerator *domain.ScheduleGenerator
+	publisher         pubsub.Publisher
 }
 
-func NewRemindUseCase(repo domain.RemindRepository, publisher pubsub.Publisher) RemindUseCase {
+// NewRemindUseCase creates the remind use case. A nil rateLimitPolicy leaves
+// remind times unlimited; a nil templateRepo rejects template requests; a nil
+// windowPolicy uses the default slide window widths; a nil densityPolicy
+// leaves widths independent of other users' reminds; a nil timePolicy uses the
+// default time normalization; a nil adaptivePolicy leaves widths independent
+// of the user's delivery history.
+func NewRemindUseCase(
+	repo domain.RemindRepository,
+	prefsRepo domain.UserPreferencesRepository,
+	templateRepo domain.RemindTemplateRepository,
+	rateLimitPolicy *domain.RateLimitPolicy,
+	windowPolicy domain.WindowPolicy,
+	densityPolicy *domain.DensityPolicy,
+	timePolicy *domain.TimeNormalizationPolicy,
+	adaptivePolicy *domain.AdaptiveWindowPolicy,
+	statsRepo domain.WindowStatsRepository,
+	publisher pubsub.Publisher,
+) RemindUseCase {
+	if windowPolicy == nil {
+		windowPolicy = domain.NewDefaultWindowPolicy()
+	}
+
+	if timePolicy == nil {
+		timePolicy = domain.NewDefaultTimeNormalizationPolicy()
+	}
+
+	calculator := domain.NewSlideWindowWidthCalculatorWithPolicy(windowPolicy)
+
 	return &remindUseCaseImpl{
-		repo:      repo,
-		publisher: publisher,
+		repo:              repo,
+		prefsRepo:         prefsRepo,
+		templateRepo:      templateRepo,
+		quietHoursPolicy:  domain.NewQuietHoursPolicy(),
+		acknowledgePolicy: domain.NewAcknowledgePolicy(),
+		rateLimitPolicy:   rateLimitPolicy,
+		densityPolicy:     densityPolicy,
+		timePolicy:        timePolicy,
+		adaptivePolicy:    adaptivePolicy,
+		statsRepo:         statsRepo,
+		calculator:        calculator,
+		scheduleGenerator: domain.NewScheduleGenerator(calculator),
+		publisher:         publisher,
 	}
 }
 
-func (uc *remindUseCaseImpl) CreateRemind(ctx context.Context, input CreateRemindInput) (RemindsOutput, error) {
+func (uc *remindUseCaseImpl) CreateRemind(ctx context.Context, input CreateRemindInput) (CreateRemindOutput, error) {
 	slog.Debug("creating reminds",
 		"task_id", input.TaskID,
 		"user_id", input.UserID,
 		"times_count", len(input.Times),
 	)
 
-	if len(input.Times) == 0 {
-		return RemindsOutput{}, NewValidationError("times", "at least one time is required")
+	if err := validateTimesSource(input); err != nil {
+		return CreateRemindOutput{}, err
 	}
 
 	userID, err := domain.UserIDFromString(input.UserID)
 	if err != nil {
-		return RemindsOutput{}, NewValidationError("user_id", err.Error())
+		return CreateRemindOutput{}, NewValidationError("user_id", err.Error())
 	}
 
 	taskID, err := domain.TaskIDFromString(input.TaskID)
 	if err != nil {
-		return RemindsOutput{}, NewValidationError("task_id", err.Error())
+		return CreateRemindOutput{}, NewValidationError("task_id", err.Error())
 	}
 
 	existing, err := uc.repo.FindByTaskID(ctx, taskID)
@@ -53,7 +105,7 @@ func (uc *remindUseCaseImpl) CreateRemind(ctx context.Context, input CreateRemin
 			"task_id", input.TaskID,
 		)
 
-		return RemindsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+		return CreateRemindOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
 	}
 
 	if len(existing) > 0 {
@@ -62,37 +114,219 @@ func (uc *remindUseCaseImpl) CreateRemind(ctx context.Context, input CreateRemin
 			"count", len(existing),
 		)
 
-		return FromEntities(existing), nil
+		return CreateRemindOutput{RemindsOutput: FromEntities(existing)}, nil
 	}
 
-	devices := make([]domain.Device, 0, len(input.Devices))
-	for i, d := range input.Devices {
-		device, err := domain.NewDevice(d.DeviceID, d.FCMToken)
-		if err != nil {
-			return RemindsOutput{}, NewValidationError(
-				fmt.Sprintf("devices[%d]", i), err.Error(),
-			)
+	plan, err := uc.planReminds(ctx, input, userID, taskID)
+	if err != nil {
+		return CreateRemindOutput{}, err
+	}
+
+	if err := uc.repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
+		for _, remind := range plan.reminds {
+			if err := txRepo.Save(ctx, remind); err != nil {
+				slog.Error("failed to save remind",
+					"error", err,
+					"task_id", input.TaskID,
+					"remind_id", remind.ID().String(),
+				)
+
+				return err
+			}
 		}
 
-		devices = append(devices, device)
+		return nil
+	}); err != nil {
+		return CreateRemindOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
 	}
 
-	deviceCollection, err := domain.NewDevices(devices)
+	slog.Debug("reminds created",
+		"task_id", input.TaskID,
+		"count", len(plan.reminds),
+	)
+
+	return plan.toCreateOutput(), nil
+}
+
+// PreviewRemind runs CreateRemind's validation and planning and returns the
+// reminds it would create without saving them. Existing reminds of the task
+// are ignored; the rate limit and density policies still read stored reminds.
+func (uc *remindUseCaseImpl) PreviewRemind(ctx context.Context, input CreateRemindInput) (RemindPreviewOutput, error) {
+	slog.Debug("previewing reminds",
+		"task_id", input.TaskID,
+		"user_id", input.UserID,
+		"times_count", len(input.Times),
+	)
+
+	if err := validateTimesSource(input); err != nil {
+		return RemindPreviewOutput{}, err
+	}
+
+	userID, err := domain.UserIDFromString(input.UserID)
 	if err != nil {
-		return RemindsOutput{}, NewValidationError("devices", err.Error())
+		return RemindPreviewOutput{}, NewValidationError("user_id", err.Error())
 	}
 
+	taskID, err := domain.TaskIDFromString(input.TaskID)
+	if err != nil {
+		return RemindPreviewOutput{}, NewValidationError("task_id", err.Error())
+	}
+
+	plan, err := uc.planReminds(ctx, input, userID, taskID)
+	if err != nil {
+		return RemindPreviewOutput{}, err
+	}
+
+	return plan.toPreviewOutput(), nil
+}
+
+// remindPlan is what a create request resolves to before anything is saved:
+// the reminds, plus what each policy changed on the way for previews.
+type remindPlan struct {
+	reminds     []*domain.Remind
+	requested   []time.Time
+	dropped     []time.Time
+	shrunk      []time.Time // times whose windows were shrunk for overlapping
+	outcomes    []domain.CatchUpOutcome
+	adjustments map[time.Time][]string
+	// windowExplanation is the adaptive window policy's reason.
+	windowExplanation string
+}
+
+// planReminds builds the task's reminds from the request: times are resolved,
+// normalized, caught up if past, moved by quiet hours and rate limiting, and
+// then given their widths. Earlier reminds whose windows would overlap the
+// next one are shrunk, or dropped when no minimal window fits.
+func (uc *remindUseCaseImpl) planReminds(
+	ctx context.Context,
+	input CreateRemindInput,
+	userID domain.UserID,
+	taskID domain.TaskID,
+) (remindPlan, error) {
 	taskType, err := domain.NewType(input.TaskType)
 	if err != nil {
-		return RemindsOutput{}, NewValidationError("task_type", err.Error())
+		return remindPlan{}, NewValidationError("task_type", err.Error())
+	}
+
+	resolved, err := uc.resolveTimes(ctx, input, taskType)
+	if err != nil {
+		return remindPlan{}, err
 	}
 
-	calculator := domain.NewSlideWindowWidthCalculator()
-	slideWindowWidths := calculator.CalculateSlideWindowWidths(input.Times, taskType)
+	now := time.Now()
 
-	reminds := make([]*domain.Remind, 0, len(input.Times))
-	for i, t := range input.Times {
-		slideWindowWidth := slideWindowWidths[t]
+	requested, err := uc.normalizeTimes(input, resolved, taskType, now)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	catchUp, err := domain.NewCatchUpPolicy(input.CatchUp)
+	if err != nil {
+		return remindPlan{}, NewValidationError("catch_up", err.Error())
+	}
+
+	caughtUp, outcomes := catchUp.Apply(requested, uc.timePolicy.NormalizeTime(now), uc.timePolicy.MinSpacing(taskType))
+	if len(caughtUp) == 0 {
+		return remindPlan{}, NewValidationError("times", domain.ErrAllTimesPast.Error())
+	}
+
+	escalationPolicy, err := toEscalationPolicy(input.Escalation, taskType)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	payload, err := toPayload(input.Payload)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	prefs, err := uc.loadPreferences(ctx, userID)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	deviceCollection, err := resolveDevices(input.Devices, prefs)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	quiet, err := uc.applyQuietHours(userID, caughtUp, taskType, prefs)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	placed, rateLimited, err := uc.applyRateLimit(ctx, userID, quiet, taskType)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	// Shifted times may have moved too close to one another.
+	times, crowded := uc.timePolicy.Space(slices.SortedFunc(maps.Values(placed), time.Time.Compare), taskType)
+
+	// Widths are calculated after quiet hours and rate limiting so shifted
+	// reminds get widths matching their new intervals.
+	override := windowOverride(prefs, taskType)
+	calculated := uc.calculator.CalculateSlideWindowWidthsWithOverride(times, taskType, override)
+
+	adapted, explanation, err := uc.applyAdaptive(ctx, userID, taskType, override, calculated)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	slideWindowWidths, err := uc.applyDensity(ctx, adapted, taskType, override)
+	if err != nil {
+		return remindPlan{}, err
+	}
+
+	plan := remindPlan{
+		reminds:           make([]*domain.Remind, 0, len(times)),
+		requested:         requested,
+		adjustments:       make(map[time.Time][]string, len(times)),
+		windowExplanation: explanation,
+	}
+
+	for _, outcome := range outcomes {
+		if outcome.Result == domain.CatchUpDropped {
+			plan.dropped = append(plan.dropped, outcome.Requested)
+		}
+	}
+
+	if uc.quietHoursPolicy.Action(taskType) == domain.QuietHoursDrop {
+		plan.dropped = append(plan.dropped, missingTimes(caughtUp, quiet)...)
+	}
+
+	plan.dropped = append(plan.dropped, rateLimited...)
+	plan.dropped = append(plan.dropped, crowded...)
+
+	clamped := clampedTimes(outcomes)
+
+	// A clamped remind is already late; it fires now with a minimal window.
+	for _, t := range clamped {
+		if _, ok := slideWindowWidths[t]; ok {
+			slideWindowWidths[t] = domain.MustSlideWindowWidth(domain.MinSlideWindowWidth)
+		}
+	}
+
+	resolvedWidths, overlaps := domain.ResolveWindowOverlaps(times, slideWindowWidths)
+	if len(overlaps) > 0 {
+		slog.Debug("overlapping slide windows resolved",
+			"task_id", taskID.String(),
+			"adjusted", len(overlaps),
+		)
+	}
+
+	for i, t := range times {
+		slideWindowWidth, ok := resolvedWidths[t]
+		if !ok {
+			plan.dropped = append(plan.dropped, t)
+
+			continue
+		}
+
+		isClamped := slices.ContainsFunc(clamped, t.Equal)
+		isAdapted := !isClamped && calculated[t] != adapted[t]
+		widened := !isClamped && adapted[t] != slideWindowWidths[t]
+		shrunk := slideWindowWidth != slideWindowWidths[t]
 
 		remind, err := domain.NewRemind(
 			t,
@@ -103,48 +337,589 @@ func (uc *remindUseCaseImpl) CreateRemind(ctx context.Context, input CreateRemin
 			slideWindowWidth,
 		)
 		if err != nil {
-			return RemindsOutput{}, NewValidationError(
+			return remindPlan{}, NewValidationError(
 				fmt.Sprintf("times[%d]", i), err.Error(),
 			)
 		}
 
-		reminds = append(reminds, remind)
+		remind.AssignPayload(payload)
+
+		// Reminds created while the user is paused wait for the resume too.
+		if prefs != nil && prefs.IsPaused() {
+			remind.Pause()
+		}
+
+		if shrunk {
+			plan.shrunk = append(plan.shrunk, t)
+		}
+
+		plan.reminds = append(plan.reminds, remind)
+		plan.adjustments[t] = adjustmentsOf(t, caughtUp, quiet, isClamped, isAdapted, widened, shrunk)
 	}
 
-	if err := uc.repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
-		for _, remind := range reminds {
-			if err := txRepo.Save(ctx, remind); err != nil {
-				slog.Error("failed to save remind",
-					"error", err,
-					"task_id", input.TaskID,
-					"remind_id", remind.ID().String(),
-				)
+	// Outcomes report where each caught-up time finally landed, after quiet
+	// hours, the rate limit and overlap resolution.
+	plan.outcomes = finalOutcomes(outcomes, func(t time.Time) (time.Time, bool) {
+		quietAt, ok := uc.quietHoursPolicy.Place(t, taskType, prefs)
+		if !ok {
+			return time.Time{}, false
+		}
 
-				return err
+		at, ok := placed[quietAt]
+		if !ok {
+			return time.Time{}, false
+		}
+
+		_, ok = resolvedWidths[at]
+
+		return at, ok
+	})
+
+	// Only the TargetAt remind escalates; earlier ones are followed by later
+	// reminds anyway.
+	latest := slices.MaxFunc(plan.reminds, func(a, b *domain.Remind) int {
+		return a.Time().Compare(b.Time())
+	})
+	latest.AssignEscalationPolicy(escalationPolicy)
+
+	return plan, nil
+}
+
+// adjustmentsOf names the policies that moved, widened or shrank the remind at t. A
+// time missing from the quiet hours result was moved by the rate limit.
+func adjustmentsOf(t time.Time, caughtUp, quiet []time.Time, clamped, adapted, widened, shrunk bool) []string {
+	var adjustments []string
+
+	switch {
+	case !slices.ContainsFunc(quiet, t.Equal):
+		adjustments = append(adjustments, AdjustmentRateLimit)
+	case !slices.ContainsFunc(caughtUp, t.Equal):
+		adjustments = append(adjustments, AdjustmentQuietHours)
+	case clamped:
+		adjustments = append(adjustments, AdjustmentCatchUp)
+	}
+
+	if adapted {
+		adjustments = append(adjustments, AdjustmentAdaptive)
+	}
+
+	if widened {
+		adjustments = append(adjustments, AdjustmentDensity)
+	}
+
+	if shrunk {
+		adjustments = append(adjustments, AdjustmentOverlap)
+	}
+
+	return adjustments
+}
+
+// unmoved places every time where it is.
+func unmoved(times []time.Time) map[time.Time]time.Time {
+	placed := make(map[time.Time]time.Time, len(times))
+	for _, t := range times {
+		placed[t] = t
+	}
+
+	return placed
+}
+
+// finalOutcomes follows the time of each kept or clamped outcome to where
+// land places it; a time that does not land is reported as dropped.
+func finalOutcomes(
+	outcomes []domain.CatchUpOutcome,
+	land func(time.Time) (time.Time, bool),
+) []domain.CatchUpOutcome {
+	final := make([]domain.CatchUpOutcome, 0, len(outcomes))
+
+	for _, outcome := range outcomes {
+		if outcome.Result != domain.CatchUpDropped {
+			if at, ok := land(outcome.Time); ok {
+				outcome.Time = at
+			} else {
+				outcome.Result = domain.CatchUpDropped
+				outcome.Time = time.Time{}
 			}
 		}
 
+		final = append(final, outcome)
+	}
+
+	return final
+}
+
+// clampedTimes returns the times the catch-up policy moved past times to.
+func clampedTimes(outcomes []domain.CatchUpOutcome) []time.Time {
+	var clamped []time.Time
+
+	for _, outcome := range outcomes {
+		if outcome.Result == domain.CatchUpClamped {
+			clamped = append(clamped, outcome.Time)
+		}
+	}
+
+	return clamped
+}
+
+// missingTimes returns the times of from that are not in to.
+func missingTimes(from, to []time.Time) []time.Time {
+	var missing []time.Time
+
+	for _, t := range from {
+		if !slices.ContainsFunc(to, t.Equal) {
+			missing = append(missing, t)
+		}
+	}
+
+	return missing
+}
+
+func toEscalationPolicy(input *EscalationInput, taskType domain.Type) (domain.EscalationPolicy, error) {
+	if input == nil {
+		return domain.DefaultEscalationPolicy(taskType), nil
+	}
+
+	steps := make([]domain.EscalationAction, 0, len(input.Steps))
+	for i, s := range input.Steps {
+		step, err := domain.NewEscalationAction(s)
+		if err != nil {
+			return domain.EscalationPolicy{}, NewValidationError(
+				fmt.Sprintf("escalation.steps[%d]", i), err.Error(),
+			)
+		}
+
+		steps = append(steps, step)
+	}
+
+	policy, err := domain.NewEscalationPolicy(time.Duration(input.AfterSeconds)*time.Second, steps)
+	if err != nil {
+		return domain.EscalationPolicy{}, NewValidationError("escalation", err.Error())
+	}
+
+	return policy, nil
+}
+
+func toPayload(input *PayloadInput) (domain.Payload, error) {
+	if input == nil {
+		return domain.Payload{}, nil
+	}
+
+	payload, err := domain.NewPayload(input.Title, input.Body, input.DeepLink, input.Locale, input.Data)
+	if err != nil {
+		return domain.Payload{}, NewValidationError("payload", err.Error())
+	}
+
+	return payload, nil
+}
+
+// validateTimesSource checks that the request gives either explicit times, or
+// a target time with exactly one of a template and the automatic schedule.
+func validateTimesSource(input CreateRemindInput) error {
+	if input.Template != "" && input.AutoSchedule {
+		return NewValidationError("auto_schedule", "template and auto_schedule are mutually exclusive")
+	}
+
+	var field string
+
+	switch {
+	case input.Template != "":
+		field = "template"
+	case input.AutoSchedule:
+		field = "auto_schedule"
+	default:
+		if len(input.Times) == 0 {
+			return NewValidationError("times", "at least one time is required")
+		}
+
 		return nil
-	}); err != nil {
-		return RemindsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
 	}
 
-	slog.Debug("reminds created",
-		"task_id", input.TaskID,
-		"count", len(reminds),
+	if len(input.Times) > 0 {
+		return NewValidationError(field, "times and "+field+" are mutually exclusive")
+	}
+
+	if input.TargetAt == nil {
+		return NewValidationError("target_at", "target_at is required with "+field)
+	}
+
+	return nil
+}
+
+// normalizeTimes truncates and de-duplicates the resolved times and enforces
+// the count, horizon and spacing limits. Errors name the offending explicit
+// time, or the template or auto_schedule that generated it.
+func (uc *remindUseCaseImpl) normalizeTimes(
+	input CreateRemindInput,
+	times []time.Time,
+	taskType domain.Type,
+	now time.Time,
+) ([]time.Time, error) {
+	normalized, err := uc.timePolicy.Normalize(times, taskType, now)
+	if err == nil {
+		return normalized, nil
+	}
+
+	field := "times"
+
+	switch {
+	case input.Template != "":
+		field = "template"
+	case input.AutoSchedule:
+		field = "auto_schedule"
+	}
+
+	var timeErr *domain.RemindTimeError
+	if field == "times" && errors.As(err, &timeErr) {
+		return nil, NewValidationError(fmt.Sprintf("times[%d]", timeErr.Index), timeErr.Err.Error())
+	}
+
+	return nil, NewValidationError(field, err.Error())
+}
+
+// resolveTimes returns the explicit times, or generates them from the named
+// template or the automatic schedule; generated times then go through quiet
+// hours, rate limiting and width calculation exactly like explicit ones.
+func (uc *remindUseCaseImpl) resolveTimes(
+	ctx context.Context,
+	input CreateRemindInput,
+	taskType domain.Type,
+) ([]time.Time, error) {
+	if input.AutoSchedule {
+		times, err := uc.scheduleGenerator.Times(*input.TargetAt, time.Now(), taskType)
+		if err != nil {
+			return nil, NewValidationError("target_at", err.Error())
+		}
+
+		return times, nil
+	}
+
+	if input.Template == "" {
+		return input.Times, nil
+	}
+
+	if uc.templateRepo == nil {
+		return nil, NewValidationError("template", "remind templates are not available")
+	}
+
+	name, err := domain.NewTemplateName(input.Template)
+	if err != nil {
+		return nil, NewValidationError("template", err.Error())
+	}
+
+	template, err := uc.templateRepo.FindByName(ctx, name)
+	if err != nil {
+		if errors.Is(err, domain.ErrRemindTemplateNotFound) {
+			return nil, NewValidationError("template", err.Error())
+		}
+
+		slog.Error("failed to load remind template",
+			"error", err,
+			"template", input.Template,
+		)
+
+		return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	times, err := template.TimesFor(*input.TargetAt, taskType, time.Now())
+	if err != nil {
+		return nil, NewValidationError("template", err.Error())
+	}
+
+	slog.Debug("remind times generated from template",
+		"template", input.Template,
+		"target_at", *input.TargetAt,
+		"times_count", len(times),
 	)
 
-	return FromEntities(reminds), nil
+	return times, nil
+}
+
+// loadPreferences returns nil when the user has not stored any preferences.
+func (uc *remindUseCaseImpl) loadPreferences(
+	ctx context.Context,
+	userID domain.UserID,
+) (*domain.UserPreferences, error) {
+	if uc.prefsRepo == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	prefs, err := uc.prefsRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserPreferencesNotFound) {
+			return nil, nil //nolint:nilnil
+		}
+
+		slog.Error("failed to load user preferences",
+			"error", err,
+			"user_id", userID.String(),
+		)
+
+		return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return prefs, nil
+}
+
+// resolveDevices falls back to the user's default devices when the request
+// carries none.
+func resolveDevices(inputs []DeviceInput, prefs *domain.UserPreferences) (domain.Devices, error) {
+	if len(inputs) == 0 {
+		if prefs == nil || !prefs.HasDefaultDevices() {
+			return nil, NewValidationError("devices", "no devices given and no default devices stored")
+		}
+
+		return prefs.DefaultDevices(), nil
+	}
+
+	devices := make([]domain.Device, 0, len(inputs))
+	for i, d := range inputs {
+		device, err := domain.NewDevice(d.DeviceID, d.FCMToken)
+		if err != nil {
+			return nil, NewValidationError(
+				fmt.Sprintf("devices[%d]", i), err.Error(),
+			)
+		}
+
+		devices = append(devices, device)
+	}
+
+	deviceCollection, err := domain.NewDevices(devices)
+	if err != nil {
+		return nil, NewValidationError("devices", err.Error())
+	}
+
+	return deviceCollection, nil
+}
+
+func windowOverride(prefs *domain.UserPreferences, taskType domain.Type) domain.WindowOverride {
+	if prefs == nil {
+		return domain.WindowOverride{}
+	}
+
+	return prefs.WindowOverride(taskType)
+}
+
+func (uc *remindUseCaseImpl) applyQuietHours(
+	userID domain.UserID,
+	times []time.Time,
+	taskType domain.Type,
+	prefs *domain.UserPreferences,
+) ([]time.Time, error) {
+	adjusted := uc.quietHoursPolicy.Apply(times, taskType, prefs)
+	if len(adjusted) == 0 {
+		return nil, NewValidationError("times", domain.ErrAllTimesInQuietHours.Error())
+	}
+
+	if !slices.EqualFunc(adjusted, times, time.Time.Equal) {
+		slog.Info("remind times adjusted for quiet hours",
+			"user_id", userID.String(),
+			"task_type", string(taskType),
+			"action", string(uc.quietHoursPolicy.Action(taskType)),
+			"requested_count", len(times),
+			"scheduled_count", len(adjusted),
+		)
+	}
+
+	return adjusted, nil
+}
+
+// applyRateLimit spreads the times so the user's reminds stay within the
+// task type's rate limit, counting the reminds already scheduled. It returns
+// where each time went and the times that found no room.
+func (uc *remindUseCaseImpl) applyRateLimit(
+	ctx context.Context,
+	userID domain.UserID,
+	times []time.Time,
+	taskType domain.Type,
+) (map[time.Time]time.Time, []time.Time, error) {
+	if uc.rateLimitPolicy == nil || len(times) == 0 {
+		return unmoved(times), nil, nil
+	}
+
+	limit := uc.rateLimitPolicy.Limit(taskType)
+	if limit.IsUnlimited() {
+		return unmoved(times), nil, nil
+	}
+
+	// Every window containing a spread time lies within a window of the
+	// requested span, so one query covers them all.
+	stored, err := uc.repo.CountByUserIDPerTime(ctx, userID, domain.TimeRange{
+		Start: slices.MinFunc(times, time.Time.Compare).Add(-limit.Window()),
+		End:   slices.MaxFunc(times, time.Time.Compare).Add(limit.Window()),
+	})
+	if err != nil {
+		slog.Error("failed to apply rate limit",
+			"error", err,
+			"user_id", userID.String(),
+		)
+
+		return nil, nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	placed, dropped := uc.rateLimitPolicy.Spread(times, taskType, uc.timePolicy.MinSpacing(taskType), stored)
+
+	moved := len(dropped) > 0
+	for from, to := range placed {
+		moved = moved || !from.Equal(to)
+	}
+
+	if moved {
+		slog.Info("remind times spread for rate limit",
+			"user_id", userID.String(),
+			"task_type", string(taskType),
+			"max", limit.Max(),
+			"window", limit.Window(),
+			"dropped", len(dropped),
+		)
+	}
+
+	return placed, dropped, nil
+}
+
+// applyAdaptive scales the widths by the user's delivery history of the task
+// type and returns why. A user's own window override takes precedence.
+func (uc *remindUseCaseImpl) applyAdaptive(
+	ctx context.Context,
+	userID domain.UserID,
+	taskType domain.Type,
+	override domain.WindowOverride,
+	widths map[time.Time]domain.SlideWindowWidth,
+) (map[time.Time]domain.SlideWindowWidth, string, error) {
+	if uc.adaptivePolicy == nil || uc.statsRepo == nil {
+		return widths, "", nil
+	}
+
+	if !override.IsZero() {
+		return widths, "unchanged: user window override in effect", nil
+	}
+
+	stats, err := uc.statsRepo.Find(ctx, userID, taskType)
+	if err != nil && !errors.Is(err, domain.ErrWindowStatsNotFound) {
+		slog.Error("failed to load window stats",
+			"error", err,
+			"user_id", userID.String(),
+			"task_type", string(taskType),
+		)
+
+		return nil, "", fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	adaptation := uc.adaptivePolicy.Adapt(stats)
+
+	if adaptation.Factor != 1 {
+		slog.Debug("slide windows adapted to delivery history",
+			"user_id", userID.String(),
+			"task_type", string(taskType),
+			"factor", adaptation.Factor,
+		)
+	}
+
+	return adaptation.Apply(widths, uc.calculator.MaxSlideWindowWidth(taskType, override)), adaptation.Explanation, nil
+}
+
+// applyDensity widens the windows of reminds landing in minutes where many
+// reminds of all users already cluster.
+func (uc *remindUseCaseImpl) applyDensity(
+	ctx context.Context,
+	widths map[time.Time]domain.SlideWindowWidth,
+	taskType domain.Type,
+	override domain.WindowOverride,
+) (map[time.Time]domain.SlideWindowWidth, error) {
+	if uc.densityPolicy == nil || !uc.densityPolicy.Applies(taskType) {
+		return widths, nil
+	}
+
+	widened, err := uc.densityPolicy.Widen(
+		widths,
+		taskType,
+		uc.calculator.MaxSlideWindowWidth(taskType, override),
+		func(buckets []time.Time) (map[time.Time]int, error) {
+			return uc.repo.CountByMinutes(ctx, buckets)
+		},
+	)
+	if err != nil {
+		slog.Error("failed to apply schedule density",
+			"error", err,
+			"task_type", string(taskType),
+		)
+
+		return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	for t, width := range widened {
+		if width != widths[t] {
+			slog.Debug("slide window widened for schedule density",
+				"time", t,
+				"width_seconds", width.Seconds(),
+			)
+		}
+	}
+
+	return widened, nil
+}
+
+// PreviewSchedule returns the automatic schedule for a deadline without
+// creating reminds. User preferences such as quiet hours are not applied.
+func (uc *remindUseCaseImpl) PreviewSchedule(
+	_ context.Context,
+	input PreviewScheduleInput,
+) (ScheduleOutput, error) {
+	slog.Debug("previewing schedule",
+		"deadline", input.Deadline,
+		"task_type", input.TaskType,
+	)
+
+	taskType, err := domain.NewType(input.TaskType)
+	if err != nil {
+		return ScheduleOutput{}, NewValidationError("task_type", err.Error())
+	}
+
+	schedule, err := uc.scheduleGenerator.Schedule(input.Deadline, time.Now(), taskType)
+	if err != nil {
+		return ScheduleOutput{}, NewValidationError("deadline", err.Error())
+	}
+
+	return FromSchedule(schedule), nil
 }
 
 func (uc *remindUseCaseImpl) GetRemindsByTimeRange(ctx context.Context, input GetRemindsByTimeRangeInput) (RemindsOutput, error) {
+	reminds, err := uc.findByTimeRange(ctx, input)
+	if err != nil {
+		return RemindsOutput{}, err
+	}
+
+	return FromEntities(reminds), nil
+}
+
+// GetRemindDigestsByTimeRange groups the reminds in the range into per-user
+// digests of reminds whose slide windows overlap.
+func (uc *remindUseCaseImpl) GetRemindDigestsByTimeRange(
+	ctx context.Context,
+	input GetRemindsByTimeRangeInput,
+) (DigestsOutput, error) {
+	reminds, err := uc.findByTimeRange(ctx, input)
+	if err != nil {
+		return DigestsOutput{}, err
+	}
+
+	digests := domain.BuildDigests(reminds)
+
+	slog.Debug("reminds grouped into digests",
+		"reminds_count", len(reminds),
+		"digests_count", len(digests),
+	)
+
+	return FromDigests(digests), nil
+}
+
+func (uc *remindUseCaseImpl) findByTimeRange(ctx context.Context, input GetRemindsByTimeRangeInput) ([]*domain.Remind, error) {
 	slog.Debug("getting reminds by time range",
 		"start", input.Start,
 		"end", input.End,
 	)
 
 	if input.Start.After(input.End) {
-		return RemindsOutput{}, NewValidationError("time_range", domain.ErrInvalidTimeRange.Error())
+		return nil, NewValidationError("time_range", domain.ErrInvalidTimeRange.Error())
 	}
 
 	timeRange := domain.TimeRange{
@@ -160,7 +935,7 @@ func (uc *remindUseCaseImpl) GetRemindsByTimeRange(ctx context.Context, input Ge
 			"end", input.End,
 		)
 
-		return RemindsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+		return nil, fmt.Errorf("%w: %v", ErrInternalError, err)
 	}
 
 	slog.Debug("reminds retrieved",
@@ -169,6 +944,31 @@ func (uc *remindUseCaseImpl) GetRemindsByTimeRange(ctx context.Context, input Ge
 		"end", input.End,
 	)
 
+	return reminds, nil
+}
+
+// GetDueReminds returns the reminds whose slide window contains the instant,
+// as defined by Remind.IsDueAt.
+func (uc *remindUseCaseImpl) GetDueReminds(ctx context.Context, input GetDueRemindsInput) (RemindsOutput, error) {
+	slog.Debug("getting due reminds",
+		"at", input.At,
+		"exclude_throttled", input.ExcludeThrottled,
+	)
+
+	if input.At.IsZero() {
+		return RemindsOutput{}, NewValidationError("at", "at is required")
+	}
+
+	reminds, err := uc.repo.FindDueAt(ctx, input.At, input.ExcludeThrottled)
+	if err != nil {
+		slog.Error("failed to get due reminds",
+			"error", err,
+			"at", input.At,
+		)
+
+		return RemindsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
 	return FromEntities(reminds), nil
 }
 
@@ -205,7 +1005,7 @@ func (uc *remindUseCaseImpl) UpdateThrottled(ctx context.Context, input UpdateTh
 		}
 	}
 
-	if err := uc.repo.Update(ctx, remind); err != nil {
+	if err := uc.repo.Update(ctx, remind, domain.RemindFieldThrottled); err != nil {
 		slog.Error("failed to update throttled status",
 			"error", err,
 			"remind_id", input.ID,
@@ -280,32 +1080,585 @@ func (uc *remindUseCaseImpl) CancelRemindByTaskID(ctx context.Context, input Can
 		return fmt.Errorf("%w: %v", ErrInternalError, err)
 	}
 
-	if uc.publisher != nil && len(deletedIDs) > 0 {
-		remindIDStrings := make([]string, len(deletedIDs))
-		for i, id := range deletedIDs {
-			remindIDStrings[i] = id.String()
+	uc.publishRemindCancelled(ctx, input.TaskID, input.UserID, deletedIDs)
+
+	slog.Info("reminds canceled by task ID",
+		"task_id", input.TaskID,
+		"user_id", input.UserID,
+		"deleted_count", len(deletedIDs),
+	)
+
+	return nil
+}
+
+func (uc *remindUseCaseImpl) AcknowledgeRemind(
+	ctx context.Context,
+	input AcknowledgeRemindInput,
+) (AcknowledgeRemindOutput, error) {
+	slog.Debug("acknowledging remind",
+		"remind_id", input.ID,
+	)
+
+	remindID, err := domain.RemindIDFromString(input.ID)
+	if err != nil {
+		return AcknowledgeRemindOutput{}, NewValidationError("id", err.Error())
+	}
+
+	remind, err := uc.repo.FindByID(ctx, remindID)
+	if err != nil {
+		if errors.Is(err, domain.ErrRemindNotFound) {
+			return AcknowledgeRemindOutput{}, fmt.Errorf("%w: %v", ErrNotFound, err)
+		}
+
+		slog.Error("failed to find remind for acknowledgment",
+			"error", err,
+			"remind_id", input.ID,
+		)
+
+		return AcknowledgeRemindOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	now := time.Now()
+
+	if err := remind.Acknowledge(now); err != nil {
+		if !errors.Is(err, domain.ErrAlreadyAcknowledged) {
+			return AcknowledgeRemindOutput{}, NewValidationError("id", err.Error())
+		}
+
+		slog.Info("remind already acknowledged (idempotency)",
+			"remind_id", input.ID,
+		)
+
+		return AcknowledgeRemindOutput{
+			Remind:             FromEntity(remind),
+			CancelledRemindIDs: []string{},
+		}, nil
+	}
+
+	var cancelledIDs []domain.RemindID
+
+	if err := uc.repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
+		if err := txRepo.Update(ctx, remind, domain.RemindFieldAcknowledgedAt); err != nil {
+			return err
+		}
+
+		if !uc.acknowledgePolicy.CancelsRemaining(remind.TaskType()) {
+			return nil
 		}
 
-		req := &throttlev1.CancelRemindRequest{
-			TaskId:       input.TaskID,
-			UserId:       input.UserID,
-			DeletedCount: int64(len(deletedIDs)),
-			CancelledAt:  timestamppb.Now(),
-			RemindIds:    remindIDStrings,
+		// Every other remind still to come is cancelled, including ones
+		// scheduled before a remind acknowledged ahead of its time.
+		cancelledIDs, err = txRepo.DeleteByTaskIDAfter(ctx, remind.TaskID(), now, remind.ID())
+
+		return err
+	}); err != nil {
+		slog.Error("failed to acknowledge remind",
+			"error", err,
+			"remind_id", input.ID,
+		)
+
+		return AcknowledgeRemindOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	uc.publishRemindCancelled(ctx, remind.TaskID().String(), remind.UserID().String(), cancelledIDs)
+
+	slog.Info("remind acknowledged",
+		"remind_id", input.ID,
+		"task_id", remind.TaskID().String(),
+		"action", string(uc.acknowledgePolicy.Action(remind.TaskType())),
+		"cancelled_count", len(cancelledIDs),
+	)
+
+	return AcknowledgeRemindOutput{
+		Remind:             FromEntity(remind),
+		CancelledRemindIDs: remindIDStrings(cancelledIDs),
+	}, nil
+}
+
+// publishRemindCancelled notifies downstream services of deleted reminds.
+// Failures are logged only; the deletion has already been committed.
+func (uc *remindUseCaseImpl) publishRemindCancelled(
+	ctx context.Context,
+	taskID string,
+	userID string,
+	deletedIDs []domain.RemindID,
+) {
+	if uc.publisher == nil || len(deletedIDs) == 0 {
+		return
+	}
+
+	req := &throttlev1.CancelRemindRequest{
+		TaskId:       taskID,
+		UserId:       userID,
+		DeletedCount: int64(len(deletedIDs)),
+		CancelledAt:  timestamppb.Now(),
+		RemindIds:    remindIDStrings(deletedIDs),
+	}
+	if pubErr := uc.publisher.PublishRemindCancelled(ctx, req); pubErr != nil {
+		slog.Error("failed to publish remind cancelled event",
+			"task_id", taskID,
+			"error", pubErr.Error(),
+		)
+	}
+}
+
+func remindIDStrings(ids []domain.RemindID) []string {
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.String()
+	}
+
+	return result
+}
+
+func (uc *remindUseCaseImpl) EscalateOverdueReminds(
+	ctx context.Context,
+	input EscalateOverdueRemindsInput,
+) (EscalateOverdueRemindsOutput, error) {
+	reminds, err := uc.repo.FindEscalationDue(ctx, input.Now, input.BatchSize)
+	if err != nil {
+		slog.Error("failed to find reminds due for escalation",
+			"error", err,
+		)
+
+		return EscalateOverdueRemindsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	var output EscalateOverdueRemindsOutput
+
+	for _, remind := range reminds {
+		followUp, err := uc.escalateRemind(ctx, remind, input.Now)
+		if errors.Is(err, domain.ErrRemindNotFound) {
+			// Escalated by another instance, acknowledged or paused since it
+			// was found.
+			continue
 		}
-		if pubErr := uc.publisher.PublishRemindCancelled(ctx, req); pubErr != nil {
-			slog.Error("failed to publish remind cancelled event",
-				"task_id", input.TaskID,
-				"error", pubErr.Error(),
+
+		if err != nil {
+			slog.Error("failed to escalate remind",
+				"error", err,
+				"remind_id", remind.ID().String(),
 			)
+
+			output.Failed++
+
+			continue
+		}
+
+		output.Escalated++
+
+		if followUp != nil {
+			output.FollowUps++
 		}
 	}
 
-	slog.Info("reminds canceled by task ID",
-		"task_id", input.TaskID,
-		"user_id", input.UserID,
-		"deleted_count", len(deletedIDs),
+	if output.Escalated > 0 || output.Failed > 0 {
+		slog.Info("overdue reminds escalated",
+			"escalated", output.Escalated,
+			"follow_ups", output.FollowUps,
+			"failed", output.Failed,
+		)
+	}
+
+	return output, nil
+}
+
+// escalateRemind runs the next escalation step of a remind found due. The
+// remind is claimed in the transaction that escalates it, so instances
+// running the job side by side never escalate it twice; it returns
+// domain.ErrRemindNotFound when the claim fails.
+func (uc *remindUseCaseImpl) escalateRemind(
+	ctx context.Context,
+	due *domain.Remind,
+	now time.Time,
+) (*domain.Remind, error) {
+	prefs, err := uc.loadPreferences(ctx, due.UserID())
+	if err != nil {
+		return nil, err
+	}
+
+	var extraDevices domain.Devices
+	if prefs != nil {
+		extraDevices = prefs.DefaultDevices()
+	}
+
+	var (
+		remind   *domain.Remind
+		followUp *domain.Remind
 	)
 
-	return nil
+	if err := uc.repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
+		remind, err = txRepo.ClaimEscalationDue(ctx, due.ID(), now)
+		if err != nil {
+			return err
+		}
+
+		step, err := remind.Escalate(now, extraDevices)
+		if err != nil {
+			return err
+		}
+
+		if err := txRepo.Update(ctx, remind, domain.RemindFieldEscalation, domain.RemindFieldDevices); err != nil {
+			return err
+		}
+
+		if step != domain.EscalationFollowUp {
+			return uc.applyEscalation(ctx, txRepo, remind, step, extraDevices, now)
+		}
+
+		at, ok, err := uc.followUpTime(ctx, txRepo, remind, prefs, now)
+		if err != nil || !ok {
+			return err
+		}
+
+		followUp, err = remind.FollowUp(at)
+		if err != nil {
+			return err
+		}
+
+		if prefs != nil && prefs.IsPaused() {
+			followUp.Pause()
+		}
+
+		return txRepo.Save(ctx, followUp)
+	}); err != nil {
+		return nil, err
+	}
+
+	slog.Debug("remind escalated",
+		"remind_id", remind.ID().String(),
+		"task_id", remind.TaskID().String(),
+		"level", remind.EscalationLevel(),
+	)
+
+	return followUp, nil
+}
+
+// applyEscalation carries an add_devices or narrow_window step of remind to
+// the task's next pending remind, which is what gets delivered next. Without
+// one, only a later follow-up carries the step.
+func (uc *remindUseCaseImpl) applyEscalation(
+	ctx context.Context,
+	repo domain.RemindRepository,
+	remind *domain.Remind,
+	step domain.EscalationAction,
+	extraDevices domain.Devices,
+	now time.Time,
+) error {
+	reminds, err := repo.FindByTaskID(ctx, remind.TaskID())
+	if err != nil {
+		return err
+	}
+
+	i := slices.IndexFunc(reminds, func(r *domain.Remind) bool {
+		return r.ID() != remind.ID() && r.IsPendingAt(now)
+	})
+	if i < 0 || !reminds[i].ApplyEscalation(step, extraDevices) {
+		return nil
+	}
+
+	slog.Debug("escalation applied to the next pending remind",
+		"remind_id", remind.ID().String(),
+		"pending_remind_id", reminds[i].ID().String(),
+		"step", string(step),
+	)
+
+	return repo.Update(ctx, reminds[i], domain.RemindFieldDevices, domain.RemindFieldSlideWindowWidth)
+}
+
+// followUpTime places the follow-up of remind like a requested time: it goes
+// through quiet hours and must fit the rate limit. It returns false when the
+// follow-up is dropped by either, or when the task already has a remind
+// within the minimum spacing.
+func (uc *remindUseCaseImpl) followUpTime(
+	ctx context.Context,
+	repo domain.RemindRepository,
+	remind *domain.Remind,
+	prefs *domain.UserPreferences,
+	now time.Time,
+) (time.Time, bool, error) {
+	taskType := remind.TaskType()
+
+	adjusted := uc.quietHoursPolicy.Apply([]time.Time{uc.timePolicy.NormalizeTime(now)}, taskType, prefs)
+	if len(adjusted) == 0 {
+		slog.Info("follow-up dropped for quiet hours",
+			"remind_id", remind.ID().String(),
+			"user_id", remind.UserID().String(),
+		)
+
+		return time.Time{}, false, nil
+	}
+
+	at := adjusted[0]
+
+	existing, err := repo.FindByTaskID(ctx, remind.TaskID())
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	minSpacing := uc.timePolicy.MinSpacing(taskType)
+
+	for _, r := range existing {
+		if gap := r.Time().Sub(at).Abs(); gap == 0 || gap < minSpacing {
+			slog.Info("follow-up dropped as the task already has a remind then",
+				"remind_id", remind.ID().String(),
+				"task_id", remind.TaskID().String(),
+				"time", at,
+			)
+
+			return time.Time{}, false, nil
+		}
+	}
+
+	if uc.rateLimitPolicy == nil {
+		return at, true, nil
+	}
+
+	limit := uc.rateLimitPolicy.Limit(taskType)
+	if limit.IsUnlimited() {
+		return at, true, nil
+	}
+
+	stored, err := repo.CountByUserIDPerTime(ctx, remind.UserID(), domain.TimeRange{
+		Start: at.Add(-limit.Window()),
+		End:   at.Add(limit.Window()),
+	})
+	if err != nil {
+		return time.Time{}, false, err
+	}
+
+	if !uc.rateLimitPolicy.Allows(at, taskType, stored) {
+		slog.Info("follow-up dropped by the rate limit",
+			"remind_id", remind.ID().String(),
+			"user_id", remind.UserID().String(),
+			"time", at,
+		)
+
+		return time.Time{}, false, nil
+	}
+
+	return at, true, nil
+}
+
+// BackfillSlideWindowWidths recalculates the widths of the future reminds of
+// the next batch of tasks with the current window, override, adaptive and
+// density policies. Widths are calculated over all of a task's reminds, as at
+// creation, but only future ones are updated.
+func (uc *remindUseCaseImpl) BackfillSlideWindowWidths(
+	ctx context.Context,
+	input BackfillSlideWindowWidthsInput,
+) (BackfillSlideWindowWidthsOutput, error) {
+	var afterTaskID domain.TaskID
+
+	if input.AfterTaskID != "" {
+		var err error
+
+		afterTaskID, err = domain.TaskIDFromString(input.AfterTaskID)
+		if err != nil {
+			return BackfillSlideWindowWidthsOutput{}, NewValidationError("after_task_id", err.Error())
+		}
+	}
+
+	taskIDs, err := uc.repo.FindTaskIDsWithRemindsAfter(ctx, input.Now, afterTaskID, input.BatchSize)
+	if err != nil {
+		slog.Error("failed to find tasks for width backfill",
+			"error", err,
+			"after_task_id", input.AfterTaskID,
+		)
+
+		return BackfillSlideWindowWidthsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	var output BackfillSlideWindowWidthsOutput
+
+	for _, taskID := range taskIDs {
+		output.Tasks++
+		output.LastTaskID = taskID.String()
+
+		task, err := uc.backfillTask(ctx, taskID, input.Now, input.DryRun)
+		if err != nil {
+			slog.Error("failed to backfill slide window widths",
+				"error", err,
+				"task_id", taskID.String(),
+			)
+
+			output.Failed++
+
+			continue
+		}
+
+		output.Reminds += task.checked
+		output.Overlap += task.overlapping
+		output.Skipped += task.skipped
+		output.Changes = append(output.Changes, task.changes...)
+	}
+
+	slog.Debug("slide window widths backfilled",
+		"tasks", output.Tasks,
+		"reminds", output.Reminds,
+		"changed", len(output.Changes),
+		"overlap", output.Overlap,
+		"skipped", output.Skipped,
+		"failed", output.Failed,
+		"dry_run", input.DryRun,
+	)
+
+	return output, nil
+}
+
+// RefreshWindowStats recomputes the delivery statistics the adaptive window
+// policy reads. It does nothing when the policy is disabled.
+func (uc *remindUseCaseImpl) RefreshWindowStats(
+	ctx context.Context,
+	input RefreshWindowStatsInput,
+) (RefreshWindowStatsOutput, error) {
+	if uc.statsRepo == nil {
+		return RefreshWindowStatsOutput{}, nil
+	}
+
+	stats, err := uc.statsRepo.Refresh(ctx, input.Since, input.Until)
+	if err != nil {
+		return RefreshWindowStatsOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	slog.Info("window stats refreshed",
+		"since", input.Since,
+		"until", input.Until,
+		"stats", stats,
+	)
+
+	return RefreshWindowStatsOutput{Stats: stats}, nil
+}
+
+// taskBackfill is the result of backfilling one task.
+type taskBackfill struct {
+	checked     int // future reminds checked
+	overlapping int // future reminds left unchanged as no width fits
+	skipped     int // changes not stored as the remind changed since it was read
+	changes     []WidthChangeOutput
+}
+
+// backfillTask checks the future reminds of the task and returns the width
+// changes, which are stored unless dryRun is set. Density counts now include
+// the task's own reminds.
+func (uc *remindUseCaseImpl) backfillTask(
+	ctx context.Context,
+	taskID domain.TaskID,
+	now time.Time,
+	dryRun bool,
+) (taskBackfill, error) {
+	reminds, err := uc.repo.FindByTaskID(ctx, taskID)
+	if err != nil || len(reminds) == 0 {
+		return taskBackfill{}, err
+	}
+
+	taskType := reminds[0].TaskType()
+
+	prefs, err := uc.loadPreferences(ctx, reminds[0].UserID())
+	if err != nil {
+		return taskBackfill{}, err
+	}
+
+	times := make([]time.Time, 0, len(reminds))
+	for _, remind := range reminds {
+		times = append(times, remind.Time())
+	}
+
+	override := windowOverride(prefs, taskType)
+
+	adapted, _, err := uc.applyAdaptive(
+		ctx,
+		reminds[0].UserID(),
+		taskType,
+		override,
+		uc.calculator.CalculateSlideWindowWidthsWithOverride(times, taskType, override),
+	)
+	if err != nil {
+		return taskBackfill{}, err
+	}
+
+	widened, err := uc.applyDensity(ctx, adapted, taskType, override)
+	if err != nil {
+		return taskBackfill{}, err
+	}
+
+	widths, _ := domain.ResolveWindowOverlaps(times, widened)
+
+	result := taskBackfill{changes: make([]WidthChangeOutput, 0, len(reminds))}
+	changed := make([]*domain.Remind, 0, len(reminds))
+	olds := make([]domain.SlideWindowWidth, 0, len(reminds))
+
+	for _, remind := range reminds {
+		// Throttled and paused reminds are not delivered, so their widths
+		// are left as they are.
+		if !remind.Time().After(now) || remind.IsThrottled() || remind.IsPaused() {
+			continue
+		}
+
+		result.checked++
+
+		// Backfill never deletes reminds, so one that overlap resolution
+		// would drop keeps its stored width and is reported instead.
+		if _, ok := widths[remind.Time()]; !ok {
+			slog.Warn("remind window overlaps the next one and was left unchanged",
+				"remind_id", remind.ID().String(),
+				"task_id", taskID.String(),
+				"time", remind.Time(),
+			)
+
+			result.overlapping++
+
+			continue
+		}
+
+		old := remind.SlideWindowWidth()
+		if !remind.ReassignSlideWindowWidth(widths[remind.Time()]) {
+			continue
+		}
+
+		changed = append(changed, remind)
+		olds = append(olds, old)
+		result.changes = append(result.changes, WidthChangeOutput{
+			RemindID: remind.ID().String(),
+			TaskID:   taskID.String(),
+			Time:     remind.Time(),
+			OldWidth: old.Seconds(),
+			NewWidth: remind.SlideWindowWidth().Seconds(),
+		})
+	}
+
+	if dryRun || len(changed) == 0 {
+		return result, nil
+	}
+
+	applied := make([]WidthChangeOutput, 0, len(changed))
+	skipped := 0
+
+	if err := uc.repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
+		for i, remind := range changed {
+			// The reminds were read without a lock, so a width is only
+			// replaced while it is still the one read.
+			ok, err := txRepo.ReassignSlideWindowWidth(ctx, remind.ID(), olds[i], remind.SlideWindowWidth())
+			if err != nil {
+				return err
+			}
+
+			if !ok {
+				skipped++
+
+				continue
+			}
+
+			applied = append(applied, result.changes[i])
+		}
+
+		return nil
+	}); err != nil {
+		return taskBackfill{}, err
+	}
+
+	result.changes = applied
+	result.skipped = skipped
+
+	return result, nil
 }
@@ -3,6 +3,7 @@ package app_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"go.uber.org/mock/gomock"
 
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
 	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
@@ -26,7 +28,8 @@ func setupUseCaseTest(t *testing.T) (app.RemindUseCase, func()) {
 	t.Helper()
 	testDB := testutil.SetupTestDB(t)
 	repo := repository.NewRemindRepository(testDB.DB)
-	useCase := app.NewRemindUseCase(repo, nil)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, repository.NewRemindTemplateRepository(testDB.DB), nil, nil, nil, nil, nil, nil, nil)
 
 	return useCase, func() {
 		testDB.CleanTable(t)
@@ -144,6 +147,1006 @@ func TestCreateRemindIdempotencySuccess(t *testing.T) {
 	}
 }
 
+// setupUseCaseTestWithQuietHours stores a two-hour quiet window for userID
+// starting at the beginning of quietStart's hour (UTC).
+func setupUseCaseTestWithQuietHours(t *testing.T, userID string, quietStart time.Time) (app.RemindUseCase, func()) {
+	t.Helper()
+	testDB := testutil.SetupTestDB(t)
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	uid, err := domain.UserIDFromString(userID)
+	require.NoError(t, err)
+
+	start := time.Duration(quietStart.UTC().Hour()) * time.Hour
+	window, err := domain.NewQuietWindow(quietStart.UTC().Weekday(), start, (start+2*time.Hour)%(24*time.Hour))
+	require.NoError(t, err)
+
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{window})
+	require.NoError(t, err)
+
+	require.NoError(t, prefsRepo.Save(context.Background(), domain.NewUserPreferences(uid, nil, domain.UTCTimezone(), quietHours, nil)))
+
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	return useCase, func() {
+		testDB.CleanTable(t)
+		testDB.TeardownTestDB(t)
+	}
+}
+
+func TestCreateRemindQuietHoursSuccess(t *testing.T) {
+	quietStart := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	quietEnd := quietStart.Add(2 * time.Hour)
+	inQuiet := quietStart.Add(30 * time.Minute)
+	beforeQuiet := quietStart.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name          string
+		taskType      string
+		times         []time.Time
+		expectedTimes []time.Time
+	}{
+		{
+			name:          "scheduled remind inside quiet hours is kept",
+			taskType:      "scheduled",
+			times:         []time.Time{inQuiet},
+			expectedTimes: []time.Time{inQuiet},
+		},
+		{
+			name:          "relaxed remind inside quiet hours is shifted to the end",
+			taskType:      "relaxed",
+			times:         []time.Time{beforeQuiet, inQuiet},
+			expectedTimes: []time.Time{beforeQuiet, quietEnd},
+		},
+		{
+			name:          "short remind inside quiet hours is dropped",
+			taskType:      "short",
+			times:         []time.Time{beforeQuiet, inQuiet},
+			expectedTimes: []time.Time{beforeQuiet},
+		},
+		{
+			name:          "shifted remind too close to the next one is dropped",
+			taskType:      "relaxed",
+			times:         []time.Time{inQuiet, quietEnd.Add(30 * time.Second)},
+			expectedTimes: []time.Time{quietEnd.Add(30 * time.Second)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := generateUUIDv7String()
+
+			useCase, cleanup := setupUseCaseTestWithQuietHours(t, userID, quietStart)
+			defer cleanup()
+
+			output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+				Times:    tt.times,
+				UserID:   userID,
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: tt.taskType,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, output.Reminds, len(tt.expectedTimes))
+
+			for i, expected := range tt.expectedTimes {
+				assert.True(t, expected.Equal(output.Reminds[i].Time), "expected %s, got %s", expected, output.Reminds[i].Time)
+			}
+		})
+	}
+}
+
+func TestCreateRemindQuietHoursError(t *testing.T) {
+	quietStart := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	userID := generateUUIDv7String()
+
+	useCase, cleanup := setupUseCaseTestWithQuietHours(t, userID, quietStart)
+	defer cleanup()
+
+	_, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{quietStart.Add(30 * time.Minute)},
+		UserID:   userID,
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "short",
+	})
+
+	var validationErr *app.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "times", validationErr.Field)
+}
+
+func TestCreateRemindDefaultDevicesSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	userID := generateUUIDv7String()
+	uid, err := domain.UserIDFromString(userID)
+	require.NoError(t, err)
+
+	device, err := domain.NewDevice("default-device", "default-token")
+	require.NoError(t, err)
+
+	override, err := domain.NewWindowOverride(2*time.Minute, 0)
+	require.NoError(t, err)
+
+	prefs := domain.NewUserPreferences(
+		uid,
+		domain.Devices{device},
+		domain.UTCTimezone(),
+		nil,
+		domain.WindowOverrides{domain.TypeNear: override},
+	)
+	require.NoError(t, prefsRepo.Save(context.Background(), prefs))
+
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+		UserID:   userID,
+		Devices:  nil,
+		TaskID:   generateUUIDv7String(),
+		TaskType: "near",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 1)
+	assert.Equal(t, []app.DeviceOutput{{DeviceID: "default-device", FCMToken: "default-token"}}, output.Reminds[0].Devices)
+	assert.Equal(t, int32(120), output.Reminds[0].SlideWindowWidth)
+}
+
+func TestCreateRemindRateLimitSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	limit, err := domain.NewRateLimit(1, time.Minute)
+	require.NoError(t, err)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	policy := domain.NewRateLimitPolicy(map[domain.Type]domain.RateLimit{domain.TypeShort: limit})
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, policy, nil, nil, nil, nil, nil, nil)
+
+	userID := generateUUIDv7String()
+	remindTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+
+	targetAt := remindTime.Add(time.Hour)
+
+	// create returns the remind before the TargetAt one, which is never moved.
+	create := func(taskType string) app.RemindOutput {
+		output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+			Times:    []time.Time{remindTime, targetAt},
+			UserID:   userID,
+			Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+			TaskID:   generateUUIDv7String(),
+			TaskType: taskType,
+		})
+		require.NoError(t, err)
+		require.Len(t, output.Reminds, 2)
+		assert.True(t, targetAt.Equal(output.Reminds[1].Time))
+
+		return output.Reminds[0]
+	}
+
+	first := create("short")
+	assert.True(t, remindTime.Equal(first.Time))
+
+	// The second short remind would share the window and is pushed back.
+	second := create("short")
+	assert.True(t, remindTime.Add(time.Minute).Equal(second.Time), "got %s", second.Time)
+
+	// Task types without a limit keep their requested time.
+	scheduled := create("scheduled")
+	assert.True(t, remindTime.Equal(scheduled.Time))
+}
+
+func hourlyTimes(n int) []time.Time {
+	times := make([]time.Time, n)
+	for i := range times {
+		times[i] = time.Now().Add(time.Duration(i+1) * time.Hour)
+	}
+
+	return times
+}
+
+func TestCreateRemindNormalizationSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	remindTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	// Times differing only below a second used to collide on the unique index.
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times: []time.Time{
+			remindTime.Add(2 * time.Hour),
+			remindTime.Add(400 * time.Millisecond).In(tokyo),
+			remindTime,
+		},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "near",
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 2)
+
+	assert.True(t, remindTime.Equal(output.Reminds[0].Time))
+	assert.Equal(t, time.UTC, output.Reminds[0].Time.Location())
+	assert.True(t, remindTime.Add(2*time.Hour).Equal(output.Reminds[1].Time))
+}
+
+func TestCreateRemindCatchUpSuccess(t *testing.T) {
+	past := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	future := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name             string
+		catchUp          string
+		expectedCount    int
+		expectedResult   string
+		expectedMinWidth bool
+	}{
+		{
+			name:           "drop keeps the future times",
+			catchUp:        "drop",
+			expectedCount:  1,
+			expectedResult: "dropped",
+		},
+		{
+			name:             "clamp fires the past time now",
+			catchUp:          "clamp",
+			expectedCount:    2,
+			expectedResult:   "clamped",
+			expectedMinWidth: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+				Times:    []time.Time{past, future},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+				CatchUp:  tt.catchUp,
+			})
+			require.NoError(t, err)
+			require.Len(t, output.Reminds, tt.expectedCount)
+
+			require.Len(t, output.TimeOutcomes, 2)
+			assert.True(t, past.Equal(output.TimeOutcomes[0].RequestedTime))
+			assert.Equal(t, tt.expectedResult, output.TimeOutcomes[0].Result)
+			assert.Equal(t, "kept", output.TimeOutcomes[1].Result)
+
+			if tt.expectedMinWidth {
+				clamped := output.Reminds[0]
+				assert.True(t, clamped.Time.After(past))
+				assert.Equal(t, int32(domain.MinSlideWindowWidth.Seconds()), clamped.SlideWindowWidth)
+			}
+		})
+	}
+}
+
+func TestCreateRemindCatchUpOverlapSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	// The past time is clamped to now, but its window would overlap the 2
+	// minute TargetAt window right after, so the outcome reports it dropped.
+	past := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	target := time.Now().Add(2 * time.Minute).Truncate(time.Second)
+
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{past, target},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "short",
+		CatchUp:  "clamp",
+	})
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 1)
+
+	require.Len(t, output.TimeOutcomes, 2)
+	assert.Equal(t, "dropped", output.TimeOutcomes[0].Result)
+	assert.True(t, output.TimeOutcomes[0].Time.IsZero())
+	assert.Equal(t, "kept", output.TimeOutcomes[1].Result)
+	assert.True(t, target.Equal(output.TimeOutcomes[1].Time))
+}
+
+func TestCreateRemindDensitySuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	density, err := domain.NewDensityPolicy(1, 2, []domain.Type{domain.TypeNear})
+	require.NoError(t, err)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, density, nil, nil, nil, nil)
+
+	remindTime := time.Now().Add(1 * time.Hour).Truncate(time.Minute)
+
+	create := func(taskType string) app.RemindOutput {
+		output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+			Times:    []time.Time{remindTime},
+			UserID:   generateUUIDv7String(),
+			Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+			TaskID:   generateUUIDv7String(),
+			TaskType: taskType,
+		})
+		require.NoError(t, err)
+		require.Len(t, output.Reminds, 1)
+
+		return output.Reminds[0]
+	}
+
+	assert.Equal(t, int32(300), create("near").SlideWindowWidth)
+
+	// The minute now holds a remind, so the next one is widened up to the max.
+	assert.Equal(t, int32(600), create("near").SlideWindowWidth)
+
+	// Task types without density widening keep their width.
+	assert.Equal(t, int32(120), create("scheduled").SlideWindowWidth)
+}
+
+func TestPreviewRemindAdaptiveSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	adaptive, err := domain.NewAdaptiveWindowPolicy(10, 0.9, 0.2, 0.8, 1.5)
+	require.NoError(t, err)
+
+	useCase := app.NewRemindUseCase(
+		repository.NewRemindRepository(testDB.DB),
+		repository.NewUserPreferencesRepository(testDB.DB),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		adaptive,
+		repository.NewWindowStatsRepository(testDB.DB),
+		nil,
+	)
+
+	tests := []struct {
+		name                string
+		stats               *repository.WindowStatsModel
+		expectedWidth       int32
+		expectedAdjustments []string
+		expectedExplanation string
+	}{
+		{
+			name:                "no history keeps the width",
+			expectedWidth:       300,
+			expectedExplanation: "no delivery history",
+		},
+		{
+			name:                "too little history keeps the width",
+			stats:               &repository.WindowStatsModel{Samples: 5, OnTime: 5},
+			expectedWidth:       300,
+			expectedExplanation: "not enough delivery history: 5 of 10 reminds",
+		},
+		{
+			name:                "on-time user gets a narrower window",
+			stats:               &repository.WindowStatsModel{Samples: 20, OnTime: 19},
+			expectedWidth:       240,
+			expectedAdjustments: []string{app.AdjustmentAdaptive},
+			expectedExplanation: "narrowed x0.8: 95% of 20 reminds were acknowledged on time",
+		},
+		{
+			name:                "colliding user gets a wider window",
+			stats:               &repository.WindowStatsModel{Samples: 20, OnTime: 19, Collided: 5},
+			expectedWidth:       450,
+			expectedAdjustments: []string{app.AdjustmentAdaptive},
+			expectedExplanation: "widened x1.5: 25% of 20 reminds collided with another remind",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := generateUUIDv7String()
+
+			if tt.stats != nil {
+				tt.stats.UserID = userID
+				tt.stats.TaskType = "near"
+				tt.stats.ComputedAt = time.Now()
+				require.NoError(t, testDB.DB.Create(tt.stats).Error)
+			}
+
+			output, err := useCase.PreviewRemind(context.Background(), app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(time.Hour)},
+				UserID:   userID,
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			})
+
+			require.NoError(t, err)
+			require.Len(t, output.Reminds, 1)
+			assert.Equal(t, tt.expectedWidth, output.Reminds[0].SlideWindowWidth)
+			assert.Equal(t, tt.expectedAdjustments, output.Reminds[0].Adjustments)
+			assert.Equal(t, tt.expectedExplanation, output.WindowExplanation)
+		})
+	}
+}
+
+func TestBackfillSlideWindowWidthsSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Minute)
+
+	created, err := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil).CreateRemind(
+		context.Background(),
+		app.CreateRemindInput{
+			Times:    []time.Time{targetAt.Add(-1 * time.Hour), targetAt},
+			UserID:   generateUUIDv7String(),
+			Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+			TaskID:   generateUUIDv7String(),
+			TaskType: "near",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, created.Reminds, 2)
+
+	// The target width of near reminds changes from 5 to 7 minutes.
+	params, err := domain.NewWindowParams(7*time.Minute, 0.3, time.Minute, 10*time.Minute)
+	require.NoError(t, err)
+
+	useCase := app.NewRemindUseCase(
+		repo,
+		prefsRepo,
+		nil,
+		nil,
+		domain.NewParameterizedWindowPolicy(map[domain.Type]domain.WindowParams{domain.TypeNear: params}),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	for _, dryRun := range []bool{true, false} {
+		output, err := useCase.BackfillSlideWindowWidths(context.Background(), app.BackfillSlideWindowWidthsInput{
+			Now:       time.Now(),
+			BatchSize: 10,
+			DryRun:    dryRun,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, output.Tasks)
+		assert.Equal(t, 2, output.Reminds)
+		assert.Equal(t, created.Reminds[0].TaskID, output.LastTaskID)
+		require.Len(t, output.Changes, 1)
+		assert.Equal(t, int32(300), output.Changes[0].OldWidth)
+		assert.Equal(t, int32(420), output.Changes[0].NewWidth)
+	}
+
+	// The stored widths now match the policy, so nothing is left to change.
+	output, err := useCase.BackfillSlideWindowWidths(context.Background(), app.BackfillSlideWindowWidthsInput{
+		Now:       time.Now(),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, output.Changes)
+}
+
+func TestBackfillSlideWindowWidthsOverlapSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Minute)
+
+	created, err := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil).CreateRemind(
+		context.Background(),
+		app.CreateRemindInput{
+			Times:    []time.Time{targetAt.Add(-10 * time.Minute), targetAt},
+			UserID:   generateUUIDv7String(),
+			Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+			TaskID:   generateUUIDv7String(),
+			TaskType: "near",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, created.Reminds, 2)
+
+	// A 10 minute target width leaves no room for the earlier window, which
+	// keeps its stored width instead of being deleted or squeezed.
+	params, err := domain.NewWindowParams(10*time.Minute, 0.3, time.Minute, 10*time.Minute)
+	require.NoError(t, err)
+
+	useCase := app.NewRemindUseCase(
+		repo,
+		prefsRepo,
+		nil,
+		nil,
+		domain.NewParameterizedWindowPolicy(map[domain.Type]domain.WindowParams{domain.TypeNear: params}),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	output, err := useCase.BackfillSlideWindowWidths(context.Background(), app.BackfillSlideWindowWidthsInput{
+		Now:       time.Now(),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, output.Reminds)
+	assert.Equal(t, 1, output.Overlap)
+	require.Len(t, output.Changes, 1)
+	assert.Equal(t, created.Reminds[1].ID, output.Changes[0].RemindID)
+	assert.Equal(t, int32(600), output.Changes[0].NewWidth)
+
+	reminds, err := useCase.GetRemindsByTimeRange(context.Background(), app.GetRemindsByTimeRangeInput{
+		Start: targetAt.Add(-time.Hour),
+		End:   targetAt.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, reminds.Reminds, 2)
+	assert.Equal(t, created.Reminds[0].SlideWindowWidth, reminds.Reminds[0].SlideWindowWidth)
+}
+
+func TestBackfillSlideWindowWidthsSkipsThrottledSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Minute)
+
+	created, err := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil).CreateRemind(
+		context.Background(),
+		app.CreateRemindInput{
+			Times:    []time.Time{targetAt.Add(-1 * time.Hour), targetAt},
+			UserID:   generateUUIDv7String(),
+			Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+			TaskID:   generateUUIDv7String(),
+			TaskType: "near",
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, created.Reminds, 2)
+
+	params, err := domain.NewWindowParams(7*time.Minute, 0.3, time.Minute, 10*time.Minute)
+	require.NoError(t, err)
+
+	useCase := app.NewRemindUseCase(
+		repo,
+		prefsRepo,
+		nil,
+		nil,
+		domain.NewParameterizedWindowPolicy(map[domain.Type]domain.WindowParams{domain.TypeNear: params}),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+
+	_, err = useCase.UpdateThrottled(context.Background(), app.UpdateThrottledInput{
+		ID:        created.Reminds[1].ID,
+		Throttled: true,
+	})
+	require.NoError(t, err)
+
+	output, err := useCase.BackfillSlideWindowWidths(context.Background(), app.BackfillSlideWindowWidthsInput{
+		Now:       time.Now(),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+
+	// The throttled remind is not delivered, so it is neither checked nor changed.
+	assert.Equal(t, 1, output.Reminds)
+	assert.Empty(t, output.Changes)
+}
+
+func TestCreateRemindTemplateSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	templateRepo := repository.NewRemindTemplateRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, templateRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	name, err := domain.NewTemplateName("standard")
+	require.NoError(t, err)
+
+	template, err := domain.NewRemindTemplate(name, domain.TemplateOffsets{
+		domain.TypeScheduled: {24 * time.Hour, time.Hour, 10 * time.Minute},
+	})
+	require.NoError(t, err)
+	require.NoError(t, templateRepo.Save(context.Background(), template))
+
+	// The one-day offset lands in the past and is dropped.
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "scheduled",
+		TargetAt: &targetAt,
+		Template: "standard",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 2)
+
+	times := []time.Time{output.Reminds[0].Time, output.Reminds[1].Time}
+	slices.SortFunc(times, func(a, b time.Time) int { return a.Compare(b) })
+	assert.True(t, targetAt.Add(-1*time.Hour).Equal(times[0]), "got %s", times[0])
+	assert.True(t, targetAt.Add(-10*time.Minute).Equal(times[1]), "got %s", times[1])
+}
+
+func TestCreateRemindTemplateError(t *testing.T) {
+	targetAt := time.Now().Add(2 * time.Hour)
+
+	tests := []struct {
+		name          string
+		times         []time.Time
+		targetAt      *time.Time
+		template      string
+		autoSchedule  bool
+		expectedField string
+	}{
+		{
+			name:          "times and template",
+			times:         []time.Time{time.Now().Add(1 * time.Hour)},
+			targetAt:      &targetAt,
+			template:      "standard",
+			expectedField: "template",
+		},
+		{
+			name:          "missing target time",
+			times:         nil,
+			targetAt:      nil,
+			template:      "standard",
+			expectedField: "target_at",
+		},
+		{
+			name:          "unknown template",
+			times:         nil,
+			targetAt:      &targetAt,
+			template:      "missing",
+			expectedField: "template",
+		},
+		{
+			name:          "invalid template name",
+			times:         nil,
+			targetAt:      &targetAt,
+			template:      "Not A Name",
+			expectedField: "template",
+		},
+		{
+			name:          "template and auto schedule",
+			times:         nil,
+			targetAt:      &targetAt,
+			template:      "standard",
+			autoSchedule:  true,
+			expectedField: "auto_schedule",
+		},
+		{
+			name:          "auto schedule without target time",
+			times:         nil,
+			targetAt:      nil,
+			autoSchedule:  true,
+			expectedField: "target_at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+				Times:        tt.times,
+				UserID:       generateUUIDv7String(),
+				Devices:      []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
+				TaskID:       generateUUIDv7String(),
+				TaskType:     "near",
+				TargetAt:     tt.targetAt,
+				Template:     tt.template,
+				AutoSchedule: tt.autoSchedule,
+			})
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestCreateRemindAutoScheduleSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		UserID:       generateUUIDv7String(),
+		Devices:      []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:       generateUUIDv7String(),
+		TaskType:     "scheduled",
+		TargetAt:     &targetAt,
+		AutoSchedule: true,
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 3) // 1h and 10m before, and the deadline
+
+	latest := slices.MaxFunc(output.Reminds, func(a, b app.RemindOutput) int {
+		return a.Time.Compare(b.Time)
+	})
+	assert.True(t, targetAt.Equal(latest.Time), "got %s", latest.Time)
+}
+
+func TestPreviewScheduleSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	deadline := time.Now().Add(90 * time.Minute).Truncate(time.Second)
+
+	output, err := useCase.PreviewSchedule(context.Background(), app.PreviewScheduleInput{
+		Deadline: deadline,
+		TaskType: "near",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Times, 3)
+	assert.True(t, deadline.Add(-1*time.Hour).Equal(output.Times[0].Time))
+	assert.True(t, deadline.Add(-15*time.Minute).Equal(output.Times[1].Time))
+	assert.True(t, deadline.Equal(output.Times[2].Time))
+	assert.Equal(t, int32(300), output.Times[2].SlideWindowWidth)
+}
+
+func TestPreviewScheduleError(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         app.PreviewScheduleInput
+		expectedField string
+	}{
+		{
+			name:          "unknown task type",
+			input:         app.PreviewScheduleInput{Deadline: time.Now().Add(1 * time.Hour), TaskType: "urgent"},
+			expectedField: "task_type",
+		},
+		{
+			name:          "deadline in the past",
+			input:         app.PreviewScheduleInput{Deadline: time.Now().Add(-1 * time.Hour), TaskType: "near"},
+			expectedField: "deadline",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.PreviewSchedule(context.Background(), tt.input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestPreviewRemindSuccess(t *testing.T) {
+	quietStart := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	quietEnd := quietStart.Add(2 * time.Hour)
+	inQuiet := quietStart.Add(30 * time.Minute)
+	beforeQuiet := quietStart.Add(-1 * time.Hour)
+
+	tests := []struct {
+		name            string
+		taskType        string
+		expectedTimes   []time.Time
+		expectedAdjusts [][]string
+		expectedDropped []time.Time
+	}{
+		{
+			name:            "shifted remind is reported as a quiet hours adjustment",
+			taskType:        "relaxed",
+			expectedTimes:   []time.Time{beforeQuiet, quietEnd},
+			expectedAdjusts: [][]string{nil, {app.AdjustmentQuietHours}},
+		},
+		{
+			name:            "dropped remind is reported",
+			taskType:        "short",
+			expectedTimes:   []time.Time{beforeQuiet},
+			expectedAdjusts: [][]string{nil},
+			expectedDropped: []time.Time{inQuiet},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			userID := generateUUIDv7String()
+
+			useCase, cleanup := setupUseCaseTestWithQuietHours(t, userID, quietStart)
+			defer cleanup()
+
+			output, err := useCase.PreviewRemind(context.Background(), app.CreateRemindInput{
+				Times:    []time.Time{inQuiet, beforeQuiet},
+				UserID:   userID,
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: tt.taskType,
+			})
+
+			require.NoError(t, err)
+			require.Len(t, output.Reminds, len(tt.expectedTimes))
+			assert.Equal(t, int32(len(tt.expectedTimes)), output.Count) //nolint:gosec
+			assert.Len(t, output.RequestedTimes, 2)
+			require.Len(t, output.DroppedTimes, len(tt.expectedDropped))
+
+			for i, expected := range tt.expectedDropped {
+				assert.True(t, expected.Equal(output.DroppedTimes[i]))
+			}
+
+			last := len(tt.expectedTimes) - 1
+			for i, expected := range tt.expectedTimes {
+				assert.True(t, expected.Equal(output.Reminds[i].Time), "expected %s, got %s", expected, output.Reminds[i].Time)
+				assert.Equal(t, tt.expectedAdjusts[i], output.Reminds[i].Adjustments)
+
+				if i == last {
+					assert.Equal(t, string(domain.WindowCategoryTarget), output.Reminds[i].Category)
+				} else {
+					assert.Equal(t, string(domain.WindowCategoryIntermediate), output.Reminds[i].Category)
+				}
+			}
+
+			// Nothing is stored by a preview.
+			stored, err := useCase.GetRemindsByTimeRange(context.Background(), app.GetRemindsByTimeRangeInput{
+				Start: beforeQuiet.Add(-time.Hour),
+				End:   quietEnd.Add(time.Hour),
+			})
+			require.NoError(t, err)
+			assert.Zero(t, stored.Count)
+		})
+	}
+}
+
+func TestPreviewRemindOverlapSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	// The remind 2 minutes ahead gets the minimal width and would overlap the
+	// 2 minute TargetAt window, so it is dropped.
+	deadline := time.Now().UTC().Add(3 * time.Hour).Truncate(time.Minute)
+	early := deadline.Add(-5 * time.Minute)
+	overlapping := deadline.Add(-2 * time.Minute)
+
+	output, err := useCase.PreviewRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{early, overlapping, deadline},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "short",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 2)
+	assert.True(t, early.Equal(output.Reminds[0].Time))
+	assert.True(t, deadline.Equal(output.Reminds[1].Time))
+	assert.Equal(t, int32(60), output.Reminds[0].SlideWindowWidth)
+	assert.Equal(t, int32(120), output.Reminds[1].SlideWindowWidth)
+
+	require.Len(t, output.DroppedTimes, 1)
+	assert.True(t, overlapping.Equal(output.DroppedTimes[0]))
+}
+
+func TestCreateRemindOverlapSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	deadline := time.Now().UTC().Add(3 * time.Hour).Truncate(time.Minute)
+	early := deadline.Add(-5 * time.Minute)
+	overlapping := deadline.Add(-2 * time.Minute)
+
+	output, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{early, overlapping, deadline},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "short",
+	})
+
+	require.NoError(t, err)
+	require.Len(t, output.Reminds, 2)
+	require.Len(t, output.DroppedTimes, 1)
+	assert.True(t, overlapping.Equal(output.DroppedTimes[0]))
+	assert.Empty(t, output.ShrunkTimes)
+}
+
+func TestPreviewRemindError(t *testing.T) {
+	targetAt := time.Now().Add(2 * time.Hour)
+
+	tests := []struct {
+		name          string
+		input         app.CreateRemindInput
+		expectedField string
+	}{
+		{
+			name: "invalid user id",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(time.Hour)},
+				UserID:   "invalid",
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			},
+			expectedField: "user_id",
+		},
+		{
+			name: "no times",
+			input: app.CreateRemindInput{
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			},
+			expectedField: "times",
+		},
+		{
+			name: "unknown template",
+			input: app.CreateRemindInput{
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+				TargetAt: &targetAt,
+				Template: "missing",
+			},
+			expectedField: "template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.PreviewRemind(context.Background(), tt.input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
 func TestCreateRemindError(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -178,76 +1181,133 @@ func TestCreateRemindError(t *testing.T) {
 				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
 				UserID:   generateUUIDv7String(),
 				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
-				TaskID:   uuid.New().String(),
-				TaskType: "near",
+				TaskID:   uuid.New().String(),
+				TaskType: "near",
+			},
+			expectedField: "task_id",
+		},
+		{
+			name: "empty device ID",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "", FCMToken: "t"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			},
+			expectedField: "devices[0]",
+		},
+		{
+			name: "empty FCM token",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: ""}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			},
+			expectedField: "devices[0]",
+		},
+		{
+			name: "empty devices array",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "near",
+			},
+			expectedField: "devices",
+		},
+		{
+			name: "empty task type",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "",
+			},
+			expectedField: "task_type",
+		},
+		{
+			name: "invalid task type",
+			input: app.CreateRemindInput{
+				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
+				TaskID:   generateUUIDv7String(),
+				TaskType: "invalid_type",
 			},
-			expectedField: "task_id",
+			expectedField: "task_type",
 		},
 		{
-			name: "empty device ID",
+			name: "past remind time",
 			input: app.CreateRemindInput{
-				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				Times:    []time.Time{time.Now().Add(-2 * time.Minute)},
 				UserID:   generateUUIDv7String(),
-				Devices:  []app.DeviceInput{{DeviceID: "", FCMToken: "t"}},
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
 				TaskType: "near",
 			},
-			expectedField: "devices[0]",
+			expectedField: "times[0]",
 		},
 		{
-			name: "empty FCM token",
+			name: "invalid catch-up policy",
 			input: app.CreateRemindInput{
 				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
 				UserID:   generateUUIDv7String(),
-				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: ""}},
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
 				TaskType: "near",
+				CatchUp:  "ignore",
 			},
-			expectedField: "devices[0]",
+			expectedField: "catch_up",
 		},
 		{
-			name: "empty devices array",
+			name: "times too close together",
 			input: app.CreateRemindInput{
-				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				Times:    []time.Time{time.Now().Add(1 * time.Hour), time.Now().Add(1*time.Hour + 10*time.Second)},
 				UserID:   generateUUIDv7String(),
-				Devices:  []app.DeviceInput{},
+				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
 				TaskType: "near",
 			},
-			expectedField: "devices",
+			expectedField: "times[1]",
 		},
 		{
-			name: "empty task type",
+			name: "time beyond the horizon",
 			input: app.CreateRemindInput{
-				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				Times:    []time.Time{time.Now().Add(2 * 365 * 24 * time.Hour)},
 				UserID:   generateUUIDv7String(),
 				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
-				TaskType: "",
+				TaskType: "near",
 			},
-			expectedField: "task_type",
+			expectedField: "times[0]",
 		},
 		{
-			name: "invalid task type",
+			name: "too many times",
 			input: app.CreateRemindInput{
-				Times:    []time.Time{time.Now().Add(1 * time.Hour)},
+				Times:    hourlyTimes(domain.DefaultMaxRemindTimes + 1),
 				UserID:   generateUUIDv7String(),
 				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
-				TaskType: "invalid_type",
+				TaskType: "near",
 			},
-			expectedField: "task_type",
+			expectedField: "times",
 		},
 		{
-			name: "past remind time",
+			name: "every time dropped as past",
 			input: app.CreateRemindInput{
 				Times:    []time.Time{time.Now().Add(-2 * time.Minute)},
 				UserID:   generateUUIDv7String(),
 				Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
 				TaskID:   generateUUIDv7String(),
 				TaskType: "near",
+				CatchUp:  "drop",
 			},
-			expectedField: "times[0]",
+			expectedField: "times",
 		},
 	}
 
@@ -399,6 +1459,79 @@ func TestGetRemindsByTimeRangeError(t *testing.T) {
 	}
 }
 
+func TestGetDueRemindsSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	remindTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+
+	created, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{remindTime},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "near",
+	})
+	require.NoError(t, err)
+
+	throttled, err := useCase.UpdateThrottled(context.Background(), app.UpdateThrottledInput{
+		ID:        created.Reminds[0].ID,
+		Throttled: true,
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name             string
+		at               time.Time
+		excludeThrottled bool
+		expectedCount    int32
+	}{
+		{
+			name:          "inside the window",
+			at:            remindTime.Add(-4 * time.Minute),
+			expectedCount: 1,
+		},
+		{
+			name:          "outside the window",
+			at:            remindTime.Add(-6 * time.Minute),
+			expectedCount: 0,
+		},
+		{
+			name:             "throttled remind excluded",
+			at:               remindTime,
+			excludeThrottled: true,
+			expectedCount:    0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := useCase.GetDueReminds(context.Background(), app.GetDueRemindsInput{
+				At:               tt.at,
+				ExcludeThrottled: tt.excludeThrottled,
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.expectedCount, output.Count)
+
+			if tt.expectedCount > 0 {
+				assert.Equal(t, throttled.ID, output.Reminds[0].ID)
+			}
+		})
+	}
+}
+
+func TestGetDueRemindsError(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	_, err := useCase.GetDueReminds(context.Background(), app.GetDueRemindsInput{})
+
+	var validationErr *app.ValidationError
+	require.ErrorAs(t, err, &validationErr)
+	assert.Equal(t, "at", validationErr.Field)
+}
+
 func TestUpdateThrottledSuccess(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -851,7 +1984,8 @@ func setupUseCaseTestWithPublisher(t *testing.T, publisher pubsub.Publisher) (ap
 	t.Helper()
 	testDB := testutil.SetupTestDB(t)
 	repo := repository.NewRemindRepository(testDB.DB)
-	useCase := app.NewRemindUseCase(repo, publisher)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, publisher)
 
 	return useCase, func() {
 		testDB.CleanTable(t)
@@ -1005,3 +2139,373 @@ func TestCancelRemindByTaskID_NilPublisher_Succeeds(t *testing.T) {
 	err = useCase.CancelRemindByTaskID(context.Background(), input)
 	assert.NoError(t, err)
 }
+
+func TestAcknowledgeRemindSuccess(t *testing.T) {
+	tests := []struct {
+		name              string
+		taskType          string
+		acknowledged      int
+		expectedCancelled int
+		expectedRemaining int
+	}{
+		{
+			name:              "near remind cancels the later reminds",
+			taskType:          "near",
+			acknowledged:      0,
+			expectedCancelled: 2,
+			expectedRemaining: 0,
+		},
+		{
+			name:              "early acknowledgment cancels the earlier upcoming reminds too",
+			taskType:          "near",
+			acknowledged:      1,
+			expectedCancelled: 2,
+			expectedRemaining: 0,
+		},
+		{
+			name:              "scheduled remind keeps the later reminds",
+			taskType:          "scheduled",
+			acknowledged:      0,
+			expectedCancelled: 0,
+			expectedRemaining: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			ctx := context.Background()
+			now := time.Now()
+			taskID := generateUUIDv7String()
+
+			created, err := useCase.CreateRemind(ctx, app.CreateRemindInput{
+				Times:    []time.Time{now.Add(1 * time.Hour), now.Add(2 * time.Hour), now.Add(3 * time.Hour)},
+				UserID:   generateUUIDv7String(),
+				Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+				TaskID:   taskID,
+				TaskType: tt.taskType,
+			})
+			require.NoError(t, err)
+			require.Len(t, created.Reminds, 3)
+
+			acknowledgedID := created.Reminds[tt.acknowledged].ID
+
+			output, err := useCase.AcknowledgeRemind(ctx, app.AcknowledgeRemindInput{ID: acknowledgedID})
+			require.NoError(t, err)
+			assert.NotNil(t, output.Remind.AcknowledgedAt)
+			assert.Len(t, output.CancelledRemindIDs, tt.expectedCancelled)
+			assert.NotContains(t, output.CancelledRemindIDs, acknowledgedID)
+
+			remaining, err := useCase.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+				Start: now,
+				End:   now.Add(4 * time.Hour),
+			})
+			require.NoError(t, err)
+			assert.Len(t, remaining.Reminds, tt.expectedRemaining)
+
+			// Acknowledging again is a no-op.
+			again, err := useCase.AcknowledgeRemind(ctx, app.AcknowledgeRemindInput{ID: acknowledgedID})
+			require.NoError(t, err)
+			assert.Empty(t, again.CancelledRemindIDs)
+			assert.True(t, output.Remind.AcknowledgedAt.Equal(*again.Remind.AcknowledgedAt))
+		})
+	}
+}
+
+func TestAcknowledgeRemindError(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		expectedErr error
+	}{
+		{
+			name:        "invalid id",
+			id:          "invalid",
+			expectedErr: app.ErrValidation,
+		},
+		{
+			name:        "not found",
+			id:          generateUUIDv7String(),
+			expectedErr: app.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.AcknowledgeRemind(context.Background(), app.AcknowledgeRemindInput{ID: tt.id})
+
+			if errors.Is(tt.expectedErr, app.ErrValidation) {
+				assert.True(t, app.IsValidationError(err))
+
+				return
+			}
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestAcknowledgeRemind_PublishesEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockPublisher := pubsub.NewMockPublisher(ctrl)
+
+	taskID := generateUUIDv7String()
+	userID := generateUUIDv7String()
+
+	mockPublisher.EXPECT().
+		PublishRemindCancelled(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
+			assert.Equal(t, taskID, req.GetTaskId())
+			assert.Equal(t, userID, req.GetUserId())
+			assert.Equal(t, int64(1), req.GetDeletedCount())
+			assert.Len(t, req.GetRemindIds(), 1)
+
+			return nil
+		}).
+		Times(1)
+
+	useCase, cleanup := setupUseCaseTestWithPublisher(t, mockPublisher)
+	defer cleanup()
+
+	now := time.Now()
+
+	created, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+		Times:    []time.Time{now.Add(1 * time.Hour), now.Add(2 * time.Hour)},
+		UserID:   userID,
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   taskID,
+		TaskType: "relaxed",
+	})
+	require.NoError(t, err)
+
+	_, err = useCase.AcknowledgeRemind(context.Background(), app.AcknowledgeRemindInput{ID: created.Reminds[0].ID})
+	assert.NoError(t, err)
+}
+
+func TestCreateRemindEscalationError(t *testing.T) {
+	tests := []struct {
+		name          string
+		escalation    *app.EscalationInput
+		expectedField string
+	}{
+		{
+			name:          "unknown step",
+			escalation:    &app.EscalationInput{AfterSeconds: 600, Steps: []string{"call_mom"}},
+			expectedField: "escalation.steps[0]",
+		},
+		{
+			name:          "delay too short",
+			escalation:    &app.EscalationInput{AfterSeconds: 10, Steps: []string{"follow_up"}},
+			expectedField: "escalation",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUseCaseTest(t)
+			defer cleanup()
+
+			_, err := useCase.CreateRemind(context.Background(), app.CreateRemindInput{
+				Times:      []time.Time{time.Now().Add(1 * time.Hour)},
+				UserID:     generateUUIDv7String(),
+				Devices:    []app.DeviceInput{{DeviceID: "d", FCMToken: "t"}},
+				TaskID:     generateUUIDv7String(),
+				TaskType:   "near",
+				Escalation: tt.escalation,
+			})
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestEscalateOverdueRemindsSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+	taskID := generateUUIDv7String()
+
+	created, err := useCase.CreateRemind(ctx, app.CreateRemindInput{
+		Times:    []time.Time{now.Add(10 * time.Minute), now.Add(20 * time.Minute)},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   taskID,
+		TaskType: "near",
+		Escalation: &app.EscalationInput{
+			AfterSeconds: 300,
+			Steps:        []string{"narrow_window", "follow_up"},
+		},
+	})
+	require.NoError(t, err)
+
+	// Nothing is due before the TargetAt remind plus the escalation delay.
+	output, err := useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{
+		Now:       now.Add(22 * time.Minute),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.Escalated)
+
+	output, err = useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{
+		Now:       now.Add(26 * time.Minute),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Escalated)
+	assert.Equal(t, 0, output.FollowUps)
+
+	output, err = useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{
+		Now:       now.Add(31 * time.Minute),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Escalated)
+	assert.Equal(t, 1, output.FollowUps)
+
+	reminds, err := useCase.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+		Start: now,
+		End:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, reminds.Reminds, 3)
+	assert.Equal(t, int32(0), reminds.Reminds[0].EscalationLevel)
+	assert.Equal(t, int32(2), reminds.Reminds[1].EscalationLevel)
+	assert.Equal(t, created.Reminds[1].ID, reminds.Reminds[1].ID)
+	assert.Equal(t, int32(300), reminds.Reminds[2].SlideWindowWidth)
+}
+
+func TestEscalateOverdueRemindsAddDevicesSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewRemindUseCase(repo, prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	now := time.Now()
+	userID := generateUUIDv7String()
+
+	uid, err := domain.UserIDFromString(userID)
+	require.NoError(t, err)
+
+	device, err := domain.NewDevice("default-device", "default-token")
+	require.NoError(t, err)
+	require.NoError(t, prefsRepo.Save(ctx, domain.NewUserPreferences(uid, domain.Devices{device}, domain.UTCTimezone(), nil, nil)))
+
+	created, err := useCase.CreateRemind(ctx, app.CreateRemindInput{
+		Times:    []time.Time{now.Add(10 * time.Minute)},
+		UserID:   userID,
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "near",
+		Escalation: &app.EscalationInput{
+			AfterSeconds: 300,
+			Steps:        []string{"add_devices", "follow_up"},
+		},
+	})
+	require.NoError(t, err)
+
+	for _, at := range []time.Time{now.Add(16 * time.Minute), now.Add(21 * time.Minute)} {
+		output, err := useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{Now: at, BatchSize: 10})
+		require.NoError(t, err)
+		assert.Equal(t, 1, output.Escalated)
+	}
+
+	reminds, err := useCase.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+		Start: now,
+		End:   now.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, reminds.Reminds, 2)
+	assert.Equal(t, created.Reminds[0].ID, reminds.Reminds[0].ID)
+
+	// The follow-up is delivered to the default devices added by the
+	// earlier step as well.
+	assert.Equal(t, []app.DeviceOutput{
+		{DeviceID: "device-a", FCMToken: "token-a"},
+		{DeviceID: "default-device", FCMToken: "default-token"},
+	}, reminds.Reminds[1].Devices)
+}
+
+func TestEscalateOverdueRemindsFollowUpQuietHoursSuccess(t *testing.T) {
+	userID := generateUUIDv7String()
+	quietStart := time.Now().UTC().Add(48 * time.Hour).Truncate(time.Hour)
+	quietEnd := quietStart.Add(2 * time.Hour)
+
+	useCase, cleanup := setupUseCaseTestWithQuietHours(t, userID, quietStart)
+	defer cleanup()
+
+	ctx := context.Background()
+	taskID := generateUUIDv7String()
+
+	_, err := useCase.CreateRemind(ctx, app.CreateRemindInput{
+		Times:    []time.Time{quietStart.Add(-10 * time.Minute)},
+		UserID:   userID,
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   taskID,
+		TaskType: "near",
+		Escalation: &app.EscalationInput{
+			AfterSeconds: 1800,
+			Steps:        []string{"follow_up"},
+		},
+	})
+	require.NoError(t, err)
+
+	// The follow-up would fire inside quiet hours, so it is shifted like any
+	// near remind.
+	output, err := useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{
+		Now:       quietStart.Add(30 * time.Minute),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, output.Escalated)
+	assert.Equal(t, 1, output.FollowUps)
+
+	reminds, err := useCase.GetRemindsByTimeRange(ctx, app.GetRemindsByTimeRangeInput{
+		Start: quietStart.Add(-time.Hour),
+		End:   quietEnd.Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Len(t, reminds.Reminds, 2)
+	assert.True(t, quietEnd.Equal(reminds.Reminds[1].Time))
+}
+
+func TestEscalateOverdueRemindsAcknowledgedSuccess(t *testing.T) {
+	useCase, cleanup := setupUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	now := time.Now()
+
+	created, err := useCase.CreateRemind(ctx, app.CreateRemindInput{
+		Times:    []time.Time{now.Add(10 * time.Minute)},
+		UserID:   generateUUIDv7String(),
+		Devices:  []app.DeviceInput{{DeviceID: "device-a", FCMToken: "token-a"}},
+		TaskID:   generateUUIDv7String(),
+		TaskType: "relaxed",
+	})
+	require.NoError(t, err)
+
+	_, err = useCase.AcknowledgeRemind(ctx, app.AcknowledgeRemindInput{ID: created.Reminds[0].ID})
+	require.NoError(t, err)
+
+	output, err := useCase.EscalateOverdueReminds(ctx, app.EscalateOverdueRemindsInput{
+		Now:       now.Add(2 * time.Hour),
+		BatchSize: 10,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, output.Escalated)
+}
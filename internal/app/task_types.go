@@ -0,0 +1,25 @@
+package app
+
+import "github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+
+// TaskTypeName returns the registered task type with the given
+// common.v1.TaskType enum value.
+func TaskTypeName(enumValue int32) (string, bool) {
+	def, ok := domain.TaskTypes().LookupEnumValue(enumValue)
+	if !ok {
+		return "", false
+	}
+
+	return string(def.Type), true
+}
+
+// TaskTypeEnumValue returns the common.v1.TaskType enum value of a registered
+// task type.
+func TaskTypeEnumValue(name string) (int32, bool) {
+	def, ok := domain.TaskTypes().Lookup(domain.Type(name))
+	if !ok {
+		return 0, false
+	}
+
+	return def.EnumValue, true
+}
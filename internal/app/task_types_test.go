@@ -0,0 +1,27 @@
+package app_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+)
+
+func TestTaskTypeNameSuccess(t *testing.T) {
+	name, ok := app.TaskTypeName(2)
+	assert.True(t, ok)
+	assert.Equal(t, "near", name)
+
+	_, ok = app.TaskTypeName(0)
+	assert.False(t, ok)
+}
+
+func TestTaskTypeEnumValueSuccess(t *testing.T) {
+	value, ok := app.TaskTypeEnumValue("scheduled")
+	assert.True(t, ok)
+	assert.Equal(t, int32(4), value)
+
+	_, ok = app.TaskTypeEnumValue("unknown")
+	assert.False(t, ok)
+}
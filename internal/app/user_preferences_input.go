@@ -0,0 +1,33 @@
+package app
+
+import "time"
+
+type GetUserPreferencesInput struct {
+	UserID string
+}
+
+// PutUserPreferencesInput replaces every stored setting except the pause state,
+// which is changed through PauseUseCase; an empty Timezone means UTC.
+type PutUserPreferencesInput struct {
+	UserID          string
+	DefaultDevices  []DeviceInput
+	Timezone        string
+	QuietHours      []QuietWindowInput
+	WindowOverrides []WindowOverrideInput
+}
+
+type QuietWindowInput struct {
+	Weekday time.Weekday
+	Start   time.Duration // offset from midnight
+	End     time.Duration // offset from midnight
+}
+
+type WindowOverrideInput struct {
+	TaskType                    string
+	TargetWidthSeconds          int32
+	IntermediateMaxWidthSeconds int32
+}
+
+type DeleteUserPreferencesInput struct {
+	UserID string
+}
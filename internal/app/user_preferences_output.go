@@ -0,0 +1,78 @@
+package app
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type UserPreferencesOutput struct {
+	UserID          string
+	DefaultDevices  []DeviceOutput
+	Timezone        string
+	QuietHours      []QuietWindowOutput
+	WindowOverrides []WindowOverrideOutput
+	Paused          bool
+	ResumeAt        *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+type QuietWindowOutput struct {
+	Weekday time.Weekday
+	Start   time.Duration
+	End     time.Duration
+}
+
+type WindowOverrideOutput struct {
+	TaskType                    string
+	TargetWidthSeconds          int32
+	IntermediateMaxWidthSeconds int32
+}
+
+func FromUserPreferencesEntity(prefs *domain.UserPreferences) UserPreferencesOutput {
+	devices := make([]DeviceOutput, 0, prefs.DefaultDevices().Count())
+	for _, d := range prefs.DefaultDevices().ToSlice() {
+		devices = append(devices, DeviceOutput{
+			DeviceID: d.DeviceID(),
+			FCMToken: d.FCMToken(),
+		})
+	}
+
+	quietHours := make([]QuietWindowOutput, 0, len(prefs.QuietHours().ToSlice()))
+	for _, w := range prefs.QuietHours().ToSlice() {
+		quietHours = append(quietHours, QuietWindowOutput{
+			Weekday: w.Weekday(),
+			Start:   w.Start(),
+			End:     w.End(),
+		})
+	}
+
+	overrides := make([]WindowOverrideOutput, 0, len(prefs.WindowOverrides()))
+	for t, o := range prefs.WindowOverrides() {
+		overrides = append(overrides, WindowOverrideOutput{
+			TaskType:                    string(t),
+			TargetWidthSeconds:          int32(o.TargetWidth() / time.Second),          // #nosec G115
+			IntermediateMaxWidthSeconds: int32(o.IntermediateMaxWidth() / time.Second), // #nosec G115
+		})
+	}
+
+	// Map iteration order is random; keep responses stable.
+	slices.SortFunc(overrides, func(a, b WindowOverrideOutput) int {
+		return strings.Compare(a.TaskType, b.TaskType)
+	})
+
+	return UserPreferencesOutput{
+		UserID:          prefs.UserID().String(),
+		DefaultDevices:  devices,
+		Timezone:        prefs.Timezone().String(),
+		QuietHours:      quietHours,
+		WindowOverrides: overrides,
+		Paused:          prefs.IsPaused(),
+		ResumeAt:        prefs.ResumeAt(),
+		CreatedAt:       prefs.CreatedAt(),
+		UpdatedAt:       prefs.UpdatedAt(),
+	}
+}
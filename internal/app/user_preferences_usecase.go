@@ -0,0 +1,11 @@
+package app
+
+import (
+	"context"
+)
+
+type UserPreferencesUseCase interface {
+	GetUserPreferences(ctx context.Context, input GetUserPreferencesInput) (UserPreferencesOutput, error)
+	PutUserPreferences(ctx context.Context, input PutUserPreferencesInput) (UserPreferencesOutput, error)
+	DeleteUserPreferences(ctx context.Context, input DeleteUserPreferencesInput) error
+}
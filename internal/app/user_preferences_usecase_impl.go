@@ -0,0 +1,221 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type userPreferencesUseCaseImpl struct {
+	transactor domain.Transactor
+	repo       domain.UserPreferencesRepository
+}
+
+func NewUserPreferencesUseCase(
+	transactor domain.Transactor,
+	repo domain.UserPreferencesRepository,
+) UserPreferencesUseCase {
+	return &userPreferencesUseCaseImpl{
+		transactor: transactor,
+		repo:       repo,
+	}
+}
+
+func (uc *userPreferencesUseCaseImpl) GetUserPreferences(
+	ctx context.Context,
+	input GetUserPreferencesInput,
+) (UserPreferencesOutput, error) {
+	slog.Debug("getting user preferences",
+		"user_id", input.UserID,
+	)
+
+	userID, err := domain.UserIDFromString(input.UserID)
+	if err != nil {
+		return UserPreferencesOutput{}, NewValidationError("user_id", err.Error())
+	}
+
+	prefs, err := uc.repo.FindByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, domain.ErrUserPreferencesNotFound) {
+			return UserPreferencesOutput{}, fmt.Errorf("%w: %v", ErrNotFound, err)
+		}
+
+		slog.Error("failed to get user preferences",
+			"error", err,
+			"user_id", input.UserID,
+		)
+
+		return UserPreferencesOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return FromUserPreferencesEntity(prefs), nil
+}
+
+func (uc *userPreferencesUseCaseImpl) PutUserPreferences(
+	ctx context.Context,
+	input PutUserPreferencesInput,
+) (UserPreferencesOutput, error) {
+	slog.Debug("putting user preferences",
+		"user_id", input.UserID,
+	)
+
+	userID, err := domain.UserIDFromString(input.UserID)
+	if err != nil {
+		return UserPreferencesOutput{}, NewValidationError("user_id", err.Error())
+	}
+
+	devices := make(domain.Devices, 0, len(input.DefaultDevices))
+	for i, d := range input.DefaultDevices {
+		device, err := domain.NewDevice(d.DeviceID, d.FCMToken)
+		if err != nil {
+			return UserPreferencesOutput{}, NewValidationError(
+				fmt.Sprintf("default_devices[%d]", i), err.Error(),
+			)
+		}
+
+		devices = append(devices, device)
+	}
+
+	timezone := domain.UTCTimezone()
+	if input.Timezone != "" {
+		timezone, err = domain.NewTimezone(input.Timezone)
+		if err != nil {
+			return UserPreferencesOutput{}, NewValidationError("timezone", err.Error())
+		}
+	}
+
+	windows := make([]domain.QuietWindow, 0, len(input.QuietHours))
+	for i, w := range input.QuietHours {
+		window, err := domain.NewQuietWindow(w.Weekday, w.Start, w.End)
+		if err != nil {
+			return UserPreferencesOutput{}, NewValidationError(
+				fmt.Sprintf("quiet_hours[%d]", i), err.Error(),
+			)
+		}
+
+		windows = append(windows, window)
+	}
+
+	quietHours, err := domain.NewQuietHours(windows)
+	if err != nil {
+		return UserPreferencesOutput{}, NewValidationError("quiet_hours", err.Error())
+	}
+
+	overrides, err := toWindowOverrides(input.WindowOverrides)
+	if err != nil {
+		return UserPreferencesOutput{}, err
+	}
+
+	var prefs *domain.UserPreferences
+
+	// The row is locked so a concurrent pause or resume is neither lost nor
+	// undone by the replaced preferences.
+	if err := uc.transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		var err error
+
+		prefs, err = repos.Preferences.FindByUserIDForUpdate(ctx, userID)
+
+		switch {
+		case errors.Is(err, domain.ErrUserPreferencesNotFound):
+			prefs = domain.NewUserPreferences(userID, devices, timezone, quietHours, overrides)
+		case err != nil:
+			return err
+		default:
+			prefs.Replace(devices, timezone, quietHours, overrides)
+		}
+
+		return repos.Preferences.Save(ctx, prefs)
+	}); err != nil {
+		slog.Error("failed to save user preferences",
+			"error", err,
+			"user_id", input.UserID,
+		)
+
+		return UserPreferencesOutput{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	slog.Debug("user preferences saved",
+		"user_id", input.UserID,
+	)
+
+	return FromUserPreferencesEntity(prefs), nil
+}
+
+func toWindowOverrides(inputs []WindowOverrideInput) (domain.WindowOverrides, error) {
+	overrides := make(domain.WindowOverrides, len(inputs))
+	for i, o := range inputs {
+		field := fmt.Sprintf("window_overrides[%d]", i)
+
+		taskType, err := domain.NewType(o.TaskType)
+		if err != nil {
+			return nil, NewValidationError(field, err.Error())
+		}
+
+		if _, ok := overrides[taskType]; ok {
+			return nil, NewValidationError(field, "duplicate task type")
+		}
+
+		override, err := domain.NewWindowOverride(
+			time.Duration(o.TargetWidthSeconds)*time.Second,
+			time.Duration(o.IntermediateMaxWidthSeconds)*time.Second,
+		)
+		if err != nil {
+			return nil, NewValidationError(field, err.Error())
+		}
+
+		overrides[taskType] = override
+	}
+
+	return overrides, nil
+}
+
+func (uc *userPreferencesUseCaseImpl) DeleteUserPreferences(
+	ctx context.Context,
+	input DeleteUserPreferencesInput,
+) error {
+	slog.Debug("deleting user preferences",
+		"user_id", input.UserID,
+	)
+
+	userID, err := domain.UserIDFromString(input.UserID)
+	if err != nil {
+		return NewValidationError("user_id", err.Error())
+	}
+
+	err = uc.transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		prefs, err := repos.Preferences.FindByUserIDForUpdate(ctx, userID)
+		if err != nil {
+			return err
+		}
+
+		// Deleting would drop the auto-resume time and strand the paused reminds.
+		if prefs.IsPaused() {
+			return ErrUserPaused
+		}
+
+		return repos.Preferences.Delete(ctx, userID)
+	})
+
+	switch {
+	case err == nil:
+	case errors.Is(err, ErrUserPaused):
+		return err
+	case errors.Is(err, domain.ErrUserPreferencesNotFound):
+		slog.Info("user preferences not found for deletion (idempotency)",
+			"user_id", input.UserID,
+		)
+	default:
+		slog.Error("failed to delete user preferences",
+			"error", err,
+			"user_id", input.UserID,
+		)
+
+		return fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package app_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func setupUserPreferencesUseCaseTest(t *testing.T) (app.UserPreferencesUseCase, func()) {
+	t.Helper()
+	testDB := testutil.SetupTestDB(t)
+	repo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewUserPreferencesUseCase(repository.NewTransactor(testDB.DB), repo)
+
+	return useCase, func() {
+		testDB.CleanTable(t)
+		testDB.TeardownTestDB(t)
+	}
+}
+
+func validPutUserPreferencesInput(userID string) app.PutUserPreferencesInput {
+	return app.PutUserPreferencesInput{
+		UserID:         userID,
+		DefaultDevices: []app.DeviceInput{{DeviceID: "device-1", FCMToken: "token-1"}},
+		Timezone:       "Asia/Tokyo",
+		QuietHours: []app.QuietWindowInput{
+			{Weekday: time.Monday, Start: 22 * time.Hour, End: 7 * time.Hour},
+		},
+		WindowOverrides: []app.WindowOverrideInput{
+			{TaskType: "relaxed", TargetWidthSeconds: 180, IntermediateMaxWidthSeconds: 0},
+		},
+	}
+}
+
+func TestPutUserPreferencesSuccess(t *testing.T) {
+	useCase, cleanup := setupUserPreferencesUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userID := generateUUIDv7String()
+
+	created, err := useCase.PutUserPreferences(ctx, validPutUserPreferencesInput(userID))
+	require.NoError(t, err)
+	assert.Equal(t, userID, created.UserID)
+	assert.Equal(t, "Asia/Tokyo", created.Timezone)
+	assert.Len(t, created.DefaultDevices, 1)
+	assert.Len(t, created.QuietHours, 1)
+	assert.Equal(t, []app.WindowOverrideOutput{
+		{TaskType: "relaxed", TargetWidthSeconds: 180, IntermediateMaxWidthSeconds: 0},
+	}, created.WindowOverrides)
+
+	replace := app.PutUserPreferencesInput{
+		UserID:          userID,
+		DefaultDevices:  nil,
+		Timezone:        "",
+		QuietHours:      nil,
+		WindowOverrides: nil,
+	}
+
+	replaced, err := useCase.PutUserPreferences(ctx, replace)
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", replaced.Timezone)
+	assert.Empty(t, replaced.DefaultDevices)
+	assert.Empty(t, replaced.QuietHours)
+	assert.Empty(t, replaced.WindowOverrides)
+	assert.False(t, replaced.Paused)
+
+	found, err := useCase.GetUserPreferences(ctx, app.GetUserPreferencesInput{UserID: userID})
+	require.NoError(t, err)
+	assert.False(t, found.Paused)
+	assert.WithinDuration(t, created.CreatedAt, found.CreatedAt, time.Millisecond)
+}
+
+func TestPutUserPreferencesError(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(*app.PutUserPreferencesInput)
+		expectedField string
+	}{
+		{
+			name:          "invalid user id",
+			modify:        func(in *app.PutUserPreferencesInput) { in.UserID = "invalid" },
+			expectedField: "user_id",
+		},
+		{
+			name: "invalid default device",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.DefaultDevices = []app.DeviceInput{{DeviceID: "", FCMToken: "t"}}
+			},
+			expectedField: "default_devices[0]",
+		},
+		{
+			name:          "invalid timezone",
+			modify:        func(in *app.PutUserPreferencesInput) { in.Timezone = "Mars/Olympus" },
+			expectedField: "timezone",
+		},
+		{
+			name: "invalid quiet window",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.QuietHours = []app.QuietWindowInput{{Weekday: time.Monday, Start: time.Hour, End: time.Hour}}
+			},
+			expectedField: "quiet_hours[0]",
+		},
+		{
+			name: "overlapping quiet windows",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.QuietHours = []app.QuietWindowInput{
+					{Weekday: time.Monday, Start: 1 * time.Hour, End: 3 * time.Hour},
+					{Weekday: time.Monday, Start: 2 * time.Hour, End: 4 * time.Hour},
+				}
+			},
+			expectedField: "quiet_hours",
+		},
+		{
+			name: "unknown override task type",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.WindowOverrides = []app.WindowOverrideInput{{TaskType: "urgent", TargetWidthSeconds: 120}}
+			},
+			expectedField: "window_overrides[0]",
+		},
+		{
+			name: "duplicate override task type",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.WindowOverrides = []app.WindowOverrideInput{
+					{TaskType: "near", TargetWidthSeconds: 120},
+					{TaskType: "near", TargetWidthSeconds: 180},
+				}
+			},
+			expectedField: "window_overrides[1]",
+		},
+		{
+			name: "override width out of range",
+			modify: func(in *app.PutUserPreferencesInput) {
+				in.WindowOverrides = []app.WindowOverrideInput{{TaskType: "near", TargetWidthSeconds: 3600}}
+			},
+			expectedField: "window_overrides[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, cleanup := setupUserPreferencesUseCaseTest(t)
+			defer cleanup()
+
+			input := validPutUserPreferencesInput(generateUUIDv7String())
+			tt.modify(&input)
+
+			_, err := useCase.PutUserPreferences(context.Background(), input)
+
+			var validationErr *app.ValidationError
+			require.ErrorAs(t, err, &validationErr)
+			assert.Equal(t, tt.expectedField, validationErr.Field)
+		})
+	}
+}
+
+func TestGetUserPreferencesError(t *testing.T) {
+	useCase, cleanup := setupUserPreferencesUseCaseTest(t)
+	defer cleanup()
+
+	_, err := useCase.GetUserPreferences(context.Background(), app.GetUserPreferencesInput{UserID: "invalid"})
+	assert.True(t, app.IsValidationError(err))
+
+	_, err = useCase.GetUserPreferences(context.Background(), app.GetUserPreferencesInput{
+		UserID: generateUUIDv7String(),
+	})
+	assert.ErrorIs(t, err, app.ErrNotFound)
+}
+
+func TestDeleteUserPreferencesSuccess(t *testing.T) {
+	useCase, cleanup := setupUserPreferencesUseCaseTest(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userID := generateUUIDv7String()
+
+	_, err := useCase.PutUserPreferences(ctx, validPutUserPreferencesInput(userID))
+	require.NoError(t, err)
+
+	require.NoError(t, useCase.DeleteUserPreferences(ctx, app.DeleteUserPreferencesInput{UserID: userID}))
+
+	_, err = useCase.GetUserPreferences(ctx, app.GetUserPreferencesInput{UserID: userID})
+	assert.ErrorIs(t, err, app.ErrNotFound)
+
+	// Deleting again is a no-op.
+	require.NoError(t, useCase.DeleteUserPreferences(ctx, app.DeleteUserPreferencesInput{UserID: userID}))
+}
+
+func TestPutUserPreferencesKeepsPauseSuccess(t *testing.T) {
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+	defer testDB.CleanTable(t)
+
+	transactor := repository.NewTransactor(testDB.DB)
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	useCase := app.NewUserPreferencesUseCase(transactor, prefsRepo)
+	pauseUseCase := app.NewPauseUseCase(transactor, prefsRepo, nil)
+
+	ctx := context.Background()
+	userID := generateUUIDv7String()
+	resumeAt := time.Now().Add(time.Hour).UTC().Truncate(time.Microsecond)
+
+	_, err := pauseUseCase.PauseUser(ctx, app.PauseUserInput{UserID: userID, ResumeAt: &resumeAt})
+	require.NoError(t, err)
+
+	output, err := useCase.PutUserPreferences(ctx, validPutUserPreferencesInput(userID))
+	require.NoError(t, err)
+	assert.True(t, output.Paused)
+
+	// The auto-resume time lives in the preferences, so a paused user's
+	// preferences are kept.
+	err = useCase.DeleteUserPreferences(ctx, app.DeleteUserPreferencesInput{UserID: userID})
+	require.ErrorIs(t, err, app.ErrUserPaused)
+
+	_, err = useCase.GetUserPreferences(ctx, app.GetUserPreferencesInput{UserID: userID})
+	require.NoError(t, err)
+}
@@ -0,0 +1,352 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+var ErrWidthBackfillRunning = errors.New("width backfill is already running")
+
+// WidthBackfillStart selects where a backfill starts and whether it only
+// reports the changes.
+type WidthBackfillStart struct {
+	AfterTaskID string // empty starts from the first task
+	DryRun      bool
+}
+
+// WidthBackfillProgress reports the running or last finished backfill.
+type WidthBackfillProgress struct {
+	Running    bool
+	DryRun     bool
+	StartedAt  time.Time
+	FinishedAt time.Time // zero while running
+	LastTaskID string    // cursor the run continues from
+	Tasks      int
+	Reminds    int
+	Changed    int
+	Overlap    int // future reminds left unchanged as no width avoids overlapping the next one
+	Skipped    int // changes not stored as the remind changed since it was read
+	Failed     int
+	Changes    []WidthChangeOutput // collected in dry runs only, up to the job's max changes
+	Truncated  bool                // Changes stopped at the cap; Changed still counts every change
+	Error      string              // why the run stopped early
+}
+
+// WidthBackfillJob recalculates the stored slide window widths of future
+// reminds after a policy change. Runs are started by an admin and walk the
+// tasks in batches.
+//
+// The run, with its cursor and counters, is stored after every batch, so
+// any instance reports its progress and only one run is active at a time.
+// The instance working on the run holds a lease on it, renewed with every
+// batch; once the lease expires, as that instance stopped, another instance
+// resumes the run from its cursor.
+type WidthBackfillJob struct {
+	useCase    RemindUseCase
+	runs       domain.WidthBackfillRunRepository
+	owner      string
+	batchSize  int
+	maxChanges int
+	lease      time.Duration
+	wake       chan struct{}
+}
+
+// NewWidthBackfillJob keeps at most maxChanges changes of a dry run so a
+// large diff does not grow the stored run without bound. A batch must take
+// less than lease, or another instance takes the run over.
+func NewWidthBackfillJob(
+	useCase RemindUseCase,
+	runs domain.WidthBackfillRunRepository,
+	batchSize, maxChanges int,
+	lease time.Duration,
+) *WidthBackfillJob {
+	return &WidthBackfillJob{
+		useCase:    useCase,
+		runs:       runs,
+		owner:      uuid.NewString(),
+		batchSize:  batchSize,
+		maxChanges: maxChanges,
+		lease:      lease,
+		wake:       make(chan struct{}, 1),
+	}
+}
+
+// Start stores a run held by this instance and hands it to Run. Only one
+// run at a time is allowed across instances.
+func (j *WidthBackfillJob) Start(ctx context.Context, start WidthBackfillStart) error {
+	if start.AfterTaskID != "" {
+		if _, err := domain.TaskIDFromString(start.AfterTaskID); err != nil {
+			return NewValidationError("after_task_id", err.Error())
+		}
+	}
+
+	now := time.Now()
+
+	err := j.runs.Start(ctx, &domain.WidthBackfillRun{
+		Running:    true,
+		DryRun:     start.DryRun,
+		StartedAt:  now,
+		LastTaskID: start.AfterTaskID,
+		Owner:      j.owner,
+		LeaseUntil: now.Add(j.lease),
+	})
+	if errors.Is(err, domain.ErrWidthBackfillRunActive) {
+		return ErrWidthBackfillRunning
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	select {
+	case j.wake <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Progress returns the stored current or last run; it is empty before the
+// first run.
+func (j *WidthBackfillJob) Progress(ctx context.Context) (WidthBackfillProgress, error) {
+	run, err := j.runs.Find(ctx)
+	if errors.Is(err, domain.ErrWidthBackfillRunNotFound) {
+		return WidthBackfillProgress{}, nil
+	}
+
+	if err != nil {
+		return WidthBackfillProgress{}, fmt.Errorf("%w: %v", ErrInternalError, err)
+	}
+
+	return toWidthBackfillProgress(run), nil
+}
+
+// Run blocks until ctx is cancelled. Besides the runs started on this
+// instance, it resumes runs whose lease expired, checking once per lease.
+func (j *WidthBackfillJob) Run(ctx context.Context) {
+	slog.InfoContext(ctx, "width backfill job started",
+		"batch_size", j.batchSize,
+		"lease", j.lease,
+		"owner", j.owner,
+	)
+
+	ticker := time.NewTicker(j.lease)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			slog.InfoContext(ctx, "width backfill job stopped")
+
+			return
+		case <-j.wake:
+		case <-ticker.C:
+		}
+
+		j.claim(ctx)
+	}
+}
+
+func (j *WidthBackfillJob) claim(ctx context.Context) {
+	now := time.Now()
+
+	run, err := j.runs.Claim(ctx, j.owner, now, now.Add(j.lease))
+	if errors.Is(err, domain.ErrWidthBackfillRunNotFound) {
+		return
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to claim width backfill run",
+			"error", err,
+		)
+
+		return
+	}
+
+	j.RunOnce(ctx, run)
+}
+
+// RunOnce backfills batches of run, held by this instance, until every task
+// is done, a batch fails, the lease is lost or ctx is cancelled. Reminds are
+// "future" relative to when this instance took the run.
+func (j *WidthBackfillJob) RunOnce(ctx context.Context, run *domain.WidthBackfillRun) {
+	now := time.Now()
+
+	slog.InfoContext(ctx, "width backfill run started",
+		"after_task_id", run.LastTaskID,
+		"dry_run", run.DryRun,
+		"tasks", run.Tasks,
+	)
+
+	for {
+		if ctx.Err() != nil {
+			j.release(ctx, run)
+
+			return
+		}
+
+		output, err := j.useCase.BackfillSlideWindowWidths(ctx, BackfillSlideWindowWidthsInput{
+			Now:         now,
+			AfterTaskID: run.LastTaskID,
+			BatchSize:   j.batchSize,
+			DryRun:      run.DryRun,
+		})
+		if err != nil {
+			if ctx.Err() != nil {
+				j.release(ctx, run)
+
+				return
+			}
+
+			j.finish(ctx, run, err)
+
+			return
+		}
+
+		j.record(run, output)
+
+		slog.InfoContext(ctx, "width backfill progress",
+			"tasks", run.Tasks,
+			"reminds", run.Reminds,
+			"changed", run.Changed,
+			"skipped", run.Skipped,
+			"failed", run.Failed,
+			"last_task_id", run.LastTaskID,
+			"dry_run", run.DryRun,
+		)
+
+		if output.Tasks < j.batchSize {
+			j.finish(ctx, run, nil)
+
+			return
+		}
+
+		run.LeaseUntil = time.Now().Add(j.lease)
+		if !j.save(ctx, run) {
+			return
+		}
+	}
+}
+
+func (j *WidthBackfillJob) record(run *domain.WidthBackfillRun, output BackfillSlideWindowWidthsOutput) {
+	run.Tasks += output.Tasks
+	run.Reminds += output.Reminds
+	run.Changed += len(output.Changes)
+	run.Overlap += output.Overlap
+	run.Skipped += output.Skipped
+	run.Failed += output.Failed
+
+	if output.LastTaskID != "" {
+		run.LastTaskID = output.LastTaskID
+	}
+
+	if run.DryRun {
+		room := max(j.maxChanges-len(run.Changes), 0)
+		if len(output.Changes) > room {
+			output.Changes = output.Changes[:room]
+			run.Truncated = true
+		}
+
+		for _, c := range output.Changes {
+			run.Changes = append(run.Changes, domain.WidthChange(c))
+		}
+	}
+}
+
+// release hands an interrupted run over to the other instances right away
+// instead of after the lease.
+func (j *WidthBackfillJob) release(ctx context.Context, run *domain.WidthBackfillRun) {
+	slog.InfoContext(ctx, "width backfill run interrupted",
+		"last_task_id", run.LastTaskID,
+	)
+
+	run.LeaseUntil = time.Now()
+	j.save(context.WithoutCancel(ctx), run)
+}
+
+func (j *WidthBackfillJob) finish(ctx context.Context, run *domain.WidthBackfillRun, err error) {
+	run.Running = false
+	run.FinishedAt = time.Now()
+
+	if err != nil {
+		run.Error = err.Error()
+	}
+
+	if !j.save(ctx, run) {
+		return
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "width backfill run stopped",
+			"error", err,
+			"last_task_id", run.LastTaskID,
+		)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "width backfill run finished",
+		"tasks", run.Tasks,
+		"changed", run.Changed,
+		"failed", run.Failed,
+	)
+}
+
+// save reports whether this instance still holds the run. When saving fails
+// otherwise, the run is left to be resumed from its last stored cursor once
+// the lease expires.
+func (j *WidthBackfillJob) save(ctx context.Context, run *domain.WidthBackfillRun) bool {
+	err := j.runs.Save(ctx, run)
+	if errors.Is(err, domain.ErrWidthBackfillLeaseLost) {
+		slog.WarnContext(ctx, "width backfill run taken over by another instance",
+			"last_task_id", run.LastTaskID,
+		)
+
+		return false
+	}
+
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to save width backfill run",
+			"last_task_id", run.LastTaskID,
+			"error", err,
+		)
+
+		return false
+	}
+
+	return true
+}
+
+func toWidthBackfillProgress(run *domain.WidthBackfillRun) WidthBackfillProgress {
+	var changes []WidthChangeOutput
+	if len(run.Changes) > 0 {
+		changes = make([]WidthChangeOutput, 0, len(run.Changes))
+		for _, c := range run.Changes {
+			changes = append(changes, WidthChangeOutput(c))
+		}
+	}
+
+	return WidthBackfillProgress{
+		Running:    run.Running,
+		DryRun:     run.DryRun,
+		StartedAt:  run.StartedAt,
+		FinishedAt: run.FinishedAt,
+		LastTaskID: run.LastTaskID,
+		Tasks:      run.Tasks,
+		Reminds:    run.Reminds,
+		Changed:    run.Changed,
+		Overlap:    run.Overlap,
+		Skipped:    run.Skipped,
+		Failed:     run.Failed,
+		Changes:    changes,
+		Truncated:  run.Truncated,
+		Error:      run.Error,
+	}
+}
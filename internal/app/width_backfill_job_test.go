@@ -0,0 +1,378 @@
+package app_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type fakeBackfillUseCase struct {
+	app.RemindUseCase
+
+	mu      sync.Mutex
+	outputs []app.BackfillSlideWindowWidthsOutput
+	err     error
+	inputs  []app.BackfillSlideWindowWidthsInput
+}
+
+func (f *fakeBackfillUseCase) BackfillSlideWindowWidths(
+	_ context.Context,
+	input app.BackfillSlideWindowWidthsInput,
+) (app.BackfillSlideWindowWidthsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.inputs = append(f.inputs, input)
+
+	if f.err != nil {
+		return app.BackfillSlideWindowWidthsOutput{}, f.err
+	}
+
+	if len(f.outputs) == 0 {
+		return app.BackfillSlideWindowWidthsOutput{}, nil
+	}
+
+	output := f.outputs[0]
+	f.outputs = f.outputs[1:]
+
+	return output, nil
+}
+
+func (f *fakeBackfillUseCase) calls() []app.BackfillSlideWindowWidthsInput {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]app.BackfillSlideWindowWidthsInput(nil), f.inputs...)
+}
+
+// fakeWidthBackfillRunRepository stores the run in memory with the lease
+// rules of the database one.
+type fakeWidthBackfillRunRepository struct {
+	mu      sync.Mutex
+	run     *domain.WidthBackfillRun
+	saveErr error
+}
+
+func copyWidthBackfillRun(run *domain.WidthBackfillRun) *domain.WidthBackfillRun {
+	c := *run
+	c.Changes = slices.Clone(run.Changes)
+
+	return &c
+}
+
+func (f *fakeWidthBackfillRunRepository) Find(_ context.Context) (*domain.WidthBackfillRun, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.run == nil {
+		return nil, domain.ErrWidthBackfillRunNotFound
+	}
+
+	return copyWidthBackfillRun(f.run), nil
+}
+
+func (f *fakeWidthBackfillRunRepository) Start(_ context.Context, run *domain.WidthBackfillRun) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.run != nil && f.run.Running {
+		return domain.ErrWidthBackfillRunActive
+	}
+
+	f.run = copyWidthBackfillRun(run)
+
+	return nil
+}
+
+func (f *fakeWidthBackfillRunRepository) Claim(
+	_ context.Context,
+	owner string,
+	now, leaseUntil time.Time,
+) (*domain.WidthBackfillRun, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.run == nil || !f.run.Running || (f.run.Owner != owner && !f.run.LeaseUntil.Before(now)) {
+		return nil, domain.ErrWidthBackfillRunNotFound
+	}
+
+	f.run.Owner = owner
+	f.run.LeaseUntil = leaseUntil
+
+	return copyWidthBackfillRun(f.run), nil
+}
+
+func (f *fakeWidthBackfillRunRepository) Save(_ context.Context, run *domain.WidthBackfillRun) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+
+	if f.run == nil || !f.run.Running || f.run.Owner != run.Owner {
+		return domain.ErrWidthBackfillLeaseLost
+	}
+
+	f.run = copyWidthBackfillRun(run)
+
+	return nil
+}
+
+func runBackfillJob(t *testing.T, job *app.WidthBackfillJob) {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		job.Run(ctx)
+		close(done)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+}
+
+// waitBackfillFinished returns the progress of the finished run.
+func waitBackfillFinished(t *testing.T, job *app.WidthBackfillJob) app.WidthBackfillProgress {
+	t.Helper()
+
+	var progress app.WidthBackfillProgress
+
+	require.Eventually(t, func() bool {
+		p, err := job.Progress(context.Background())
+		if err != nil {
+			return false
+		}
+
+		progress = p
+
+		return !p.Running
+	}, time.Second, 5*time.Millisecond)
+
+	return progress
+}
+
+func TestWidthBackfillJobSuccess(t *testing.T) {
+	change := app.WidthChangeOutput{RemindID: "r1", TaskID: "t1", OldWidth: 300, NewWidth: 420}
+
+	tests := []struct {
+		name            string
+		start           app.WidthBackfillStart
+		outputs         []app.BackfillSlideWindowWidthsOutput
+		expectedCalls   int
+		expectedLast    string
+		expectedChanges int
+		expectedCapped  bool
+	}{
+		{
+			name:          "nothing to backfill",
+			start:         app.WidthBackfillStart{},
+			outputs:       nil,
+			expectedCalls: 1,
+		},
+		{
+			name:  "full batches are walked by cursor",
+			start: app.WidthBackfillStart{},
+			outputs: []app.BackfillSlideWindowWidthsOutput{
+				{Tasks: 2, Reminds: 4, Changes: []app.WidthChangeOutput{change}, LastTaskID: "t2"},
+				{Tasks: 1, Reminds: 1, LastTaskID: "t3"},
+			},
+			expectedCalls: 2,
+			expectedLast:  "t3",
+		},
+		{
+			name:  "dry run keeps the diff",
+			start: app.WidthBackfillStart{DryRun: true},
+			outputs: []app.BackfillSlideWindowWidthsOutput{
+				{Tasks: 1, Reminds: 2, Changes: []app.WidthChangeOutput{change, change}, LastTaskID: "t1"},
+			},
+			expectedCalls:   1,
+			expectedLast:    "t1",
+			expectedChanges: 2,
+		},
+		{
+			name:  "dry run diff is capped",
+			start: app.WidthBackfillStart{DryRun: true},
+			outputs: []app.BackfillSlideWindowWidthsOutput{
+				{Tasks: 2, Reminds: 8, Changes: slices.Repeat([]app.WidthChangeOutput{change}, 8), LastTaskID: "t2"},
+				{Tasks: 1, Reminds: 4, Changes: slices.Repeat([]app.WidthChangeOutput{change}, 4), LastTaskID: "t3"},
+			},
+			expectedCalls:   2,
+			expectedLast:    "t3",
+			expectedChanges: 10,
+			expectedCapped:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := &fakeBackfillUseCase{outputs: tt.outputs}
+			job := app.NewWidthBackfillJob(useCase, &fakeWidthBackfillRunRepository{}, 2, 10, time.Minute)
+			runBackfillJob(t, job)
+
+			require.NoError(t, job.Start(context.Background(), tt.start))
+
+			progress := waitBackfillFinished(t, job)
+			assert.Empty(t, progress.Error)
+			assert.False(t, progress.FinishedAt.IsZero())
+			assert.Equal(t, tt.expectedLast, progress.LastTaskID)
+			assert.Len(t, progress.Changes, tt.expectedChanges)
+			assert.Equal(t, tt.expectedCapped, progress.Truncated)
+
+			calls := useCase.calls()
+			require.Len(t, calls, tt.expectedCalls)
+
+			for i, call := range calls {
+				assert.Equal(t, tt.start.DryRun, call.DryRun)
+				assert.Equal(t, 2, call.BatchSize)
+
+				if i > 0 {
+					assert.Equal(t, tt.outputs[i-1].LastTaskID, call.AfterTaskID)
+				}
+			}
+		})
+	}
+}
+
+func TestWidthBackfillJobStartAfterSuccess(t *testing.T) {
+	useCase := &fakeBackfillUseCase{}
+	job := app.NewWidthBackfillJob(useCase, &fakeWidthBackfillRunRepository{}, 2, 10, time.Minute)
+	runBackfillJob(t, job)
+
+	after := "019a0000-0000-7000-8000-000000000001"
+	require.NoError(t, job.Start(context.Background(), app.WidthBackfillStart{AfterTaskID: after}))
+
+	progress := waitBackfillFinished(t, job)
+
+	calls := useCase.calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, after, calls[0].AfterTaskID)
+	assert.Equal(t, after, progress.LastTaskID)
+}
+
+func TestWidthBackfillJobResumeSuccess(t *testing.T) {
+	useCase := &fakeBackfillUseCase{outputs: []app.BackfillSlideWindowWidthsOutput{
+		{Tasks: 1, Reminds: 3, LastTaskID: "t3"},
+	}}
+	runs := &fakeWidthBackfillRunRepository{run: &domain.WidthBackfillRun{
+		Running:    true,
+		DryRun:     true,
+		StartedAt:  time.Now().Add(-time.Hour),
+		LastTaskID: "t2",
+		Tasks:      2,
+		Reminds:    5,
+		Owner:      "stopped-instance",
+		LeaseUntil: time.Now().Add(-time.Minute),
+	}}
+	job := app.NewWidthBackfillJob(useCase, runs, 2, 10, 10*time.Millisecond)
+	runBackfillJob(t, job)
+
+	progress := waitBackfillFinished(t, job)
+
+	calls := useCase.calls()
+	require.Len(t, calls, 1)
+	assert.Equal(t, "t2", calls[0].AfterTaskID)
+	assert.True(t, calls[0].DryRun)
+	assert.Equal(t, "t3", progress.LastTaskID)
+	assert.Equal(t, 3, progress.Tasks)
+	assert.Equal(t, 8, progress.Reminds)
+}
+
+func TestWidthBackfillJobError(t *testing.T) {
+	t.Run("invalid start point", func(t *testing.T) {
+		job := app.NewWidthBackfillJob(&fakeBackfillUseCase{}, &fakeWidthBackfillRunRepository{}, 2, 10, time.Minute)
+
+		err := job.Start(context.Background(), app.WidthBackfillStart{AfterTaskID: "not-a-task"})
+
+		var validationErr *app.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, "after_task_id", validationErr.Field)
+	})
+
+	t.Run("already running", func(t *testing.T) {
+		runs := &fakeWidthBackfillRunRepository{}
+
+		// Without Run the first start stays pending.
+		require.NoError(t, app.NewWidthBackfillJob(&fakeBackfillUseCase{}, runs, 2, 10, time.Minute).
+			Start(context.Background(), app.WidthBackfillStart{}))
+
+		// Another instance sees the stored run.
+		err := app.NewWidthBackfillJob(&fakeBackfillUseCase{}, runs, 2, 10, time.Minute).
+			Start(context.Background(), app.WidthBackfillStart{})
+
+		assert.ErrorIs(t, err, app.ErrWidthBackfillRunning)
+	})
+
+	t.Run("running run is not claimed before its lease expires", func(t *testing.T) {
+		useCase := &fakeBackfillUseCase{}
+		runs := &fakeWidthBackfillRunRepository{run: &domain.WidthBackfillRun{
+			Running:    true,
+			Owner:      "other-instance",
+			LeaseUntil: time.Now().Add(time.Hour),
+		}}
+		job := app.NewWidthBackfillJob(useCase, runs, 2, 10, time.Millisecond)
+		runBackfillJob(t, job)
+
+		time.Sleep(20 * time.Millisecond)
+
+		assert.Empty(t, useCase.calls())
+	})
+
+	t.Run("failed batch stops the run", func(t *testing.T) {
+		useCase := &fakeBackfillUseCase{err: errors.New("db down")}
+		job := app.NewWidthBackfillJob(useCase, &fakeWidthBackfillRunRepository{}, 2, 10, time.Minute)
+		runBackfillJob(t, job)
+
+		require.NoError(t, job.Start(context.Background(), app.WidthBackfillStart{}))
+
+		progress := waitBackfillFinished(t, job)
+		assert.Equal(t, "db down", progress.Error)
+		assert.Len(t, useCase.calls(), 1)
+	})
+
+	t.Run("lost lease stops the run", func(t *testing.T) {
+		useCase := &fakeBackfillUseCase{outputs: []app.BackfillSlideWindowWidthsOutput{
+			{Tasks: 2, LastTaskID: "t2"},
+			{Tasks: 2, LastTaskID: "t4"},
+		}}
+		runs := &fakeWidthBackfillRunRepository{saveErr: domain.ErrWidthBackfillLeaseLost}
+		job := app.NewWidthBackfillJob(useCase, runs, 2, 10, time.Minute)
+
+		job.RunOnce(context.Background(), &domain.WidthBackfillRun{Running: true})
+
+		assert.Len(t, useCase.calls(), 1)
+	})
+
+	t.Run("interrupted run is released", func(t *testing.T) {
+		useCase := &fakeBackfillUseCase{}
+		runs := &fakeWidthBackfillRunRepository{}
+		job := app.NewWidthBackfillJob(useCase, runs, 2, 10, time.Hour)
+		require.NoError(t, job.Start(context.Background(), app.WidthBackfillStart{}))
+
+		run, err := runs.Claim(context.Background(), "", time.Now().Add(2*time.Hour), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		job.RunOnce(ctx, run)
+
+		stored, err := runs.Find(context.Background())
+		require.NoError(t, err)
+		assert.True(t, stored.Running)
+		assert.False(t, stored.LeaseUntil.After(time.Now()))
+		assert.Empty(t, useCase.calls())
+	})
+}
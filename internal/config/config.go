@@ -4,19 +4,180 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	Log      LogConfig
-	PubSub   PubSubConfig
+	Platform   PlatformConfig
+	Server     ServerConfig
+	Database   DatabaseConfig
+	Log        LogConfig
+	PubSub     PubSubConfig
+	Escalation EscalationConfig
+	AutoResume AutoResumeConfig
+	RateLimit  RateLimitConfig
+	Window     WindowPolicyConfig
+	Density    DensityConfig
+	Backfill   WidthBackfillConfig
+	Times      RemindTimesConfig
+	Adaptive   AdaptiveWindowConfig
+	TaskTypes  []TaskTypeConfig
+}
+
+const (
+	PlatformLocal  = "local"
+	PlatformGCloud = "gcloud"
+
+	ExporterOTLP   = "otlp"
+	ExporterGCloud = "gcloud"
+	ExporterNone   = "none"
+)
+
+// PlatformConfig selects the runtime backends. Every backend is compiled into
+// the binary, so one image can be promoted across environments.
+type PlatformConfig struct {
+	Name           string // local or gcloud
+	TraceExporter  string // otlp, gcloud or none
+	MetricExporter string // otlp, gcloud or none
 }
 
 type PubSubConfig struct {
-	NatsURL         string
-	GCloudProjectID string
+	// Backends lists the brokers events are published to (nats, gcloud or
+	// gochannel). More than one fans events out, e.g. during a migration.
+	Backends []string
+	// BestEffortBackends lists backends whose publish failures are only logged.
+	BestEffortBackends []string
+	NatsURL            string
+	GCloudProjectID    string
+	DebugEndpoint      bool   // expose published messages of the in-memory publisher over HTTP
+	EventMode          string // CloudEvents content mode: binary or structured
+	EventSource        string // CloudEvents source attribute
+	NatsStream         NatsStreamConfig
+	NatsAuth           NatsAuthConfig
+}
+
+// NatsStreamConfig describes the JetStream stream provisioned for remind events.
+type NatsStreamConfig struct {
+	Name                   string
+	Subjects               []string
+	RemindCancelledSubject string
+	RemindsPausedSubject   string
+	RemindsResumedSubject  string
+	Retention              string // limits, interest or workqueue
+	MaxAge                 time.Duration
+	MaxBytes               int64
+	Storage                string // file or memory
+	Replicas               int
+}
+
+type NatsAuthConfig struct {
+	CredsFile    string
+	NKeySeedFile string
+	TLSCertFile  string
+	TLSKeyFile   string
+	TLSCAFile    string
+}
+
+// EscalationConfig controls the background job that escalates unacknowledged reminds.
+type EscalationConfig struct {
+	Enabled   bool
+	Interval  time.Duration
+	BatchSize int
+}
+
+// AutoResumeConfig controls the background job that resumes paused users whose
+// resume time has passed.
+type AutoResumeConfig struct {
+	Enabled   bool
+	Interval  time.Duration
+	BatchSize int
+}
+
+// RateLimitConfig caps how many of a user's reminds fire within a rolling
+// window. MaxPerTaskType is keyed by task type; zero leaves a type unlimited.
+type RateLimitConfig struct {
+	Enabled        bool
+	Window         time.Duration
+	MaxPerTaskType map[string]int
+}
+
+// WindowPolicyConfig tunes slide window widths per task type. While disabled
+// the built-in widths apply.
+type WindowPolicyConfig struct {
+	Enabled           bool
+	ParamsPerTaskType map[string]WindowParamsConfig
+}
+
+type WindowParamsConfig struct {
+	TargetWidth          time.Duration
+	IntermediateRatio    float64
+	IntermediateMinWidth time.Duration
+	IntermediateMaxWidth time.Duration
+}
+
+// DensityConfig widens the windows of reminds landing in minutes already
+// holding at least HotThreshold reminds, by at most MaxFactor, for TaskTypes.
+type DensityConfig struct {
+	Enabled      bool
+	HotThreshold int
+	MaxFactor    float64
+	TaskTypes    []string
+}
+
+// WidthBackfillConfig exposes the admin endpoint that recalculates the stored
+// slide window widths of future reminds, BatchSize tasks at a time. Dry runs
+// report at most MaxChanges changes. The instance working on a run holds it
+// for Lease after each batch; another instance takes the run over once the
+// lease has expired.
+type WidthBackfillConfig struct {
+	Enabled    bool
+	BatchSize  int
+	MaxChanges int
+	Lease      time.Duration
+}
+
+// RemindTimesConfig normalizes the requested times of a task: they are
+// truncated to Truncation, capped at MaxTimes and Horizon ahead, and spaced
+// at least MinSpacingPerTaskType apart.
+type RemindTimesConfig struct {
+	Truncation            time.Duration
+	MaxTimes              int
+	Horizon               time.Duration
+	MinSpacingPerTaskType map[string]time.Duration
+}
+
+// AdaptiveWindowConfig scales slide window widths by each user's delivery
+// history of a task type. Every Interval the statistics are recomputed from
+// the reminds of the last Lookback; at least MinSamples reminds are needed.
+// A CollisionThreshold share of reminds whose windows overlapped another
+// remind of the user widens windows by
+// WidenFactor, an OnTimeThreshold share of on-time acknowledgments narrows
+// them by NarrowFactor.
+type AdaptiveWindowConfig struct {
+	Enabled            bool
+	Interval           time.Duration
+	Lookback           time.Duration
+	MinSamples         int
+	OnTimeThreshold    float64
+	CollisionThreshold float64
+	NarrowFactor       float64
+	WidenFactor        float64
+}
+
+// TaskTypeConfig registers a task type beyond the built-in four under
+// EnumValue of the common.v1.TaskType enum. A zero EscalationAfter never
+// escalates; Schedule lists the automatic schedule's offsets before the
+// deadline.
+type TaskTypeConfig struct {
+	Name            string
+	EnumValue       int32
+	Window          WindowParamsConfig
+	EscalationAfter time.Duration
+	EscalationSteps []string
+	Schedule        []time.Duration
+	QuietHours      string
+	Acknowledge     string
 }
 
 type LogConfig struct {
@@ -37,7 +198,51 @@ type DatabaseConfig struct {
 	ConnMaxLifetime time.Duration
 }
 
+// LoadPlatform reads only the platform selection, which observability needs
+// before the rest of the configuration is loaded.
+func LoadPlatform() (PlatformConfig, error) {
+	name := getEnv("PLATFORM", PlatformLocal)
+	if name != PlatformLocal && name != PlatformGCloud {
+		return PlatformConfig{}, fmt.Errorf("invalid PLATFORM: %q (must be local or gcloud)", name)
+	}
+
+	defaultExporter := ExporterOTLP
+	if name == PlatformGCloud {
+		defaultExporter = ExporterGCloud
+	}
+
+	traceExporter := getEnv("TRACE_EXPORTER", defaultExporter)
+	if !isValidExporter(traceExporter) {
+		return PlatformConfig{}, fmt.Errorf("invalid TRACE_EXPORTER: %q (must be otlp, gcloud or none)", traceExporter)
+	}
+
+	metricExporter := getEnv("METRIC_EXPORTER", defaultExporter)
+	if !isValidExporter(metricExporter) {
+		return PlatformConfig{}, fmt.Errorf("invalid METRIC_EXPORTER: %q (must be otlp, gcloud or none)", metricExporter)
+	}
+
+	return PlatformConfig{
+		Name:           name,
+		TraceExporter:  traceExporter,
+		MetricExporter: metricExporter,
+	}, nil
+}
+
+func isValidExporter(name string) bool {
+	switch name {
+	case ExporterOTLP, ExporterGCloud, ExporterNone:
+		return true
+	default:
+		return false
+	}
+}
+
 func Load() (*Config, error) {
+	platform, err := LoadPlatform()
+	if err != nil {
+		return nil, err
+	}
+
 	serverPort, err := strconv.Atoi(getEnv("SERVER_PORT", "8080"))
 	if err != nil {
 		return nil, fmt.Errorf("invalid SERVER_PORT: %w", err)
@@ -68,12 +273,80 @@ func Load() (*Config, error) {
 		return nil, fmt.Errorf("invalid DB_CONN_MAX_LIFETIME: %w", err)
 	}
 
+	pubSubDebugEndpoint, err := strconv.ParseBool(getEnv("PUBSUB_DEBUG_ENDPOINT", "false"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid PUBSUB_DEBUG_ENDPOINT: %w", err)
+	}
+
+	eventMode := getEnv("CLOUDEVENTS_MODE", "binary")
+	if eventMode != "binary" && eventMode != "structured" {
+		return nil, fmt.Errorf("invalid CLOUDEVENTS_MODE: %q (must be binary or structured)", eventMode)
+	}
+
+	natsStream, err := loadNatsStreamConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	escalation, err := loadEscalationConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	autoResume, err := loadAutoResumeConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	taskTypes, err := loadTaskTypesConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	customTaskTypes := make([]string, 0, len(taskTypes))
+	for _, taskType := range taskTypes {
+		customTaskTypes = append(customTaskTypes, taskType.Name)
+	}
+
+	rateLimit, err := loadRateLimitConfig(customTaskTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := loadWindowPolicyConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	density, err := loadDensityConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	backfill, err := loadWidthBackfillConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := loadRemindTimesConfig(customTaskTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	adaptive, err := loadAdaptiveWindowConfig()
+	if err != nil {
+		return nil, err
+	}
+
 	dsn := os.Getenv("POSTGRES_DSN")
 	if dsn == "" {
 		return nil, fmt.Errorf("POSTGRES_DSN environment variable is required")
 	}
 
+	natsURL := os.Getenv("NATS_URL")
+
 	return &Config{
+		Platform: platform,
 		Server: ServerConfig{
 			Host:         getEnv("SERVER_HOST", "0.0.0.0"),
 			Port:         serverPort,
@@ -90,12 +363,451 @@ func Load() (*Config, error) {
 			Level: getEnv("LOG_LEVEL", "info"),
 		},
 		PubSub: PubSubConfig{
-			NatsURL:         os.Getenv("NATS_URL"),
-			GCloudProjectID: os.Getenv("GCLOUD_PROJECT_ID"),
+			Backends:           splitList(getEnv("PUBSUB_BACKEND", defaultPubSubBackend(platform.Name, natsURL))),
+			BestEffortBackends: splitList(os.Getenv("PUBSUB_BEST_EFFORT_BACKENDS")),
+			NatsURL:            natsURL,
+			GCloudProjectID:    os.Getenv("GCLOUD_PROJECT_ID"),
+			DebugEndpoint:      pubSubDebugEndpoint,
+			EventMode:          eventMode,
+			EventSource:        getEnv("CLOUDEVENTS_SOURCE", "/primind/time-mgmt"),
+			NatsStream:         natsStream,
+			NatsAuth: NatsAuthConfig{
+				CredsFile:    os.Getenv("NATS_CREDS_FILE"),
+				NKeySeedFile: os.Getenv("NATS_NKEY_SEED_FILE"),
+				TLSCertFile:  os.Getenv("NATS_TLS_CERT_FILE"),
+				TLSKeyFile:   os.Getenv("NATS_TLS_KEY_FILE"),
+				TLSCAFile:    os.Getenv("NATS_TLS_CA_FILE"),
+			},
 		},
+		Escalation: escalation,
+		AutoResume: autoResume,
+		RateLimit:  rateLimit,
+		Window:     window,
+		Density:    density,
+		Backfill:   backfill,
+		Times:      times,
+		Adaptive:   adaptive,
+		TaskTypes:  taskTypes,
+	}, nil
+}
+
+func loadEscalationConfig() (EscalationConfig, error) {
+	enabled, interval, batchSize, err := loadJobConfig("ESCALATION")
+	if err != nil {
+		return EscalationConfig{}, err
+	}
+
+	return EscalationConfig{
+		Enabled:   enabled,
+		Interval:  interval,
+		BatchSize: batchSize,
+	}, nil
+}
+
+func loadAutoResumeConfig() (AutoResumeConfig, error) {
+	enabled, interval, batchSize, err := loadJobConfig("AUTO_RESUME")
+	if err != nil {
+		return AutoResumeConfig{}, err
+	}
+
+	return AutoResumeConfig{
+		Enabled:   enabled,
+		Interval:  interval,
+		BatchSize: batchSize,
+	}, nil
+}
+
+// loadRateLimitConfig reads RATE_LIMIT_WINDOW and a RATE_LIMIT_MAX_<TYPE>
+// cap per task type. Scheduled reminds and custom task types are unlimited by
+// default; scheduled times were chosen deliberately.
+func loadRateLimitConfig(customTaskTypes []string) (RateLimitConfig, error) {
+	enabled, err := strconv.ParseBool(getEnv("RATE_LIMIT_ENABLED", "false"))
+	if err != nil {
+		return RateLimitConfig{}, fmt.Errorf("invalid RATE_LIMIT_ENABLED: %w", err)
+	}
+
+	window, err := time.ParseDuration(getEnv("RATE_LIMIT_WINDOW", "1m"))
+	if err != nil || window <= 0 {
+		return RateLimitConfig{}, fmt.Errorf("invalid RATE_LIMIT_WINDOW: %q", os.Getenv("RATE_LIMIT_WINDOW"))
+	}
+
+	type taskTypeDefault struct {
+		taskType   string
+		maxReminds string
+	}
+
+	defaults := []taskTypeDefault{
+		{taskType: "short", maxReminds: "4"},
+		{taskType: "near", maxReminds: "3"},
+		{taskType: "relaxed", maxReminds: "2"},
+		{taskType: "scheduled", maxReminds: "0"},
+	}
+
+	for _, taskType := range customTaskTypes {
+		defaults = append(defaults, taskTypeDefault{taskType: taskType, maxReminds: "0"})
+	}
+
+	maxPerTaskType := make(map[string]int, len(defaults))
+	for _, d := range defaults {
+		key := "RATE_LIMIT_MAX_" + strings.ToUpper(d.taskType)
+
+		maxReminds, err := strconv.Atoi(getEnv(key, d.maxReminds))
+		if err != nil || maxReminds < 0 {
+			return RateLimitConfig{}, fmt.Errorf("invalid %s: %q", key, os.Getenv(key))
+		}
+
+		maxPerTaskType[d.taskType] = maxReminds
+	}
+
+	return RateLimitConfig{
+		Enabled:        enabled,
+		Window:         window,
+		MaxPerTaskType: maxPerTaskType,
+	}, nil
+}
+
+// loadWindowPolicyConfig reads WINDOW_<TYPE>_TARGET_WIDTH,
+// WINDOW_<TYPE>_INTERMEDIATE_RATIO, WINDOW_<TYPE>_INTERMEDIATE_MIN_WIDTH and
+// WINDOW_<TYPE>_INTERMEDIATE_MAX_WIDTH per task type. The defaults match the
+// built-in widths; range checks are left to the domain.
+func loadWindowPolicyConfig() (WindowPolicyConfig, error) {
+	enabled, err := strconv.ParseBool(getEnv("WINDOW_POLICY_ENABLED", "false"))
+	if err != nil {
+		return WindowPolicyConfig{}, fmt.Errorf("invalid WINDOW_POLICY_ENABLED: %w", err)
+	}
+
+	defaults := []struct {
+		taskType             string
+		targetWidth          string
+		intermediateMaxWidth string
+	}{
+		{taskType: "short", targetWidth: "2m", intermediateMaxWidth: "5m"},
+		{taskType: "near", targetWidth: "5m", intermediateMaxWidth: "10m"},
+		{taskType: "relaxed", targetWidth: "5m", intermediateMaxWidth: "10m"},
+		{taskType: "scheduled", targetWidth: "2m", intermediateMaxWidth: "10m"},
+	}
+
+	paramsPerTaskType := make(map[string]WindowParamsConfig, len(defaults))
+	for _, d := range defaults {
+		params, err := loadWindowParamsConfig(d.taskType, d.targetWidth, d.intermediateMaxWidth)
+		if err != nil {
+			return WindowPolicyConfig{}, err
+		}
+
+		paramsPerTaskType[d.taskType] = params
+	}
+
+	return WindowPolicyConfig{
+		Enabled:           enabled,
+		ParamsPerTaskType: paramsPerTaskType,
+	}, nil
+}
+
+// loadWindowParamsConfig reads the WINDOW_<TYPE>_* widths of one task type.
+func loadWindowParamsConfig(taskType, targetWidth, intermediateMaxWidth string) (WindowParamsConfig, error) {
+	prefix := "WINDOW_" + strings.ToUpper(taskType) + "_"
+
+	widths := make(map[string]time.Duration, 3)
+	for suffix, defaultValue := range map[string]string{
+		"TARGET_WIDTH":           targetWidth,
+		"INTERMEDIATE_MIN_WIDTH": "1m",
+		"INTERMEDIATE_MAX_WIDTH": intermediateMaxWidth,
+	} {
+		key := prefix + suffix
+
+		width, err := time.ParseDuration(getEnv(key, defaultValue))
+		if err != nil {
+			return WindowParamsConfig{}, fmt.Errorf("invalid %s: %q", key, os.Getenv(key))
+		}
+
+		widths[suffix] = width
+	}
+
+	ratioKey := prefix + "INTERMEDIATE_RATIO"
+
+	ratio, err := strconv.ParseFloat(getEnv(ratioKey, "0.3"), 64)
+	if err != nil {
+		return WindowParamsConfig{}, fmt.Errorf("invalid %s: %q", ratioKey, os.Getenv(ratioKey))
+	}
+
+	return WindowParamsConfig{
+		TargetWidth:          widths["TARGET_WIDTH"],
+		IntermediateRatio:    ratio,
+		IntermediateMinWidth: widths["INTERMEDIATE_MIN_WIDTH"],
+		IntermediateMaxWidth: widths["INTERMEDIATE_MAX_WIDTH"],
+	}, nil
+}
+
+// loadDensityConfig reads the density widening settings. Scheduled reminds
+// are left out by default because their times were chosen deliberately.
+func loadDensityConfig() (DensityConfig, error) {
+	enabled, err := strconv.ParseBool(getEnv("DENSITY_ENABLED", "false"))
+	if err != nil {
+		return DensityConfig{}, fmt.Errorf("invalid DENSITY_ENABLED: %w", err)
+	}
+
+	hotThreshold, err := strconv.Atoi(getEnv("DENSITY_HOT_THRESHOLD", "100"))
+	if err != nil {
+		return DensityConfig{}, fmt.Errorf("invalid DENSITY_HOT_THRESHOLD: %w", err)
+	}
+
+	maxFactor, err := strconv.ParseFloat(getEnv("DENSITY_MAX_FACTOR", "2"), 64)
+	if err != nil {
+		return DensityConfig{}, fmt.Errorf("invalid DENSITY_MAX_FACTOR: %w", err)
+	}
+
+	return DensityConfig{
+		Enabled:      enabled,
+		HotThreshold: hotThreshold,
+		MaxFactor:    maxFactor,
+		TaskTypes:    splitList(getEnv("DENSITY_TASK_TYPES", "short,near,relaxed")),
+	}, nil
+}
+
+func loadWidthBackfillConfig() (WidthBackfillConfig, error) {
+	enabled, err := strconv.ParseBool(getEnv("WIDTH_BACKFILL_ENABLED", "false"))
+	if err != nil {
+		return WidthBackfillConfig{}, fmt.Errorf("invalid WIDTH_BACKFILL_ENABLED: %w", err)
+	}
+
+	batchSize, err := strconv.Atoi(getEnv("WIDTH_BACKFILL_BATCH_SIZE", "100"))
+	if err != nil || batchSize <= 0 {
+		return WidthBackfillConfig{}, fmt.Errorf("invalid WIDTH_BACKFILL_BATCH_SIZE: %q", os.Getenv("WIDTH_BACKFILL_BATCH_SIZE"))
+	}
+
+	maxChanges, err := strconv.Atoi(getEnv("WIDTH_BACKFILL_MAX_CHANGES", "1000"))
+	if err != nil || maxChanges <= 0 {
+		return WidthBackfillConfig{}, fmt.Errorf("invalid WIDTH_BACKFILL_MAX_CHANGES: %q", os.Getenv("WIDTH_BACKFILL_MAX_CHANGES"))
+	}
+
+	lease, err := time.ParseDuration(getEnv("WIDTH_BACKFILL_LEASE", "1m"))
+	if err != nil || lease <= 0 {
+		return WidthBackfillConfig{}, fmt.Errorf("invalid WIDTH_BACKFILL_LEASE: %q", os.Getenv("WIDTH_BACKFILL_LEASE"))
+	}
+
+	return WidthBackfillConfig{
+		Enabled:    enabled,
+		BatchSize:  batchSize,
+		MaxChanges: maxChanges,
+		Lease:      lease,
+	}, nil
+}
+
+// loadRemindTimesConfig reads REMIND_TIME_TRUNCATION, REMIND_MAX_TIMES,
+// REMIND_HORIZON and a REMIND_MIN_SPACING_<TYPE> per task type.
+func loadRemindTimesConfig(customTaskTypes []string) (RemindTimesConfig, error) {
+	truncation, err := time.ParseDuration(getEnv("REMIND_TIME_TRUNCATION", "1s"))
+	if err != nil || truncation < 0 {
+		return RemindTimesConfig{}, fmt.Errorf("invalid REMIND_TIME_TRUNCATION: %q", os.Getenv("REMIND_TIME_TRUNCATION"))
+	}
+
+	maxTimes, err := strconv.Atoi(getEnv("REMIND_MAX_TIMES", "20"))
+	if err != nil || maxTimes <= 0 {
+		return RemindTimesConfig{}, fmt.Errorf("invalid REMIND_MAX_TIMES: %q", os.Getenv("REMIND_MAX_TIMES"))
+	}
+
+	horizon, err := time.ParseDuration(getEnv("REMIND_HORIZON", "8760h"))
+	if err != nil || horizon <= 0 {
+		return RemindTimesConfig{}, fmt.Errorf("invalid REMIND_HORIZON: %q", os.Getenv("REMIND_HORIZON"))
+	}
+
+	taskTypes := append([]string{"short", "near", "relaxed", "scheduled"}, customTaskTypes...)
+
+	minSpacingPerTaskType := make(map[string]time.Duration, len(taskTypes))
+	for _, taskType := range taskTypes {
+		key := "REMIND_MIN_SPACING_" + strings.ToUpper(taskType)
+
+		spacing, err := time.ParseDuration(getEnv(key, "1m"))
+		if err != nil || spacing < 0 {
+			return RemindTimesConfig{}, fmt.Errorf("invalid %s: %q", key, os.Getenv(key))
+		}
+
+		minSpacingPerTaskType[taskType] = spacing
+	}
+
+	return RemindTimesConfig{
+		Truncation:            truncation,
+		MaxTimes:              maxTimes,
+		Horizon:               horizon,
+		MinSpacingPerTaskType: minSpacingPerTaskType,
+	}, nil
+}
+
+// loadAdaptiveWindowConfig reads the ADAPTIVE_WINDOW_* settings. Thresholds
+// and factors are validated by the domain policy.
+func loadAdaptiveWindowConfig() (AdaptiveWindowConfig, error) {
+	enabled, err := strconv.ParseBool(getEnv("ADAPTIVE_WINDOW_ENABLED", "false"))
+	if err != nil {
+		return AdaptiveWindowConfig{}, fmt.Errorf("invalid ADAPTIVE_WINDOW_ENABLED: %w", err)
+	}
+
+	interval, err := time.ParseDuration(getEnv("ADAPTIVE_WINDOW_INTERVAL", "1h"))
+	if err != nil || interval <= 0 {
+		return AdaptiveWindowConfig{}, fmt.Errorf("invalid ADAPTIVE_WINDOW_INTERVAL: %q", os.Getenv("ADAPTIVE_WINDOW_INTERVAL"))
+	}
+
+	lookback, err := time.ParseDuration(getEnv("ADAPTIVE_WINDOW_LOOKBACK", "720h"))
+	if err != nil || lookback <= 0 {
+		return AdaptiveWindowConfig{}, fmt.Errorf("invalid ADAPTIVE_WINDOW_LOOKBACK: %q", os.Getenv("ADAPTIVE_WINDOW_LOOKBACK"))
+	}
+
+	minSamples, err := strconv.Atoi(getEnv("ADAPTIVE_WINDOW_MIN_SAMPLES", "20"))
+	if err != nil {
+		return AdaptiveWindowConfig{}, fmt.Errorf("invalid ADAPTIVE_WINDOW_MIN_SAMPLES: %w", err)
+	}
+
+	floats := map[string]string{
+		"ADAPTIVE_WINDOW_ON_TIME_THRESHOLD":   "0.9",
+		"ADAPTIVE_WINDOW_COLLISION_THRESHOLD": "0.2",
+		"ADAPTIVE_WINDOW_NARROW_FACTOR":       "0.8",
+		"ADAPTIVE_WINDOW_WIDEN_FACTOR":        "1.5",
+	}
+
+	values := make(map[string]float64, len(floats))
+	for key, fallback := range floats {
+		value, err := strconv.ParseFloat(getEnv(key, fallback), 64)
+		if err != nil {
+			return AdaptiveWindowConfig{}, fmt.Errorf("invalid %s: %w", key, err)
+		}
+
+		values[key] = value
+	}
+
+	return AdaptiveWindowConfig{
+		Enabled:            enabled,
+		Interval:           interval,
+		Lookback:           lookback,
+		MinSamples:         minSamples,
+		OnTimeThreshold:    values["ADAPTIVE_WINDOW_ON_TIME_THRESHOLD"],
+		CollisionThreshold: values["ADAPTIVE_WINDOW_COLLISION_THRESHOLD"],
+		NarrowFactor:       values["ADAPTIVE_WINDOW_NARROW_FACTOR"],
+		WidenFactor:        values["ADAPTIVE_WINDOW_WIDEN_FACTOR"],
+	}, nil
+}
+
+// loadTaskTypesConfig reads the custom task types listed in TASK_TYPES. Each
+// needs TASK_TYPE_<TYPE>_ENUM and may set TASK_TYPE_<TYPE>_ESCALATION_AFTER,
+// TASK_TYPE_<TYPE>_ESCALATION_STEPS, TASK_TYPE_<TYPE>_SCHEDULE,
+// TASK_TYPE_<TYPE>_QUIET_HOURS, TASK_TYPE_<TYPE>_ACKNOWLEDGE and the
+// WINDOW_<TYPE>_* widths, which default to those of near. Names and actions
+// are validated by the domain registry.
+func loadTaskTypesConfig() ([]TaskTypeConfig, error) {
+	names := splitList(os.Getenv("TASK_TYPES"))
+
+	taskTypes := make([]TaskTypeConfig, 0, len(names))
+	for _, name := range names {
+		prefix := "TASK_TYPE_" + strings.ToUpper(name) + "_"
+
+		enumValue, err := strconv.ParseInt(os.Getenv(prefix+"ENUM"), 10, 32)
+		if err != nil || enumValue <= 0 {
+			return nil, fmt.Errorf("invalid %sENUM: %q", prefix, os.Getenv(prefix+"ENUM"))
+		}
+
+		window, err := loadWindowParamsConfig(name, "5m", "10m")
+		if err != nil {
+			return nil, err
+		}
+
+		escalationAfter, err := time.ParseDuration(getEnv(prefix+"ESCALATION_AFTER", "0s"))
+		if err != nil || escalationAfter < 0 {
+			return nil, fmt.Errorf("invalid %sESCALATION_AFTER: %q", prefix, os.Getenv(prefix+"ESCALATION_AFTER"))
+		}
+
+		var schedule []time.Duration
+
+		for _, item := range splitList(os.Getenv(prefix + "SCHEDULE")) {
+			offset, err := time.ParseDuration(item)
+			if err != nil {
+				return nil, fmt.Errorf("invalid %sSCHEDULE: %q", prefix, os.Getenv(prefix+"SCHEDULE"))
+			}
+
+			schedule = append(schedule, offset)
+		}
+
+		taskTypes = append(taskTypes, TaskTypeConfig{
+			Name:            name,
+			EnumValue:       int32(enumValue),
+			Window:          window,
+			EscalationAfter: escalationAfter,
+			EscalationSteps: splitList(os.Getenv(prefix + "ESCALATION_STEPS")),
+			Schedule:        schedule,
+			QuietHours:      getEnv(prefix+"QUIET_HOURS", "keep"),
+			Acknowledge:     getEnv(prefix+"ACKNOWLEDGE", "cancel_remaining"),
+		})
+	}
+
+	return taskTypes, nil
+}
+
+// loadJobConfig reads the <prefix>_ENABLED, <prefix>_INTERVAL and <prefix>_BATCH_SIZE
+// settings shared by the background jobs.
+func loadJobConfig(prefix string) (bool, time.Duration, int, error) {
+	enabled, err := strconv.ParseBool(getEnv(prefix+"_ENABLED", "true"))
+	if err != nil {
+		return false, 0, 0, fmt.Errorf("invalid %s_ENABLED: %w", prefix, err)
+	}
+
+	interval, err := time.ParseDuration(getEnv(prefix+"_INTERVAL", "1m"))
+	if err != nil || interval <= 0 {
+		return false, 0, 0, fmt.Errorf("invalid %s_INTERVAL: %q", prefix, os.Getenv(prefix+"_INTERVAL"))
+	}
+
+	batchSize, err := strconv.Atoi(getEnv(prefix+"_BATCH_SIZE", "100"))
+	if err != nil || batchSize <= 0 {
+		return false, 0, 0, fmt.Errorf("invalid %s_BATCH_SIZE: %q", prefix, os.Getenv(prefix+"_BATCH_SIZE"))
+	}
+
+	return enabled, interval, batchSize, nil
+}
+
+func loadNatsStreamConfig() (NatsStreamConfig, error) {
+	maxAge, err := time.ParseDuration(getEnv("NATS_STREAM_MAX_AGE", "24h"))
+	if err != nil {
+		return NatsStreamConfig{}, fmt.Errorf("invalid NATS_STREAM_MAX_AGE: %w", err)
+	}
+
+	maxBytes, err := strconv.ParseInt(getEnv("NATS_STREAM_MAX_BYTES", "104857600"), 10, 64)
+	if err != nil {
+		return NatsStreamConfig{}, fmt.Errorf("invalid NATS_STREAM_MAX_BYTES: %w", err)
+	}
+
+	replicas, err := strconv.Atoi(getEnv("NATS_STREAM_REPLICAS", "1"))
+	if err != nil {
+		return NatsStreamConfig{}, fmt.Errorf("invalid NATS_STREAM_REPLICAS: %w", err)
+	}
+
+	remindCancelledSubject := getEnv("NATS_SUBJECT_REMIND_CANCELLED", "remind.cancelled")
+	remindsPausedSubject := getEnv("NATS_SUBJECT_REMINDS_PAUSED", "remind.paused")
+	remindsResumedSubject := getEnv("NATS_SUBJECT_REMINDS_RESUMED", "remind.resumed")
+	defaultSubjects := strings.Join([]string{remindCancelledSubject, remindsPausedSubject, remindsResumedSubject}, ",")
+
+	return NatsStreamConfig{
+		Name:                   getEnv("NATS_STREAM_NAME", "REMIND_EVENTS"),
+		Subjects:               splitList(getEnv("NATS_STREAM_SUBJECTS", defaultSubjects)),
+		RemindCancelledSubject: remindCancelledSubject,
+		RemindsPausedSubject:   remindsPausedSubject,
+		RemindsResumedSubject:  remindsResumedSubject,
+		Retention:              getEnv("NATS_STREAM_RETENTION", "limits"),
+		MaxAge:                 maxAge,
+		MaxBytes:               maxBytes,
+		Storage:                getEnv("NATS_STREAM_STORAGE", "file"),
+		Replicas:               replicas,
 	}, nil
 }
 
+func splitList(value string) []string {
+	parts := strings.Split(value, ",")
+
+	items := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			items = append(items, p)
+		}
+	}
+
+	return items
+}
+
 func getEnv(key, defaultValue string) string {
 	if value := os.Getenv(key); value != "" {
 		return value
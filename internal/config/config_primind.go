@@ -1,7 +0,0 @@
-//go:build !gcloud
-
-package config
-
-func (c *PubSubConfig) Validate() error {
-	return nil
-}
@@ -0,0 +1,145 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+const (
+	PubSubBackendNATS      = "nats"
+	PubSubBackendGCloud    = "gcloud"
+	PubSubBackendGoChannel = "gochannel"
+)
+
+func (c *PubSubConfig) Validate() error {
+	if len(c.Backends) == 0 {
+		return errors.New("PUBSUB_BACKEND must list at least one backend")
+	}
+
+	seen := make(map[string]bool, len(c.Backends))
+
+	for _, backend := range c.Backends {
+		if seen[backend] {
+			return fmt.Errorf("invalid PUBSUB_BACKEND: %q is listed more than once", backend)
+		}
+
+		seen[backend] = true
+
+		if err := c.validateBackend(backend); err != nil {
+			return err
+		}
+	}
+
+	for _, backend := range c.BestEffortBackends {
+		if !seen[backend] {
+			return fmt.Errorf("invalid PUBSUB_BEST_EFFORT_BACKENDS: %q is not listed in PUBSUB_BACKEND", backend)
+		}
+	}
+
+	return nil
+}
+
+func (c *PubSubConfig) validateBackend(backend string) error {
+	switch backend {
+	case PubSubBackendNATS:
+		if c.NatsURL == "" {
+			return errors.New("NATS_URL is required for the nats publisher backend")
+		}
+
+		if err := c.NatsStream.Validate(); err != nil {
+			return err
+		}
+
+		return c.NatsAuth.Validate()
+	case PubSubBackendGCloud:
+		if c.GCloudProjectID == "" {
+			return errors.New("GCLOUD_PROJECT_ID is required for event publishing")
+		}
+
+		return nil
+	case PubSubBackendGoChannel:
+		return nil
+	default:
+		return fmt.Errorf("invalid PUBSUB_BACKEND: %q (must be nats, gcloud or gochannel)", backend)
+	}
+}
+
+// IsBestEffort reports whether publish failures on backend are only logged
+// instead of failing the publish.
+func (c *PubSubConfig) IsBestEffort(backend string) bool {
+	return slices.Contains(c.BestEffortBackends, backend)
+}
+
+// defaultPubSubBackend keeps the behavior of the former per-platform builds:
+// Google Cloud Pub/Sub on gcloud, NATS when configured, otherwise the in-memory channel.
+func defaultPubSubBackend(platform, natsURL string) string {
+	switch {
+	case platform == PlatformGCloud:
+		return PubSubBackendGCloud
+	case natsURL != "":
+		return PubSubBackendNATS
+	default:
+		return PubSubBackendGoChannel
+	}
+}
+
+func (c *NatsStreamConfig) Validate() error {
+	if c.Name == "" {
+		return errors.New("NATS_STREAM_NAME must not be empty")
+	}
+
+	if c.RemindCancelledSubject == "" {
+		return errors.New("NATS_SUBJECT_REMIND_CANCELLED must not be empty")
+	}
+
+	if c.RemindsPausedSubject == "" {
+		return errors.New("NATS_SUBJECT_REMINDS_PAUSED must not be empty")
+	}
+
+	if c.RemindsResumedSubject == "" {
+		return errors.New("NATS_SUBJECT_REMINDS_RESUMED must not be empty")
+	}
+
+	if len(c.Subjects) == 0 {
+		return errors.New("NATS_STREAM_SUBJECTS must contain at least one subject")
+	}
+
+	switch c.Retention {
+	case "limits", "interest", "workqueue":
+	default:
+		return fmt.Errorf("invalid NATS_STREAM_RETENTION: %q (must be limits, interest or workqueue)", c.Retention)
+	}
+
+	switch c.Storage {
+	case "file", "memory":
+	default:
+		return fmt.Errorf("invalid NATS_STREAM_STORAGE: %q (must be file or memory)", c.Storage)
+	}
+
+	if c.Replicas < 1 || c.Replicas > 5 {
+		return fmt.Errorf("invalid NATS_STREAM_REPLICAS: %d (must be between 1 and 5)", c.Replicas)
+	}
+
+	if c.MaxAge < 0 {
+		return errors.New("NATS_STREAM_MAX_AGE must not be negative")
+	}
+
+	if c.MaxBytes == 0 || c.MaxBytes < -1 {
+		return errors.New("NATS_STREAM_MAX_BYTES must be positive, or -1 for unlimited")
+	}
+
+	return nil
+}
+
+func (c *NatsAuthConfig) Validate() error {
+	if c.CredsFile != "" && c.NKeySeedFile != "" {
+		return errors.New("NATS_CREDS_FILE and NATS_NKEY_SEED_FILE are mutually exclusive")
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("NATS_TLS_CERT_FILE and NATS_TLS_KEY_FILE must be set together")
+	}
+
+	return nil
+}
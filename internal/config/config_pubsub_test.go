@@ -0,0 +1,216 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/config"
+)
+
+func validPubSubConfig() config.PubSubConfig {
+	return config.PubSubConfig{
+		Backends: []string{"nats"},
+		NatsURL:  "nats://localhost:4222",
+		NatsStream: config.NatsStreamConfig{
+			Name:                   "REMIND_EVENTS",
+			Subjects:               []string{"remind.>"},
+			RemindCancelledSubject: "remind.cancelled",
+			RemindsPausedSubject:   "remind.paused",
+			RemindsResumedSubject:  "remind.resumed",
+			Retention:              "limits",
+			MaxAge:                 24 * time.Hour,
+			MaxBytes:               100 * 1024 * 1024,
+			Storage:                "file",
+			Replicas:               3,
+		},
+	}
+}
+
+func TestPubSubConfigValidateSuccess(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(cfg *config.PubSubConfig)
+	}{
+		{
+			name:   "valid NATS configuration",
+			modify: func(_ *config.PubSubConfig) {},
+		},
+		{
+			name: "stream settings are ignored by the in-memory backend",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"gochannel"}
+				cfg.NatsURL = ""
+				cfg.NatsStream.Replicas = 0
+			},
+		},
+		{
+			name: "gcloud backend with project",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"gcloud"}
+				cfg.GCloudProjectID = "my-project"
+			},
+		},
+		{
+			name: "fan out to NATS and best-effort gcloud",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"nats", "gcloud"}
+				cfg.BestEffortBackends = []string{"gcloud"}
+				cfg.GCloudProjectID = "my-project"
+			},
+		},
+		{
+			name: "unlimited max bytes with creds and TLS",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.MaxBytes = -1
+				cfg.NatsAuth.CredsFile = "/etc/nats/user.creds"
+				cfg.NatsAuth.TLSCertFile = "/etc/nats/tls.crt"
+				cfg.NatsAuth.TLSKeyFile = "/etc/nats/tls.key"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validPubSubConfig()
+			tt.modify(&cfg)
+
+			assert.NoError(t, cfg.Validate())
+		})
+	}
+}
+
+func TestPubSubConfigValidateError(t *testing.T) {
+	tests := []struct {
+		name        string
+		modify      func(cfg *config.PubSubConfig)
+		expectedErr string
+	}{
+		{
+			name: "unknown backend",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"kafka"}
+			},
+			expectedErr: "PUBSUB_BACKEND",
+		},
+		{
+			name: "no backend",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = nil
+			},
+			expectedErr: "at least one backend",
+		},
+		{
+			name: "duplicate backend",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"nats", "nats"}
+			},
+			expectedErr: "more than once",
+		},
+		{
+			name: "best-effort backend not in backend list",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.BestEffortBackends = []string{"gcloud"}
+			},
+			expectedErr: "PUBSUB_BEST_EFFORT_BACKENDS",
+		},
+		{
+			name: "fan out validates every backend",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"nats", "gcloud"}
+			},
+			expectedErr: "GCLOUD_PROJECT_ID",
+		},
+		{
+			name: "nats backend without URL",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsURL = ""
+			},
+			expectedErr: "NATS_URL",
+		},
+		{
+			name: "gcloud backend without project",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.Backends = []string{"gcloud"}
+			},
+			expectedErr: "GCLOUD_PROJECT_ID",
+		},
+		{
+			name: "empty stream name",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.Name = ""
+			},
+			expectedErr: "NATS_STREAM_NAME",
+		},
+		{
+			name: "empty paused subject",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.RemindsPausedSubject = ""
+			},
+			expectedErr: "NATS_SUBJECT_REMINDS_PAUSED",
+		},
+		{
+			name: "no stream subjects",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.Subjects = nil
+			},
+			expectedErr: "NATS_STREAM_SUBJECTS",
+		},
+		{
+			name: "unknown retention",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.Retention = "forever"
+			},
+			expectedErr: "NATS_STREAM_RETENTION",
+		},
+		{
+			name: "unknown storage",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.Storage = "tape"
+			},
+			expectedErr: "NATS_STREAM_STORAGE",
+		},
+		{
+			name: "too many replicas",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.Replicas = 7
+			},
+			expectedErr: "NATS_STREAM_REPLICAS",
+		},
+		{
+			name: "zero max bytes",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsStream.MaxBytes = 0
+			},
+			expectedErr: "NATS_STREAM_MAX_BYTES",
+		},
+		{
+			name: "creds and nkey together",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsAuth.CredsFile = "/etc/nats/user.creds"
+				cfg.NatsAuth.NKeySeedFile = "/etc/nats/user.nk"
+			},
+			expectedErr: "mutually exclusive",
+		},
+		{
+			name: "TLS cert without key",
+			modify: func(cfg *config.PubSubConfig) {
+				cfg.NatsAuth.TLSCertFile = "/etc/nats/tls.crt"
+			},
+			expectedErr: "NATS_TLS_KEY_FILE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := validPubSubConfig()
+			tt.modify(&cfg)
+
+			err := cfg.Validate()
+
+			assert.Error(t, err)
+			assert.Contains(t, err.Error(), tt.expectedErr)
+		})
+	}
+}
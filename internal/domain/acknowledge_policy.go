@@ -0,0 +1,44 @@
+package domain
+
+// AcknowledgeAction is what happens to the rest of a task's reminds once one
+// of them is acknowledged.
+type AcknowledgeAction string
+
+const (
+	// AcknowledgeCancelRemaining deletes the task's other upcoming reminds.
+	AcknowledgeCancelRemaining AcknowledgeAction = "cancel_remaining"
+	// AcknowledgeKeepRemaining leaves the task's other upcoming reminds in place.
+	AcknowledgeKeepRemaining AcknowledgeAction = "keep_remaining"
+)
+
+type AcknowledgePolicy struct {
+	actions map[Type]AcknowledgeAction
+}
+
+// NewAcknowledgePolicy returns the registered actions of the task types:
+//   - short/near/relaxed: cancel the remaining reminds (the user has acted)
+//   - scheduled: keep them (reacting early does not mean the appointment is done)
+func NewAcknowledgePolicy() *AcknowledgePolicy {
+	defs := taskTypes.Definitions()
+
+	actions := make(map[Type]AcknowledgeAction, len(defs))
+	for _, def := range defs {
+		actions[def.Type] = def.Acknowledge
+	}
+
+	return &AcknowledgePolicy{
+		actions: actions,
+	}
+}
+
+func (p *AcknowledgePolicy) Action(taskType Type) AcknowledgeAction {
+	if action, ok := p.actions[taskType]; ok {
+		return action
+	}
+
+	return AcknowledgeCancelRemaining
+}
+
+func (p *AcknowledgePolicy) CancelsRemaining(taskType Type) bool {
+	return p.Action(taskType) == AcknowledgeCancelRemaining
+}
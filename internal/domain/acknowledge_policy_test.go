@@ -0,0 +1,52 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestAcknowledgePolicyActionSuccess(t *testing.T) {
+	tests := []struct {
+		name             string
+		taskType         domain.Type
+		expectedAction   domain.AcknowledgeAction
+		cancelsRemaining bool
+	}{
+		{
+			name:             "short cancels remaining",
+			taskType:         domain.TypeShort,
+			expectedAction:   domain.AcknowledgeCancelRemaining,
+			cancelsRemaining: true,
+		},
+		{
+			name:             "near cancels remaining",
+			taskType:         domain.TypeNear,
+			expectedAction:   domain.AcknowledgeCancelRemaining,
+			cancelsRemaining: true,
+		},
+		{
+			name:             "relaxed cancels remaining",
+			taskType:         domain.TypeRelaxed,
+			expectedAction:   domain.AcknowledgeCancelRemaining,
+			cancelsRemaining: true,
+		},
+		{
+			name:             "scheduled keeps remaining",
+			taskType:         domain.TypeScheduled,
+			expectedAction:   domain.AcknowledgeKeepRemaining,
+			cancelsRemaining: false,
+		},
+	}
+
+	policy := domain.NewAcknowledgePolicy()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedAction, policy.Action(tt.taskType))
+			assert.Equal(t, tt.cancelsRemaining, policy.CancelsRemaining(tt.taskType))
+		})
+	}
+}
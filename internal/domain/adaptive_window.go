@@ -0,0 +1,214 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidAdaptiveWindowPolicy = errors.New("invalid adaptive window policy")
+	ErrWindowStatsNotFound         = errors.New("window stats not found")
+)
+
+// WindowStats summarizes how one user's reminds of a task type were
+// delivered: how many were acknowledged within their slide window and how
+// many collided with the window of another of the user's reminds.
+type WindowStats struct {
+	userID     UserID
+	taskType   Type
+	samples    int
+	onTime     int
+	collided   int
+	computedAt time.Time
+}
+
+func ReconstituteWindowStats(
+	userID UserID,
+	taskType Type,
+	samples int,
+	onTime int,
+	collided int,
+	computedAt time.Time,
+) *WindowStats {
+	return &WindowStats{
+		userID:     userID,
+		taskType:   taskType,
+		samples:    samples,
+		onTime:     onTime,
+		collided:   collided,
+		computedAt: computedAt,
+	}
+}
+
+func (s *WindowStats) UserID() UserID {
+	return s.userID
+}
+
+func (s *WindowStats) TaskType() Type {
+	return s.taskType
+}
+
+// Samples is the number of reminds whose window closed within the lookback.
+func (s *WindowStats) Samples() int {
+	return s.samples
+}
+
+// OnTime is the number of reminds acknowledged before their window closed.
+func (s *WindowStats) OnTime() int {
+	return s.onTime
+}
+
+// Collided is the number of reminds whose window overlapped the window of
+// another of the user's reminds, which the throttle has to spread apart.
+func (s *WindowStats) Collided() int {
+	return s.collided
+}
+
+func (s *WindowStats) ComputedAt() time.Time {
+	return s.computedAt
+}
+
+func (s *WindowStats) OnTimeRate() float64 {
+	if s.samples == 0 {
+		return 0
+	}
+
+	return float64(s.onTime) / float64(s.samples)
+}
+
+func (s *WindowStats) CollisionRate() float64 {
+	if s.samples == 0 {
+		return 0
+	}
+
+	return float64(s.collided) / float64(s.samples)
+}
+
+type WindowStatsRepository interface {
+	// Refresh recomputes the statistics of every user and task type from the
+	// reminds scheduled since since whose windows closed before until, and
+	// removes the statistics of pairs without such reminds. It returns the
+	// number kept.
+	Refresh(ctx context.Context, since, until time.Time) (int, error)
+	// Find returns ErrWindowStatsNotFound when the pair has no statistics.
+	Find(ctx context.Context, userID UserID, taskType Type) (*WindowStats, error)
+}
+
+// WindowAdaptation is the factor applied to a task's widths and why.
+type WindowAdaptation struct {
+	Factor      float64
+	Explanation string
+}
+
+// Apply scales the widths by the factor, never below MinSlideWindowWidth.
+// Widened widths stay within maxWidth, the widest window the task type gets,
+// and never end up narrower than before.
+func (a WindowAdaptation) Apply(
+	widths map[time.Time]SlideWindowWidth,
+	maxWidth SlideWindowWidth,
+) map[time.Time]SlideWindowWidth {
+	if a.Factor == 0 || a.Factor == 1 {
+		return widths
+	}
+
+	adapted := make(map[time.Time]SlideWindowWidth, len(widths))
+	for t, width := range widths {
+		scaled := time.Duration(float64(width.Duration()) * a.Factor).Round(time.Second)
+		if a.Factor > 1 {
+			scaled = max(min(scaled, maxWidth.Duration()), width.Duration())
+		}
+
+		adapted[t] = MustSlideWindowWidth(max(scaled, MinSlideWindowWidth))
+	}
+
+	return adapted
+}
+
+// AdaptiveWindowPolicy narrows the windows of users who acknowledge on time
+// and widens those of users whose reminds keep colliding.
+type AdaptiveWindowPolicy struct {
+	minSamples         int
+	onTimeThreshold    float64
+	collisionThreshold float64
+	narrowFactor       float64
+	widenFactor        float64
+}
+
+// NewAdaptiveWindowPolicy returns a policy that needs minSamples reminds of
+// history. At least collisionThreshold colliding reminds widen windows by
+// widenFactor; otherwise at least onTimeThreshold on-time acknowledgments
+// narrow them by narrowFactor.
+func NewAdaptiveWindowPolicy(
+	minSamples int,
+	onTimeThreshold float64,
+	collisionThreshold float64,
+	narrowFactor float64,
+	widenFactor float64,
+) (*AdaptiveWindowPolicy, error) {
+	if minSamples <= 0 {
+		return nil, fmt.Errorf("%w: min samples must be positive, got %d", ErrInvalidAdaptiveWindowPolicy, minSamples)
+	}
+
+	for name, rate := range map[string]float64{"on-time": onTimeThreshold, "collision": collisionThreshold} {
+		if rate <= 0 || rate > 1 {
+			return nil, fmt.Errorf("%w: %s threshold must be within (0, 1], got %v",
+				ErrInvalidAdaptiveWindowPolicy, name, rate)
+		}
+	}
+
+	if narrowFactor <= 0 || narrowFactor > 1 {
+		return nil, fmt.Errorf("%w: narrow factor must be within (0, 1], got %v", ErrInvalidAdaptiveWindowPolicy, narrowFactor)
+	}
+
+	if widenFactor < 1 {
+		return nil, fmt.Errorf("%w: widen factor must be at least 1, got %v", ErrInvalidAdaptiveWindowPolicy, widenFactor)
+	}
+
+	return &AdaptiveWindowPolicy{
+		minSamples:         minSamples,
+		onTimeThreshold:    onTimeThreshold,
+		collisionThreshold: collisionThreshold,
+		narrowFactor:       narrowFactor,
+		widenFactor:        widenFactor,
+	}, nil
+}
+
+// Adapt picks the factor for stats, which may be nil when there is no
+// history. Collisions win over on-time acknowledgment because a crowded
+// window is not fixed by narrowing it.
+func (p *AdaptiveWindowPolicy) Adapt(stats *WindowStats) WindowAdaptation {
+	if stats == nil {
+		return WindowAdaptation{Factor: 1, Explanation: "no delivery history"}
+	}
+
+	if stats.Samples() < p.minSamples {
+		return WindowAdaptation{
+			Factor:      1,
+			Explanation: fmt.Sprintf("not enough delivery history: %d of %d reminds", stats.Samples(), p.minSamples),
+		}
+	}
+
+	if rate := stats.CollisionRate(); rate >= p.collisionThreshold {
+		return WindowAdaptation{
+			Factor: p.widenFactor,
+			Explanation: fmt.Sprintf("widened x%g: %.0f%% of %d reminds collided with another remind",
+				p.widenFactor, rate*100, stats.Samples()),
+		}
+	}
+
+	if rate := stats.OnTimeRate(); rate >= p.onTimeThreshold {
+		return WindowAdaptation{
+			Factor: p.narrowFactor,
+			Explanation: fmt.Sprintf("narrowed x%g: %.0f%% of %d reminds were acknowledged on time",
+				p.narrowFactor, rate*100, stats.Samples()),
+		}
+	}
+
+	return WindowAdaptation{
+		Factor: 1,
+		Explanation: fmt.Sprintf("unchanged: %.0f%% acknowledged on time and %.0f%% colliding of %d reminds",
+			stats.OnTimeRate()*100, stats.CollisionRate()*100, stats.Samples()),
+	}
+}
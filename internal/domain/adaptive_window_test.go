@@ -0,0 +1,131 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func mustAdaptiveWindowPolicy(t *testing.T) *domain.AdaptiveWindowPolicy {
+	t.Helper()
+
+	policy, err := domain.NewAdaptiveWindowPolicy(20, 0.9, 0.2, 0.8, 1.5)
+	require.NoError(t, err)
+
+	return policy
+}
+
+func windowStats(samples, onTime, collided int) *domain.WindowStats {
+	return domain.ReconstituteWindowStats(
+		domain.UserID{},
+		domain.TypeNear,
+		samples,
+		onTime,
+		collided,
+		time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC),
+	)
+}
+
+func TestNewAdaptiveWindowPolicyError(t *testing.T) {
+	tests := []struct {
+		name               string
+		minSamples         int
+		onTimeThreshold    float64
+		collisionThreshold float64
+		narrowFactor       float64
+		widenFactor        float64
+	}{
+		{name: "zero samples", minSamples: 0, onTimeThreshold: 0.9, collisionThreshold: 0.2, narrowFactor: 0.8, widenFactor: 1.5},
+		{name: "on-time above one", minSamples: 20, onTimeThreshold: 1.1, collisionThreshold: 0.2, narrowFactor: 0.8, widenFactor: 1.5},
+		{name: "zero collision", minSamples: 20, onTimeThreshold: 0.9, collisionThreshold: 0, narrowFactor: 0.8, widenFactor: 1.5},
+		{name: "narrow above one", minSamples: 20, onTimeThreshold: 0.9, collisionThreshold: 0.2, narrowFactor: 1.2, widenFactor: 1.5},
+		{name: "widen below one", minSamples: 20, onTimeThreshold: 0.9, collisionThreshold: 0.2, narrowFactor: 0.8, widenFactor: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewAdaptiveWindowPolicy(
+				tt.minSamples, tt.onTimeThreshold, tt.collisionThreshold, tt.narrowFactor, tt.widenFactor,
+			)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidAdaptiveWindowPolicy)
+		})
+	}
+}
+
+func TestAdaptiveWindowPolicyAdaptSuccess(t *testing.T) {
+	tests := []struct {
+		name                string
+		stats               *domain.WindowStats
+		expectedFactor      float64
+		expectedExplanation string
+	}{
+		{
+			name:                "no history",
+			stats:               nil,
+			expectedFactor:      1,
+			expectedExplanation: "no delivery history",
+		},
+		{
+			name:                "too few samples",
+			stats:               windowStats(5, 5, 0),
+			expectedFactor:      1,
+			expectedExplanation: "not enough delivery history: 5 of 20 reminds",
+		},
+		{
+			name:                "on time narrows",
+			stats:               windowStats(40, 38, 0),
+			expectedFactor:      0.8,
+			expectedExplanation: "narrowed x0.8: 95% of 40 reminds were acknowledged on time",
+		},
+		{
+			name:                "collisions widen even when on time",
+			stats:               windowStats(40, 38, 10),
+			expectedFactor:      1.5,
+			expectedExplanation: "widened x1.5: 25% of 40 reminds collided with another remind",
+		},
+		{
+			name:                "in between is unchanged",
+			stats:               windowStats(40, 24, 2),
+			expectedFactor:      1,
+			expectedExplanation: "unchanged: 60% acknowledged on time and 5% colliding of 40 reminds",
+		},
+	}
+
+	policy := mustAdaptiveWindowPolicy(t)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			adaptation := policy.Adapt(tt.stats)
+
+			assert.InDelta(t, tt.expectedFactor, adaptation.Factor, 1e-9)
+			assert.Equal(t, tt.expectedExplanation, adaptation.Explanation)
+		})
+	}
+}
+
+func TestWindowAdaptationApplySuccess(t *testing.T) {
+	at := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	maxWidth := domain.MustSlideWindowWidth(10 * time.Minute)
+	widths := map[time.Time]domain.SlideWindowWidth{
+		at:                    domain.MustSlideWindowWidth(5 * time.Minute),
+		at.Add(time.Hour):     domain.MustSlideWindowWidth(domain.MinSlideWindowWidth),
+		at.Add(2 * time.Hour): domain.MustSlideWindowWidth(8 * time.Minute),
+		at.Add(3 * time.Hour): domain.MustSlideWindowWidth(12 * time.Minute),
+	}
+
+	narrowed := domain.WindowAdaptation{Factor: 0.8}.Apply(widths, maxWidth)
+	assert.Equal(t, 4*time.Minute, narrowed[at].Duration())
+	assert.Equal(t, domain.MinSlideWindowWidth, narrowed[at.Add(time.Hour)].Duration())
+
+	widened := domain.WindowAdaptation{Factor: 1.5}.Apply(widths, maxWidth)
+	assert.Equal(t, 7*time.Minute+30*time.Second, widened[at].Duration())
+	assert.Equal(t, 10*time.Minute, widened[at.Add(2*time.Hour)].Duration(), "capped at the task type's max width")
+	assert.Equal(t, 12*time.Minute, widened[at.Add(3*time.Hour)].Duration(), "never narrowed by the cap")
+
+	assert.Equal(t, widths, domain.WindowAdaptation{Factor: 1}.Apply(widths, maxWidth))
+}
@@ -0,0 +1,105 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// PastRemindTimeTolerance is how far in the past a remind time may be before
+// it counts as past, absorbing small client clock skew.
+const PastRemindTimeTolerance = time.Minute
+
+// IsPastRemindTime reports whether t is too far behind now to be scheduled.
+func IsPastRemindTime(t, now time.Time) bool {
+	return t.Before(now.Add(-PastRemindTimeTolerance))
+}
+
+// CatchUpPolicy decides what happens to requested remind times that are
+// already past, e.g. because an offline client synced late.
+type CatchUpPolicy string
+
+const (
+	// CatchUpReject fails the whole request with ErrPastRemindTime.
+	CatchUpReject CatchUpPolicy = "reject"
+	// CatchUpDrop discards the past times and keeps the rest.
+	CatchUpDrop CatchUpPolicy = "drop"
+	// CatchUpClamp moves the past times to now; they fire with a minimal
+	// window, or are dropped when a kept time fires within the minimum spacing.
+	CatchUpClamp CatchUpPolicy = "clamp"
+)
+
+// NewCatchUpPolicy parses a policy name; an empty name selects CatchUpReject.
+func NewCatchUpPolicy(s string) (CatchUpPolicy, error) {
+	switch policy := CatchUpPolicy(s); policy {
+	case "":
+		return CatchUpReject, nil
+	case CatchUpReject, CatchUpDrop, CatchUpClamp:
+		return policy, nil
+	default:
+		return "", ErrInvalidCatchUpPolicy
+	}
+}
+
+// CatchUpResult is what a catch-up policy did to one requested time.
+type CatchUpResult string
+
+const (
+	CatchUpKept    CatchUpResult = "kept"
+	CatchUpDropped CatchUpResult = "dropped"
+	CatchUpClamped CatchUpResult = "clamped"
+)
+
+// CatchUpOutcome records the result for one requested time. Time is zero
+// for dropped times.
+type CatchUpOutcome struct {
+	Requested time.Time
+	Result    CatchUpResult
+	Time      time.Time
+}
+
+// Apply returns the remind times to schedule, sorted and without duplicates
+// (several clamped times land on now), together with one outcome per
+// requested time. CatchUpReject keeps past times so that NewRemind rejects
+// them. Clamped times are dropped instead when a kept time is less than
+// minSpacing from now, as that remind fires right away anyway.
+func (p CatchUpPolicy) Apply(times []time.Time, now time.Time, minSpacing time.Duration) ([]time.Time, []CatchUpOutcome) {
+	adjusted := make([]time.Time, 0, len(times))
+	outcomes := make([]CatchUpOutcome, 0, len(times))
+
+	var kept []time.Time
+
+	for _, t := range times {
+		if p == CatchUpReject || !IsPastRemindTime(t, now) {
+			kept = append(kept, t)
+		}
+	}
+
+	clampable := p == CatchUpClamp && !tooClose(now, kept, minSpacing)
+
+	for _, t := range times {
+		if p == CatchUpReject || !IsPastRemindTime(t, now) {
+			adjusted = append(adjusted, t)
+			outcomes = append(outcomes, CatchUpOutcome{Requested: t, Result: CatchUpKept, Time: t})
+
+			continue
+		}
+
+		if !clampable {
+			outcomes = append(outcomes, CatchUpOutcome{Requested: t, Result: CatchUpDropped})
+
+			continue
+		}
+
+		clamped := now.In(t.Location())
+		adjusted = append(adjusted, clamped)
+		outcomes = append(outcomes, CatchUpOutcome{Requested: t, Result: CatchUpClamped, Time: clamped})
+	}
+
+	slices.SortFunc(adjusted, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	return slices.CompactFunc(adjusted, func(a, b time.Time) bool {
+		return a.Equal(b)
+	}), outcomes
+}
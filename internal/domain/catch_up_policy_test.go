@@ -0,0 +1,126 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewCatchUpPolicySuccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected domain.CatchUpPolicy
+	}{
+		{name: "empty defaults to reject", input: "", expected: domain.CatchUpReject},
+		{name: "reject", input: "reject", expected: domain.CatchUpReject},
+		{name: "drop", input: "drop", expected: domain.CatchUpDrop},
+		{name: "clamp", input: "clamp", expected: domain.CatchUpClamp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := domain.NewCatchUpPolicy(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+		})
+	}
+}
+
+func TestNewCatchUpPolicyError(t *testing.T) {
+	_, err := domain.NewCatchUpPolicy("ignore")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidCatchUpPolicy)
+}
+
+func TestCatchUpPolicyApplySuccess(t *testing.T) {
+	now := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	stale := now.Add(-10 * time.Minute)
+	staler := now.Add(-20 * time.Minute)
+	skewed := now.Add(-30 * time.Second)
+	soon := now.Add(30 * time.Second)
+	future := now.Add(time.Hour)
+
+	tests := []struct {
+		name             string
+		policy           domain.CatchUpPolicy
+		times            []time.Time
+		expectedTimes    []time.Time
+		expectedOutcomes []domain.CatchUpOutcome
+	}{
+		{
+			name:          "reject keeps past times",
+			policy:        domain.CatchUpReject,
+			times:         []time.Time{stale, future},
+			expectedTimes: []time.Time{stale, future},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: stale, Result: domain.CatchUpKept, Time: stale},
+				{Requested: future, Result: domain.CatchUpKept, Time: future},
+			},
+		},
+		{
+			name:          "drop discards past times",
+			policy:        domain.CatchUpDrop,
+			times:         []time.Time{stale, future},
+			expectedTimes: []time.Time{future},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: stale, Result: domain.CatchUpDropped},
+				{Requested: future, Result: domain.CatchUpKept, Time: future},
+			},
+		},
+		{
+			name:          "clamp merges past times at now",
+			policy:        domain.CatchUpClamp,
+			times:         []time.Time{staler, stale, future},
+			expectedTimes: []time.Time{now, future},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: staler, Result: domain.CatchUpClamped, Time: now},
+				{Requested: stale, Result: domain.CatchUpClamped, Time: now},
+				{Requested: future, Result: domain.CatchUpKept, Time: future},
+			},
+		},
+		{
+			name:          "clamp drops past times when a kept time fires within the spacing",
+			policy:        domain.CatchUpClamp,
+			times:         []time.Time{stale, soon, future},
+			expectedTimes: []time.Time{soon, future},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: stale, Result: domain.CatchUpDropped},
+				{Requested: soon, Result: domain.CatchUpKept, Time: soon},
+				{Requested: future, Result: domain.CatchUpKept, Time: future},
+			},
+		},
+		{
+			name:          "skew within tolerance is kept",
+			policy:        domain.CatchUpDrop,
+			times:         []time.Time{skewed},
+			expectedTimes: []time.Time{skewed},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: skewed, Result: domain.CatchUpKept, Time: skewed},
+			},
+		},
+		{
+			name:          "every time dropped",
+			policy:        domain.CatchUpDrop,
+			times:         []time.Time{stale},
+			expectedTimes: []time.Time{},
+			expectedOutcomes: []domain.CatchUpOutcome{
+				{Requested: stale, Result: domain.CatchUpDropped},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, outcomes := tt.policy.Apply(tt.times, now, time.Minute)
+
+			assert.Equal(t, tt.expectedTimes, times)
+			assert.Equal(t, tt.expectedOutcomes, outcomes)
+		})
+	}
+}
@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var ErrInvalidDensityPolicy = errors.New("invalid density policy")
+
+// DensityBucket is the granularity at which schedule density is measured.
+const DensityBucket = time.Minute
+
+// BucketCounter reports how many reminds already fall in each of the given
+// DensityBucket starts, keyed by bucket start. Buckets without reminds may be
+// omitted.
+type BucketCounter func(buckets []time.Time) (map[time.Time]int, error)
+
+// DensityPolicy widens the windows of reminds landing in hot buckets, where
+// many reminds of all users cluster, so the throttle service can spread sends.
+type DensityPolicy struct {
+	hotThreshold int
+	maxFactor    float64
+	taskTypes    map[Type]bool
+}
+
+// NewDensityPolicy returns a policy treating buckets with at least
+// hotThreshold reminds as hot, widening windows by at most maxFactor, and
+// applying only to the given task types.
+func NewDensityPolicy(hotThreshold int, maxFactor float64, taskTypes []Type) (*DensityPolicy, error) {
+	if hotThreshold <= 0 {
+		return nil, fmt.Errorf("%w: hot threshold must be positive, got %d", ErrInvalidDensityPolicy, hotThreshold)
+	}
+
+	if maxFactor < 1 {
+		return nil, fmt.Errorf("%w: max factor must be at least 1, got %v", ErrInvalidDensityPolicy, maxFactor)
+	}
+
+	enabled := make(map[Type]bool, len(taskTypes))
+	for _, t := range taskTypes {
+		enabled[t] = true
+	}
+
+	return &DensityPolicy{
+		hotThreshold: hotThreshold,
+		maxFactor:    maxFactor,
+		taskTypes:    enabled,
+	}, nil
+}
+
+func (p *DensityPolicy) Applies(taskType Type) bool {
+	return p.taskTypes[taskType]
+}
+
+// Factor is how much a window landing in a bucket of count reminds is
+// widened: 1 below the hot threshold, then 1 + count/hotThreshold, capped at
+// the max factor.
+func (p *DensityPolicy) Factor(count int) float64 {
+	if count < p.hotThreshold {
+		return 1
+	}
+
+	return min(1+float64(count)/float64(p.hotThreshold), p.maxFactor)
+}
+
+// Widen returns widths with the windows of reminds in hot buckets widened,
+// never beyond maxWidth and never narrower than before.
+func (p *DensityPolicy) Widen(
+	widths map[time.Time]SlideWindowWidth,
+	taskType Type,
+	maxWidth SlideWindowWidth,
+	counter BucketCounter,
+) (map[time.Time]SlideWindowWidth, error) {
+	if !p.Applies(taskType) || len(widths) == 0 {
+		return widths, nil
+	}
+
+	// Only the buckets the reminds land in are counted, however far apart
+	// the reminds are.
+	buckets := make([]time.Time, 0, len(widths))
+	for t := range widths {
+		buckets = append(buckets, t.Truncate(DensityBucket))
+	}
+
+	slices.SortFunc(buckets, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	counts, err := counter(slices.CompactFunc(buckets, time.Time.Equal))
+	if err != nil {
+		return nil, err
+	}
+
+	// Counted buckets may come back in another location; match on the instant.
+	countsByUnix := make(map[int64]int, len(counts))
+	for bucket, n := range counts {
+		countsByUnix[bucket.Unix()] += n
+	}
+
+	widened := make(map[time.Time]SlideWindowWidth, len(widths))
+	for t, width := range widths {
+		widened[t] = width
+
+		factor := p.Factor(countsByUnix[t.Truncate(DensityBucket).Unix()])
+		if factor <= 1 {
+			continue
+		}
+
+		d := min(time.Duration(float64(width.Duration())*factor), maxWidth.Duration())
+		if d > width.Duration() {
+			widened[t] = MustSlideWindowWidth(d)
+		}
+	}
+
+	return widened, nil
+}
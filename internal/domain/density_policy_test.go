@@ -0,0 +1,116 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func mustDensityPolicy(t *testing.T, hotThreshold int, maxFactor float64, taskTypes ...domain.Type) *domain.DensityPolicy {
+	t.Helper()
+
+	policy, err := domain.NewDensityPolicy(hotThreshold, maxFactor, taskTypes)
+	require.NoError(t, err)
+
+	return policy
+}
+
+func TestNewDensityPolicyError(t *testing.T) {
+	tests := []struct {
+		name         string
+		hotThreshold int
+		maxFactor    float64
+	}{
+		{name: "zero threshold", hotThreshold: 0, maxFactor: 2},
+		{name: "factor below one", hotThreshold: 10, maxFactor: 0.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewDensityPolicy(tt.hotThreshold, tt.maxFactor, nil)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidDensityPolicy)
+		})
+	}
+}
+
+func TestDensityPolicyFactorSuccess(t *testing.T) {
+	policy := mustDensityPolicy(t, 10, 2.5)
+
+	assert.InDelta(t, 1.0, policy.Factor(0), 1e-9)
+	assert.InDelta(t, 1.0, policy.Factor(9), 1e-9)
+	assert.InDelta(t, 2.0, policy.Factor(10), 1e-9)
+	assert.InDelta(t, 2.5, policy.Factor(100), 1e-9)
+}
+
+func TestDensityPolicyWidenSuccess(t *testing.T) {
+	hot := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	quiet := hot.Add(-30 * time.Minute)
+
+	widths := map[time.Time]domain.SlideWindowWidth{
+		quiet: domain.MustSlideWindowWidth(5 * time.Minute),
+		hot:   domain.MustSlideWindowWidth(2 * time.Minute),
+	}
+
+	var counted []time.Time
+
+	counter := func(buckets []time.Time) (map[time.Time]int, error) {
+		counted = buckets
+
+		// Buckets may come back in another location.
+		return map[time.Time]int{hot.In(time.FixedZone("JST", 9*60*60)): 40}, nil
+	}
+
+	policy := mustDensityPolicy(t, 20, 4, domain.TypeNear)
+
+	widened, err := policy.Widen(widths, domain.TypeNear, domain.MustSlideWindowWidth(10*time.Minute), counter)
+	require.NoError(t, err)
+
+	assert.Equal(t, []time.Time{quiet, hot}, counted, "only the buckets of the reminds are counted")
+	assert.Equal(t, 5*time.Minute, widened[quiet].Duration())
+	assert.Equal(t, 6*time.Minute, widened[hot].Duration()) // factor 3
+
+	// The type's max width caps the widening.
+	widened, err = policy.Widen(widths, domain.TypeNear, domain.MustSlideWindowWidth(4*time.Minute), counter)
+	require.NoError(t, err)
+	assert.Equal(t, 4*time.Minute, widened[hot].Duration())
+	assert.Equal(t, 5*time.Minute, widened[quiet].Duration(), "widths are never narrowed")
+}
+
+func TestDensityPolicyWidenSkipSuccess(t *testing.T) {
+	widths := map[time.Time]domain.SlideWindowWidth{
+		time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC): domain.MustSlideWindowWidth(2 * time.Minute),
+	}
+
+	policy := mustDensityPolicy(t, 1, 4, domain.TypeNear)
+
+	widened, err := policy.Widen(widths, domain.TypeScheduled, domain.MustSlideWindowWidth(10*time.Minute),
+		func([]time.Time) (map[time.Time]int, error) {
+			t.Fatal("counter must not be called for task types without density widening")
+
+			return nil, nil //nolint:nilnil
+		})
+
+	require.NoError(t, err)
+	assert.Equal(t, widths, widened)
+}
+
+func TestDensityPolicyWidenError(t *testing.T) {
+	errCount := errors.New("count failed")
+	widths := map[time.Time]domain.SlideWindowWidth{
+		time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC): domain.MustSlideWindowWidth(2 * time.Minute),
+	}
+
+	_, err := mustDensityPolicy(t, 1, 4, domain.TypeNear).Widen(
+		widths, domain.TypeNear, domain.MustSlideWindowWidth(10*time.Minute),
+		func([]time.Time) (map[time.Time]int, error) {
+			return nil, errCount
+		})
+
+	assert.ErrorIs(t, err, errCount)
+}
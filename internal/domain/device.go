@@ -1,6 +1,9 @@
 package domain
 
-import "errors"
+import (
+	"errors"
+	"slices"
+)
 
 type Device struct {
 	deviceID string
@@ -56,3 +59,17 @@ func (d Devices) ToSlice() []Device {
 func (d Devices) Count() int {
 	return len(d)
 }
+
+// Merge returns d followed by the devices of other not already in d.
+func (d Devices) Merge(other Devices) Devices {
+	merged := make(Devices, 0, len(d)+len(other))
+	merged = append(merged, d...)
+
+	for _, o := range other {
+		if !slices.ContainsFunc(merged, o.Equals) {
+			merged = append(merged, o)
+		}
+	}
+
+	return merged
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
 )
@@ -302,3 +303,14 @@ func TestDevicesCountSuccess(t *testing.T) {
 		})
 	}
 }
+
+func TestDevicesMergeSuccess(t *testing.T) {
+	a, err := domain.NewDevice("device-a", "token-a")
+	require.NoError(t, err)
+	b, err := domain.NewDevice("device-b", "token-b")
+	require.NoError(t, err)
+
+	merged := domain.Devices{a}.Merge(domain.Devices{a, b})
+
+	assert.Equal(t, domain.Devices{a, b}, merged)
+}
@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Digest is a group of one user's reminds that can be delivered as a single
+// notification: the slide windows (time ± width) of all members overlap.
+type Digest struct {
+	userID             UserID
+	representativeTime time.Time
+	window             TimeRange
+	remindIDs          []RemindID
+}
+
+func (d Digest) UserID() UserID {
+	return d.userID
+}
+
+// RepresentativeTime is the middle of the shared window, so a single remind
+// digest keeps the remind's own time.
+func (d Digest) RepresentativeTime() time.Time {
+	return d.representativeTime
+}
+
+// Window is the intersection of the members' slide windows; firing anywhere
+// inside it stays within every member's window.
+func (d Digest) Window() TimeRange {
+	return d.window
+}
+
+func (d Digest) RemindIDs() []RemindID {
+	return d.remindIDs
+}
+
+func (d Digest) Size() int {
+	return len(d.remindIDs)
+}
+
+// BuildDigests groups the reminds per user into digests, ordered by
+// representative time. Reminds are taken in order of window start and join
+// the current digest while their window overlaps the digest's shared window.
+// Acknowledged, paused and throttled reminds are not delivered and are left
+// out.
+func BuildDigests(reminds []*Remind) []Digest {
+	byUser := make(map[UserID][]*Remind)
+	for _, r := range reminds {
+		if r.IsAcknowledged() || r.IsPaused() || r.IsThrottled() {
+			continue
+		}
+
+		byUser[r.UserID()] = append(byUser[r.UserID()], r)
+	}
+
+	digests := make([]Digest, 0, len(reminds))
+
+	for userID, userReminds := range byUser {
+		slices.SortFunc(userReminds, func(a, b *Remind) int {
+			return cmp.Or(
+				a.SlideWindow().Start.Compare(b.SlideWindow().Start),
+				a.Time().Compare(b.Time()),
+			)
+		})
+
+		var current *Digest
+
+		for _, r := range userReminds {
+			window := r.SlideWindow()
+
+			if current != nil && window.Start.Before(current.window.End) {
+				current.window = TimeRange{
+					Start: latest(current.window.Start, window.Start),
+					End:   earliest(current.window.End, window.End),
+				}
+				current.remindIDs = append(current.remindIDs, r.ID())
+
+				continue
+			}
+
+			if current != nil {
+				digests = append(digests, current.withRepresentativeTime())
+			}
+
+			current = &Digest{
+				userID:             userID,
+				representativeTime: time.Time{},
+				window:             window,
+				remindIDs:          []RemindID{r.ID()},
+			}
+		}
+
+		if current != nil {
+			digests = append(digests, current.withRepresentativeTime())
+		}
+	}
+
+	slices.SortFunc(digests, func(a, b Digest) int {
+		return cmp.Or(
+			a.representativeTime.Compare(b.representativeTime),
+			cmp.Compare(a.userID.String(), b.userID.String()),
+		)
+	})
+
+	return digests
+}
+
+func (d Digest) withRepresentativeTime() Digest {
+	d.representativeTime = d.window.Start.Add(d.window.End.Sub(d.window.Start) / 2)
+
+	return d
+}
+
+func latest(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+
+	return b
+}
+
+func earliest(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+
+	return b
+}
@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func newDigestRemind(t *testing.T, userID domain.UserID, remindTime time.Time, width time.Duration) *domain.Remind {
+	t.Helper()
+
+	return domain.Reconstitute(
+		domain.NewRemindID(),
+		remindTime,
+		userID,
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		false,
+		domain.MustSlideWindowWidth(width),
+		nil,
+		domain.EscalationPolicy{},
+		0,
+		false,
+		domain.Payload{},
+		remindTime,
+		remindTime,
+	)
+}
+
+func TestBuildDigestsSuccess(t *testing.T) {
+	base := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	user := createValidUserID(t)
+	other := createValidUserID(t)
+
+	first := newDigestRemind(t, user, base, 2*time.Minute)
+	second := newDigestRemind(t, user, base.Add(3*time.Minute), 2*time.Minute)
+	// Overlaps second's window but not the shared window of first and second.
+	third := newDigestRemind(t, user, base.Add(6*time.Minute), 2*time.Minute)
+	otherUsers := newDigestRemind(t, other, base.Add(time.Minute), 5*time.Minute)
+
+	digests := domain.BuildDigests([]*domain.Remind{third, otherUsers, second, first})
+
+	require.Len(t, digests, 3)
+
+	assert.Equal(t, other, digests[0].UserID())
+	assert.Equal(t, []domain.RemindID{otherUsers.ID()}, digests[0].RemindIDs())
+	assert.True(t, base.Add(time.Minute).Equal(digests[0].RepresentativeTime()))
+
+	assert.Equal(t, user, digests[1].UserID())
+	assert.Equal(t, []domain.RemindID{first.ID(), second.ID()}, digests[1].RemindIDs())
+	assert.True(t, base.Add(time.Minute).Equal(digests[1].Window().Start))
+	assert.True(t, base.Add(2*time.Minute).Equal(digests[1].Window().End))
+	assert.True(t, base.Add(90*time.Second).Equal(digests[1].RepresentativeTime()))
+
+	assert.Equal(t, user, digests[2].UserID())
+	assert.Equal(t, 1, digests[2].Size())
+	assert.True(t, base.Add(6*time.Minute).Equal(digests[2].RepresentativeTime()))
+}
+
+func TestBuildDigestsSkipsUndeliveredSuccess(t *testing.T) {
+	base := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	user := createValidUserID(t)
+
+	acknowledged := newDigestRemind(t, user, base, 2*time.Minute)
+	require.NoError(t, acknowledged.Acknowledge(base))
+
+	paused := newDigestRemind(t, user, base, 2*time.Minute)
+	paused.Pause()
+
+	throttled := newDigestRemind(t, user, base, 2*time.Minute)
+	require.NoError(t, throttled.MarkAsThrottled())
+
+	pending := newDigestRemind(t, user, base.Add(time.Minute), 2*time.Minute)
+
+	digests := domain.BuildDigests([]*domain.Remind{acknowledged, paused, throttled, pending})
+
+	require.Len(t, digests, 1)
+	assert.Equal(t, []domain.RemindID{pending.ID()}, digests[0].RemindIDs())
+	assert.True(t, base.Add(time.Minute).Equal(digests[0].RepresentativeTime()))
+}
+
+func TestBuildDigestsEmptySuccess(t *testing.T) {
+	assert.Empty(t, domain.BuildDigests(nil))
+}
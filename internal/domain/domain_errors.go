@@ -9,7 +9,20 @@ var (
 	ErrInvalidTaskType  = errors.New("invalid task type")
 
 	ErrPastRemindTime   = errors.New("remind time cannot be in the past")
+	ErrAllTimesPast     = errors.New("all remind times are in the past")
 	ErrAlreadyThrottled = errors.New("remind is already throttled")
 
+	ErrAlreadyAcknowledged = errors.New("remind is already acknowledged")
+
 	ErrInvalidRemindID = errors.New("invalid remind ID")
+
+	ErrUserPreferencesNotFound = errors.New("user preferences not found")
+	ErrAllTimesInQuietHours    = errors.New("all remind times fall within quiet hours")
+
+	ErrInvalidResumeAt     = errors.New("resume time must be in the future")
+	ErrInvalidResumePolicy = errors.New("invalid resume policy")
+
+	ErrInvalidCatchUpPolicy = errors.New("invalid catch-up policy")
+
+	ErrInvalidRateLimit = errors.New("invalid rate limit")
 )
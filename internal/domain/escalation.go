@@ -0,0 +1,96 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+// EscalationAction is what happens when a remind goes unacknowledged for too long.
+type EscalationAction string
+
+const (
+	// EscalationAddDevices also notifies the user's default devices through
+	// the task's next pending remind and any follow-up.
+	EscalationAddDevices EscalationAction = "add_devices"
+	// EscalationNarrowWindow switches the task's next pending remind to the
+	// narrow TargetAt window of the task type; follow-ups always use it.
+	EscalationNarrowWindow EscalationAction = "narrow_window"
+	// EscalationFollowUp creates a new remind for the same task.
+	EscalationFollowUp EscalationAction = "follow_up"
+)
+
+const (
+	MinEscalationDelay = 1 * time.Minute
+	MaxEscalationSteps = 5
+)
+
+var (
+	ErrInvalidEscalationPolicy = errors.New("invalid escalation policy: delay must be at least 1 minute with 1 to 5 known steps")
+	ErrNotEscalatable          = errors.New("remind is not due for escalation")
+)
+
+func NewEscalationAction(s string) (EscalationAction, error) {
+	switch EscalationAction(s) {
+	case EscalationAddDevices, EscalationNarrowWindow, EscalationFollowUp:
+		return EscalationAction(s), nil
+	default:
+		return "", ErrInvalidEscalationPolicy
+	}
+}
+
+// EscalationPolicy runs one step each time a remind stays unacknowledged for
+// another `after` past its time: step i is due at time + (i+1)*after.
+// The zero value never escalates.
+type EscalationPolicy struct {
+	after time.Duration
+	steps []EscalationAction
+}
+
+func NewEscalationPolicy(after time.Duration, steps []EscalationAction) (EscalationPolicy, error) {
+	if after < MinEscalationDelay || len(steps) == 0 || len(steps) > MaxEscalationSteps {
+		return EscalationPolicy{}, ErrInvalidEscalationPolicy
+	}
+
+	for _, s := range steps {
+		if _, err := NewEscalationAction(string(s)); err != nil {
+			return EscalationPolicy{}, err
+		}
+	}
+
+	return EscalationPolicy{
+		after: after,
+		steps: steps,
+	}, nil
+}
+
+// DefaultEscalationPolicy returns the policy used when a request sets none,
+// as registered for the task type:
+//   - short: narrow the window after 5 minutes, then follow up
+//   - near: narrow the window after 15 minutes, add devices, then follow up
+//   - relaxed: follow up after 30 minutes
+//   - scheduled: none (a late nag for a fixed-time appointment is useless)
+func DefaultEscalationPolicy(taskType Type) EscalationPolicy {
+	def, ok := taskTypes.Lookup(taskType)
+	if !ok {
+		return EscalationPolicy{}
+	}
+
+	return def.Escalation
+}
+
+func (p EscalationPolicy) After() time.Duration {
+	return p.after
+}
+
+func (p EscalationPolicy) Steps() []EscalationAction {
+	return p.steps
+}
+
+func (p EscalationPolicy) IsZero() bool {
+	return len(p.steps) == 0
+}
+
+// MaxLevel is the escalation level reached once every step has run.
+func (p EscalationPolicy) MaxLevel() int {
+	return len(p.steps)
+}
@@ -0,0 +1,212 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewEscalationPolicySuccess(t *testing.T) {
+	policy, err := domain.NewEscalationPolicy(10*time.Minute, []domain.EscalationAction{
+		domain.EscalationAddDevices,
+		domain.EscalationFollowUp,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, 10*time.Minute, policy.After())
+	assert.Equal(t, 2, policy.MaxLevel())
+	assert.False(t, policy.IsZero())
+}
+
+func TestNewEscalationPolicyError(t *testing.T) {
+	tests := []struct {
+		name  string
+		after time.Duration
+		steps []domain.EscalationAction
+	}{
+		{
+			name:  "delay below one minute",
+			after: 30 * time.Second,
+			steps: []domain.EscalationAction{domain.EscalationFollowUp},
+		},
+		{
+			name:  "no steps",
+			after: 10 * time.Minute,
+			steps: nil,
+		},
+		{
+			name:  "too many steps",
+			after: 10 * time.Minute,
+			steps: []domain.EscalationAction{
+				domain.EscalationFollowUp, domain.EscalationFollowUp, domain.EscalationFollowUp,
+				domain.EscalationFollowUp, domain.EscalationFollowUp, domain.EscalationFollowUp,
+			},
+		},
+		{
+			name:  "unknown step",
+			after: 10 * time.Minute,
+			steps: []domain.EscalationAction{"call_mom"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewEscalationPolicy(tt.after, tt.steps)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidEscalationPolicy)
+		})
+	}
+}
+
+func TestDefaultEscalationPolicySuccess(t *testing.T) {
+	assert.Equal(t, 2, domain.DefaultEscalationPolicy(domain.TypeShort).MaxLevel())
+	assert.Equal(t, 3, domain.DefaultEscalationPolicy(domain.TypeNear).MaxLevel())
+	assert.Equal(t, 1, domain.DefaultEscalationPolicy(domain.TypeRelaxed).MaxLevel())
+	assert.True(t, domain.DefaultEscalationPolicy(domain.TypeScheduled).IsZero())
+}
+
+func newEscalatingRemind(t *testing.T, remindTime time.Time, steps ...domain.EscalationAction) *domain.Remind {
+	t.Helper()
+
+	policy, err := domain.NewEscalationPolicy(10*time.Minute, steps)
+	require.NoError(t, err)
+
+	remind := domain.Reconstitute(
+		domain.NewRemindID(),
+		remindTime,
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeRelaxed,
+		false,
+		domain.MustSlideWindowWidth(10*time.Minute),
+		nil,
+		policy,
+		0,
+		false,
+		domain.Payload{},
+		remindTime.Add(-1*time.Hour),
+		remindTime.Add(-1*time.Hour),
+	)
+
+	return remind
+}
+
+func TestRemindEscalateSuccess(t *testing.T) {
+	remindTime := time.Now().Add(-25 * time.Minute)
+	remind := newEscalatingRemind(t, remindTime,
+		domain.EscalationNarrowWindow,
+		domain.EscalationAddDevices,
+		domain.EscalationFollowUp,
+	)
+
+	payload, err := domain.NewPayload("Stretch", "", "", "", nil)
+	require.NoError(t, err)
+	remind.AssignPayload(payload)
+
+	dueAt, ok := remind.NextEscalationAt()
+	require.True(t, ok)
+	assert.True(t, remindTime.Add(10*time.Minute).Equal(dueAt))
+
+	now := time.Now()
+
+	step, err := remind.Escalate(now, nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EscalationNarrowWindow, step)
+	assert.Equal(t, 1, remind.EscalationLevel())
+	assert.Equal(t, 10*time.Minute, remind.SlideWindowWidth().Duration(), "a fired remind keeps its window")
+
+	extra, err := domain.NewDevice("extra-device", "extra-token")
+	require.NoError(t, err)
+
+	step, err = remind.Escalate(now, domain.Devices{extra})
+	require.NoError(t, err)
+	assert.Equal(t, domain.EscalationAddDevices, step)
+	assert.Equal(t, 2, remind.Devices().Count())
+
+	// The third step is due at 30 minutes past the remind time.
+	_, err = remind.Escalate(now, nil)
+	require.ErrorIs(t, err, domain.ErrNotEscalatable)
+
+	step, err = remind.Escalate(now.Add(10*time.Minute), nil)
+	require.NoError(t, err)
+	assert.Equal(t, domain.EscalationFollowUp, step)
+
+	followUpAt := now.Add(10 * time.Minute).Truncate(time.Second)
+	followUp, err := remind.FollowUp(followUpAt)
+	require.NoError(t, err)
+	assert.True(t, followUpAt.Equal(followUp.Time()))
+	assert.Equal(t, remind.TaskID(), followUp.TaskID())
+	assert.Equal(t, remind.Devices(), followUp.Devices())
+	assert.Equal(t, payload, followUp.Payload())
+	assert.True(t, followUp.EscalationPolicy().IsZero())
+	assert.Equal(t, 3, remind.EscalationLevel())
+
+	_, ok = remind.NextEscalationAt()
+	assert.False(t, ok)
+}
+
+func TestRemindApplyEscalationSuccess(t *testing.T) {
+	pending := newEscalatingRemind(t, time.Now().Add(time.Hour), domain.EscalationFollowUp)
+	assert.True(t, pending.IsPendingAt(time.Now()))
+
+	extra, err := domain.NewDevice("extra-device", "extra-token")
+	require.NoError(t, err)
+
+	assert.True(t, pending.ApplyEscalation(domain.EscalationAddDevices, domain.Devices{extra}))
+	assert.Equal(t, 2, pending.Devices().Count())
+	assert.False(t, pending.ApplyEscalation(domain.EscalationAddDevices, domain.Devices{extra}), "devices are added once")
+
+	assert.True(t, pending.ApplyEscalation(domain.EscalationNarrowWindow, nil))
+	assert.Equal(t, domain.GetTargetAtWindowWidth(domain.TypeRelaxed), pending.SlideWindowWidth())
+	assert.False(t, pending.ApplyEscalation(domain.EscalationNarrowWindow, nil), "already narrow")
+
+	assert.False(t, pending.ApplyEscalation(domain.EscalationFollowUp, nil))
+
+	require.NoError(t, pending.Acknowledge(time.Now()))
+	assert.False(t, pending.IsPendingAt(time.Now()))
+}
+
+func TestRemindEscalateError(t *testing.T) {
+	tests := []struct {
+		name   string
+		remind func(t *testing.T) *domain.Remind
+	}{
+		{
+			name: "not yet due",
+			remind: func(t *testing.T) *domain.Remind {
+				return newEscalatingRemind(t, time.Now().Add(-5*time.Minute), domain.EscalationFollowUp)
+			},
+		},
+		{
+			name: "acknowledged",
+			remind: func(t *testing.T) *domain.Remind {
+				r := newEscalatingRemind(t, time.Now().Add(-time.Hour), domain.EscalationFollowUp)
+				require.NoError(t, r.Acknowledge(time.Now()))
+
+				return r
+			},
+		},
+		{
+			name: "without policy",
+			remind: func(t *testing.T) *domain.Remind {
+				r := newEscalatingRemind(t, time.Now().Add(-time.Hour), domain.EscalationFollowUp)
+				r.AssignEscalationPolicy(domain.EscalationPolicy{})
+
+				return r
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.remind(t).Escalate(time.Now(), nil)
+
+			assert.ErrorIs(t, err, domain.ErrNotEscalatable)
+		})
+	}
+}
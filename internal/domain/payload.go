@@ -0,0 +1,132 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// Payload limits. The total stays well below the 4KB FCM message limit so the
+// notification side can add its own fields.
+const (
+	MaxPayloadTitleLength    = 100  // runes
+	MaxPayloadBodyLength     = 1000 // runes
+	MaxPayloadDeepLinkLength = 2048 // bytes
+	MaxPayloadDataEntries    = 20
+	MaxPayloadDataKeyLength  = 64  // bytes
+	MaxPayloadDataValueSize  = 512 // bytes
+	MaxPayloadSize           = 3072
+)
+
+var ErrInvalidPayload = errors.New("invalid notification payload")
+
+// Payload is the notification content stored with a remind so it can be
+// rendered at send time without asking the task service. The zero value is
+// no payload.
+type Payload struct {
+	title    string
+	body     string
+	deepLink string
+	locale   string
+	data     map[string]string
+}
+
+func NewPayload(title, body, deepLink, locale string, data map[string]string) (Payload, error) {
+	if utf8.RuneCountInString(title) > MaxPayloadTitleLength {
+		return Payload{}, fmt.Errorf("%w: title exceeds %d characters", ErrInvalidPayload, MaxPayloadTitleLength)
+	}
+
+	if utf8.RuneCountInString(body) > MaxPayloadBodyLength {
+		return Payload{}, fmt.Errorf("%w: body exceeds %d characters", ErrInvalidPayload, MaxPayloadBodyLength)
+	}
+
+	if deepLink != "" {
+		if len(deepLink) > MaxPayloadDeepLinkLength {
+			return Payload{}, fmt.Errorf("%w: deep link exceeds %d bytes", ErrInvalidPayload, MaxPayloadDeepLinkLength)
+		}
+
+		if u, err := url.Parse(deepLink); err != nil || u.Scheme == "" {
+			return Payload{}, fmt.Errorf("%w: deep link must be an absolute URI", ErrInvalidPayload)
+		}
+	}
+
+	if locale != "" {
+		tag, err := language.Parse(locale)
+		if err != nil {
+			return Payload{}, fmt.Errorf("%w: invalid locale %q", ErrInvalidPayload, locale)
+		}
+
+		locale = tag.String()
+	}
+
+	if len(data) > MaxPayloadDataEntries {
+		return Payload{}, fmt.Errorf("%w: more than %d data entries", ErrInvalidPayload, MaxPayloadDataEntries)
+	}
+
+	size := len(title) + len(body) + len(deepLink) + len(locale)
+
+	for k, v := range data {
+		if k == "" || len(k) > MaxPayloadDataKeyLength {
+			return Payload{}, fmt.Errorf("%w: data key must be 1 to %d bytes", ErrInvalidPayload, MaxPayloadDataKeyLength)
+		}
+
+		if len(v) > MaxPayloadDataValueSize {
+			return Payload{}, fmt.Errorf("%w: data value of %q exceeds %d bytes", ErrInvalidPayload, k, MaxPayloadDataValueSize)
+		}
+
+		size += len(k) + len(v)
+	}
+
+	if size > MaxPayloadSize {
+		return Payload{}, fmt.Errorf("%w: total size exceeds %d bytes", ErrInvalidPayload, MaxPayloadSize)
+	}
+
+	return Payload{
+		title:    title,
+		body:     body,
+		deepLink: deepLink,
+		locale:   locale,
+		data:     maps.Clone(data),
+	}, nil
+}
+
+// ReconstitutePayload restores a stored payload without re-validating it, so
+// reminds stored before a limit was tightened can still be loaded.
+func ReconstitutePayload(title, body, deepLink, locale string, data map[string]string) Payload {
+	return Payload{
+		title:    title,
+		body:     body,
+		deepLink: deepLink,
+		locale:   locale,
+		data:     maps.Clone(data),
+	}
+}
+
+func (p Payload) Title() string {
+	return p.title
+}
+
+func (p Payload) Body() string {
+	return p.body
+}
+
+func (p Payload) DeepLink() string {
+	return p.deepLink
+}
+
+// Locale is the BCP 47 tag of the content, in canonical form.
+func (p Payload) Locale() string {
+	return p.locale
+}
+
+func (p Payload) Data() map[string]string {
+	return p.data
+}
+
+func (p Payload) IsEmpty() bool {
+	return p.title == "" && p.body == "" && p.deepLink == "" && p.locale == "" && len(p.data) == 0
+}
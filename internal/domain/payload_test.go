@@ -0,0 +1,83 @@
+package domain_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewPayloadSuccess(t *testing.T) {
+	data := map[string]string{"task_id": "42"}
+
+	payload, err := domain.NewPayload("Stand-up", "Daily sync", "primind://tasks/42", "ja-jp", data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Stand-up", payload.Title())
+	assert.Equal(t, "Daily sync", payload.Body())
+	assert.Equal(t, "primind://tasks/42", payload.DeepLink())
+	assert.Equal(t, "ja-JP", payload.Locale())
+	assert.Equal(t, data, payload.Data())
+	assert.False(t, payload.IsEmpty())
+
+	// The payload keeps its own copy of the data.
+	data["task_id"] = "43"
+	assert.Equal(t, "42", payload.Data()["task_id"])
+
+	empty, err := domain.NewPayload("", "", "", "", nil)
+	require.NoError(t, err)
+	assert.True(t, empty.IsEmpty())
+	assert.True(t, domain.Payload{}.IsEmpty())
+}
+
+func TestNewPayloadError(t *testing.T) {
+	tooManyEntries := make(map[string]string, domain.MaxPayloadDataEntries+1)
+	for i := range domain.MaxPayloadDataEntries + 1 {
+		tooManyEntries["key"+strconv.Itoa(i)] = "v"
+	}
+
+	tooLarge := make(map[string]string, 7)
+	for i := range 7 {
+		tooLarge["key"+strconv.Itoa(i)] = strings.Repeat("v", domain.MaxPayloadDataValueSize)
+	}
+
+	tests := []struct {
+		name     string
+		title    string
+		body     string
+		deepLink string
+		locale   string
+		data     map[string]string
+	}{
+		{name: "title too long", title: strings.Repeat("あ", domain.MaxPayloadTitleLength+1)},
+		{name: "body too long", body: strings.Repeat("b", domain.MaxPayloadBodyLength+1)},
+		{name: "relative deep link", deepLink: "/tasks/42"},
+		{name: "invalid locale", locale: "not a locale"},
+		{name: "too many data entries", data: tooManyEntries},
+		{name: "empty data key", data: map[string]string{"": "v"}},
+		{name: "data value too large", data: map[string]string{"k": strings.Repeat("v", domain.MaxPayloadDataValueSize+1)}},
+		{name: "total size too large", data: tooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewPayload(tt.title, tt.body, tt.deepLink, tt.locale, tt.data)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidPayload)
+		})
+	}
+}
+
+func TestReconstitutePayloadSuccess(t *testing.T) {
+	// Stored payloads load even when they exceed the current limits.
+	title := strings.Repeat("t", domain.MaxPayloadTitleLength+1)
+
+	payload := domain.ReconstitutePayload(title, "", "/tasks/42", "", nil)
+
+	assert.Equal(t, title, payload.Title())
+	assert.Equal(t, "/tasks/42", payload.DeepLink())
+}
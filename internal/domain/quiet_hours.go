@@ -0,0 +1,164 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidQuietWindow   = errors.New("invalid quiet window: start and end must be distinct times of day")
+	ErrInvalidQuietWeekday  = errors.New("invalid quiet window weekday")
+	ErrOverlappingQuietHour = errors.New("quiet windows must not overlap")
+)
+
+// QuietWindow is a daily period, in the user's timezone, during which reminds
+// should not fire. A window whose end is before its start runs past midnight
+// into the following day (e.g. 22:00-07:00 on Monday ends Tuesday 07:00).
+type QuietWindow struct {
+	weekday time.Weekday
+	start   time.Duration // offset from midnight
+	end     time.Duration // offset from midnight
+}
+
+func NewQuietWindow(weekday time.Weekday, start, end time.Duration) (QuietWindow, error) {
+	if weekday < time.Sunday || weekday > time.Saturday {
+		return QuietWindow{}, ErrInvalidQuietWeekday
+	}
+
+	if !isTimeOfDay(start) || !isTimeOfDay(end) || start == end {
+		return QuietWindow{}, ErrInvalidQuietWindow
+	}
+
+	return QuietWindow{
+		weekday: weekday,
+		start:   start,
+		end:     end,
+	}, nil
+}
+
+func isTimeOfDay(d time.Duration) bool {
+	return d >= 0 && d < 24*time.Hour
+}
+
+func (w QuietWindow) Weekday() time.Weekday {
+	return w.weekday
+}
+
+func (w QuietWindow) Start() time.Duration {
+	return w.start
+}
+
+func (w QuietWindow) End() time.Duration {
+	return w.end
+}
+
+func (w QuietWindow) length() time.Duration {
+	if w.end > w.start {
+		return w.end - w.start
+	}
+
+	return 24*time.Hour - w.start + w.end
+}
+
+// occurrence returns the window instance that begins on the calendar day of
+// day. Both ends are wall clock times, so a window on a DST transition day
+// still starts and ends at its configured times of day.
+func (w QuietWindow) occurrence(day time.Time) (time.Time, time.Time) {
+	return wallClock(day, w.start), wallClock(day, w.start+w.length())
+}
+
+// wallClock returns the time of day offset on the calendar day of day; an
+// offset past midnight falls on the next day.
+func wallClock(day time.Time, offset time.Duration) time.Time {
+	return time.Date(
+		day.Year(), day.Month(), day.Day(),
+		int(offset/time.Hour), int(offset%time.Hour/time.Minute), int(offset%time.Minute/time.Second), 0,
+		day.Location(),
+	)
+}
+
+type QuietHours []QuietWindow
+
+// NewQuietHours rejects windows that overlap, including those that run past
+// midnight into the next day's window. Windows may touch.
+func NewQuietHours(windows []QuietWindow) (QuietHours, error) {
+	ordered := slices.Clone(windows)
+	slices.SortFunc(ordered, func(a, b QuietWindow) int {
+		return int(weekOffset(a) - weekOffset(b))
+	})
+
+	for i, w := range ordered {
+		if len(ordered) == 1 {
+			break
+		}
+
+		var gap time.Duration
+		if i == len(ordered)-1 {
+			// The last window of the week is followed by the first one of the next week.
+			gap = weekOffset(ordered[0]) + 7*24*time.Hour - weekOffset(w)
+		} else {
+			gap = weekOffset(ordered[i+1]) - weekOffset(w)
+		}
+
+		if w.length() > gap {
+			return nil, ErrOverlappingQuietHour
+		}
+	}
+
+	return QuietHours(ordered), nil
+}
+
+func weekOffset(w QuietWindow) time.Duration {
+	return time.Duration(w.weekday)*24*time.Hour + w.start
+}
+
+func (q QuietHours) ToSlice() []QuietWindow {
+	return q
+}
+
+func (q QuietHours) IsEmpty() bool {
+	return len(q) == 0
+}
+
+// QuietUntil reports whether t falls inside a quiet window in loc and, if so,
+// when quiet hours end. Windows touching each other, such as one ending at
+// midnight and the next day's starting then, count as one.
+func (q QuietHours) QuietUntil(t time.Time, loc *time.Location) (time.Time, bool) {
+	end, quiet := q.windowEnd(t.In(loc))
+	if !quiet {
+		return time.Time{}, false
+	}
+
+	// Each window can continue the chain once; the bound also stops a week
+	// covered entirely.
+	for range q {
+		next, quiet := q.windowEnd(end)
+		if !quiet {
+			break
+		}
+
+		end = next
+	}
+
+	return end, true
+}
+
+// windowEnd returns the end of the window local falls inside.
+func (q QuietHours) windowEnd(local time.Time) (time.Time, bool) {
+	// A window that started on the previous day may still be running.
+	for _, day := range []time.Time{local.AddDate(0, 0, -1), local} {
+		for _, w := range q {
+			if w.weekday != day.Weekday() {
+				continue
+			}
+
+			start, end := w.occurrence(day)
+			if !local.Before(start) && local.Before(end) {
+				return end, true
+			}
+		}
+	}
+
+	return time.Time{}, false
+}
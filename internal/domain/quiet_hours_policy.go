@@ -0,0 +1,99 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// QuietHoursAction is what happens to a remind time that falls inside quiet hours.
+type QuietHoursAction string
+
+const (
+	// QuietHoursKeep fires the remind anyway, e.g. for appointments at a fixed time.
+	QuietHoursKeep QuietHoursAction = "keep"
+	// QuietHoursShift moves the remind to the end of the quiet window.
+	QuietHoursShift QuietHoursAction = "shift"
+	// QuietHoursDrop discards the remind.
+	QuietHoursDrop QuietHoursAction = "drop"
+)
+
+type QuietHoursPolicy struct {
+	actions map[Type]QuietHoursAction
+}
+
+// NewQuietHoursPolicy returns the registered actions of the task types:
+//   - scheduled: keep (the time was chosen deliberately)
+//   - relaxed/near: shift to the end of quiet hours
+//   - short: drop (a short task's reminder is stale by morning)
+func NewQuietHoursPolicy() *QuietHoursPolicy {
+	defs := taskTypes.Definitions()
+
+	actions := make(map[Type]QuietHoursAction, len(defs))
+	for _, def := range defs {
+		actions[def.Type] = def.QuietHours
+	}
+
+	return &QuietHoursPolicy{
+		actions: actions,
+	}
+}
+
+func (p *QuietHoursPolicy) Action(taskType Type) QuietHoursAction {
+	if action, ok := p.actions[taskType]; ok {
+		return action
+	}
+
+	return QuietHoursKeep
+}
+
+// Place returns where the remind time goes for the user's quiet hours, or
+// false when it is dropped.
+func (p *QuietHoursPolicy) Place(t time.Time, taskType Type, prefs *UserPreferences) (time.Time, bool) {
+	if prefs == nil {
+		return t, true
+	}
+
+	end, quiet := prefs.QuietUntil(t)
+	if !quiet {
+		return t, true
+	}
+
+	switch p.Action(taskType) {
+	case QuietHoursShift:
+		return end.In(t.Location()), true
+	case QuietHoursDrop:
+		return time.Time{}, false
+	default:
+		return t, true
+	}
+}
+
+// Apply returns the remind times adjusted for the user's quiet hours, sorted
+// and without duplicates (several shifted times can land on the same window
+// end). The result may be empty when every time is dropped.
+func (p *QuietHoursPolicy) Apply(times []time.Time, taskType Type, prefs *UserPreferences) []time.Time {
+	if prefs == nil || prefs.QuietHours().IsEmpty() {
+		return times
+	}
+
+	action := p.Action(taskType)
+	if action == QuietHoursKeep {
+		return times
+	}
+
+	adjusted := make([]time.Time, 0, len(times))
+
+	for _, t := range times {
+		if placed, ok := p.Place(t, taskType, prefs); ok {
+			adjusted = append(adjusted, placed)
+		}
+	}
+
+	slices.SortFunc(adjusted, func(a, b time.Time) int {
+		return a.Compare(b)
+	})
+
+	return slices.CompactFunc(adjusted, func(a, b time.Time) bool {
+		return a.Equal(b)
+	})
+}
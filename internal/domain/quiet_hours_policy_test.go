@@ -0,0 +1,151 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func newNightlyQuietPreferences(t *testing.T) *domain.UserPreferences {
+	t.Helper()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	windows := make([]domain.QuietWindow, 0, 7)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		windows = append(windows, mustQuietWindow(t, d, 22*time.Hour, 7*time.Hour))
+	}
+
+	quietHours, err := domain.NewQuietHours(windows)
+	require.NoError(t, err)
+
+	return domain.NewUserPreferences(userID, nil, domain.UTCTimezone(), quietHours, nil)
+}
+
+func TestQuietHoursPolicyApplySuccess(t *testing.T) {
+	prefs := newNightlyQuietPreferences(t)
+	policy := domain.NewQuietHoursPolicy()
+
+	evening := time.Date(2030, 1, 7, 20, 0, 0, 0, time.UTC)
+	night := time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC)
+	earlyMorning := time.Date(2030, 1, 8, 3, 0, 0, 0, time.UTC)
+	quietEnd := time.Date(2030, 1, 8, 7, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		taskType domain.Type
+		times    []time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "scheduled reminds are kept",
+			taskType: domain.TypeScheduled,
+			times:    []time.Time{evening, night},
+			expected: []time.Time{evening, night},
+		},
+		{
+			name:     "relaxed reminds are shifted and deduplicated",
+			taskType: domain.TypeRelaxed,
+			times:    []time.Time{evening, night, earlyMorning},
+			expected: []time.Time{evening, quietEnd},
+		},
+		{
+			name:     "near reminds are shifted",
+			taskType: domain.TypeNear,
+			times:    []time.Time{night},
+			expected: []time.Time{quietEnd},
+		},
+		{
+			name:     "short reminds are dropped",
+			taskType: domain.TypeShort,
+			times:    []time.Time{evening, night},
+			expected: []time.Time{evening},
+		},
+		{
+			name:     "all short reminds dropped",
+			taskType: domain.TypeShort,
+			times:    []time.Time{night, earlyMorning},
+			expected: []time.Time{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := policy.Apply(tt.times, tt.taskType, prefs)
+
+			require.Len(t, result, len(tt.expected))
+
+			for i := range tt.expected {
+				assert.True(t, tt.expected[i].Equal(result[i]), "expected %s, got %s", tt.expected[i], result[i])
+			}
+		})
+	}
+}
+
+func TestQuietHoursPolicyPlaceSuccess(t *testing.T) {
+	prefs := newNightlyQuietPreferences(t)
+	policy := domain.NewQuietHoursPolicy()
+
+	night := time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC)
+	quietEnd := time.Date(2030, 1, 8, 7, 0, 0, 0, time.UTC)
+
+	at, ok := policy.Place(night, domain.TypeNear, prefs)
+	assert.True(t, ok)
+	assert.True(t, quietEnd.Equal(at))
+
+	_, ok = policy.Place(night, domain.TypeShort, prefs)
+	assert.False(t, ok)
+
+	at, ok = policy.Place(night, domain.TypeScheduled, prefs)
+	assert.True(t, ok)
+	assert.True(t, night.Equal(at))
+}
+
+func TestQuietHoursPolicyPlaceTouchingWindowsSuccess(t *testing.T) {
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{
+		mustQuietWindow(t, time.Monday, 22*time.Hour, 0),
+		mustQuietWindow(t, time.Tuesday, 0, 7*time.Hour),
+	})
+	require.NoError(t, err)
+
+	prefs := domain.NewUserPreferences(userID, nil, domain.UTCTimezone(), quietHours, nil)
+
+	at, ok := domain.NewQuietHoursPolicy().Place(time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC), domain.TypeNear, prefs)
+
+	assert.True(t, ok)
+	assert.True(t, time.Date(2030, 1, 8, 7, 0, 0, 0, time.UTC).Equal(at), "got %s", at)
+}
+
+func TestQuietHoursPolicyApplyWithoutPreferencesSuccess(t *testing.T) {
+	policy := domain.NewQuietHoursPolicy()
+	times := []time.Time{time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC)}
+
+	assert.Equal(t, times, policy.Apply(times, domain.TypeShort, nil))
+}
+
+func TestQuietHoursPolicyShiftedWidthsSuccess(t *testing.T) {
+	prefs := newNightlyQuietPreferences(t)
+	policy := domain.NewQuietHoursPolicy()
+	calc := domain.NewSlideWindowWidthCalculator()
+
+	// 21:50 stays; 23:00 moves to 07:00, so the first remind is no longer
+	// an intermediate with a 70-minute gap but one with a 9h10m gap.
+	first := time.Date(2030, 1, 7, 21, 50, 0, 0, time.UTC)
+	second := time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC)
+
+	shifted := policy.Apply([]time.Time{first, second}, domain.TypeRelaxed, prefs)
+	widths := calc.CalculateSlideWindowWidths(shifted, domain.TypeRelaxed)
+
+	require.Len(t, shifted, 2)
+	assert.Equal(t, domain.IntermediateMaxWidthBase, widths[shifted[0]].Duration())
+	assert.Equal(t, domain.WindowWidthBase, widths[shifted[1]].Duration())
+}
@@ -0,0 +1,271 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func mustQuietWindow(t *testing.T, weekday time.Weekday, start, end time.Duration) domain.QuietWindow {
+	t.Helper()
+
+	w, err := domain.NewQuietWindow(weekday, start, end)
+	require.NoError(t, err)
+
+	return w
+}
+
+func TestNewQuietWindowSuccess(t *testing.T) {
+	tests := []struct {
+		name    string
+		weekday time.Weekday
+		start   time.Duration
+		end     time.Duration
+	}{
+		{
+			name:    "same-day window",
+			weekday: time.Monday,
+			start:   13 * time.Hour,
+			end:     14 * time.Hour,
+		},
+		{
+			name:    "overnight window",
+			weekday: time.Friday,
+			start:   22 * time.Hour,
+			end:     7 * time.Hour,
+		},
+		{
+			name:    "starts at midnight",
+			weekday: time.Sunday,
+			start:   0,
+			end:     6 * time.Hour,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w, err := domain.NewQuietWindow(tt.weekday, tt.start, tt.end)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.weekday, w.Weekday())
+			assert.Equal(t, tt.start, w.Start())
+			assert.Equal(t, tt.end, w.End())
+		})
+	}
+}
+
+func TestNewQuietWindowError(t *testing.T) {
+	tests := []struct {
+		name        string
+		weekday     time.Weekday
+		start       time.Duration
+		end         time.Duration
+		expectedErr error
+	}{
+		{
+			name:        "start equals end",
+			weekday:     time.Monday,
+			start:       8 * time.Hour,
+			end:         8 * time.Hour,
+			expectedErr: domain.ErrInvalidQuietWindow,
+		},
+		{
+			name:        "end past midnight",
+			weekday:     time.Monday,
+			start:       22 * time.Hour,
+			end:         24 * time.Hour,
+			expectedErr: domain.ErrInvalidQuietWindow,
+		},
+		{
+			name:        "negative start",
+			weekday:     time.Monday,
+			start:       -time.Hour,
+			end:         7 * time.Hour,
+			expectedErr: domain.ErrInvalidQuietWindow,
+		},
+		{
+			name:        "invalid weekday",
+			weekday:     time.Weekday(7),
+			start:       22 * time.Hour,
+			end:         7 * time.Hour,
+			expectedErr: domain.ErrInvalidQuietWeekday,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewQuietWindow(tt.weekday, tt.start, tt.end)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestNewQuietHoursError(t *testing.T) {
+	tests := []struct {
+		name    string
+		windows func(t *testing.T) []domain.QuietWindow
+	}{
+		{
+			name: "overnight window runs into next day's window",
+			windows: func(t *testing.T) []domain.QuietWindow {
+				return []domain.QuietWindow{
+					mustQuietWindow(t, time.Monday, 22*time.Hour, 7*time.Hour),
+					mustQuietWindow(t, time.Tuesday, 6*time.Hour, 8*time.Hour),
+				}
+			},
+		},
+		{
+			name: "Saturday night runs into Sunday morning",
+			windows: func(t *testing.T) []domain.QuietWindow {
+				return []domain.QuietWindow{
+					mustQuietWindow(t, time.Sunday, 5*time.Hour, 9*time.Hour),
+					mustQuietWindow(t, time.Saturday, 23*time.Hour, 6*time.Hour),
+				}
+			},
+		},
+		{
+			name: "duplicate window",
+			windows: func(t *testing.T) []domain.QuietWindow {
+				return []domain.QuietWindow{
+					mustQuietWindow(t, time.Monday, 22*time.Hour, 23*time.Hour),
+					mustQuietWindow(t, time.Monday, 22*time.Hour, 23*time.Hour),
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewQuietHours(tt.windows(t))
+
+			assert.ErrorIs(t, err, domain.ErrOverlappingQuietHour)
+		})
+	}
+}
+
+func TestQuietHoursQuietUntilSuccess(t *testing.T) {
+	tokyo, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{
+		mustQuietWindow(t, time.Monday, 22*time.Hour, 7*time.Hour),
+		mustQuietWindow(t, time.Wednesday, 12*time.Hour, 13*time.Hour),
+	})
+	require.NoError(t, err)
+
+	// 2030-01-07 is a Monday.
+	tests := []struct {
+		name          string
+		at            time.Time
+		expectedQuiet bool
+		expectedEnd   time.Time
+	}{
+		{
+			name:          "inside overnight window before midnight",
+			at:            time.Date(2030, 1, 7, 23, 0, 0, 0, tokyo),
+			expectedQuiet: true,
+			expectedEnd:   time.Date(2030, 1, 8, 7, 0, 0, 0, tokyo),
+		},
+		{
+			name:          "inside overnight window after midnight",
+			at:            time.Date(2030, 1, 8, 3, 0, 0, 0, tokyo),
+			expectedQuiet: true,
+			expectedEnd:   time.Date(2030, 1, 8, 7, 0, 0, 0, tokyo),
+		},
+		{
+			name:          "window start is inclusive",
+			at:            time.Date(2030, 1, 9, 12, 0, 0, 0, tokyo),
+			expectedQuiet: true,
+			expectedEnd:   time.Date(2030, 1, 9, 13, 0, 0, 0, tokyo),
+		},
+		{
+			name:          "window end is exclusive",
+			at:            time.Date(2030, 1, 8, 7, 0, 0, 0, tokyo),
+			expectedQuiet: false,
+		},
+		{
+			name:          "other weekday is not quiet",
+			at:            time.Date(2030, 1, 10, 23, 0, 0, 0, tokyo),
+			expectedQuiet: false,
+		},
+		{
+			name:          "UTC instant is evaluated in the user's zone",
+			at:            time.Date(2030, 1, 7, 14, 30, 0, 0, time.UTC), // 23:30 in Tokyo
+			expectedQuiet: true,
+			expectedEnd:   time.Date(2030, 1, 8, 7, 0, 0, 0, tokyo),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			end, quiet := quietHours.QuietUntil(tt.at, tokyo)
+
+			assert.Equal(t, tt.expectedQuiet, quiet)
+
+			if tt.expectedQuiet {
+				assert.True(t, tt.expectedEnd.Equal(end), "expected %s, got %s", tt.expectedEnd, end)
+			}
+		})
+	}
+}
+
+func TestQuietHoursQuietUntilTouchingSuccess(t *testing.T) {
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{
+		mustQuietWindow(t, time.Monday, 22*time.Hour, 0),
+		mustQuietWindow(t, time.Tuesday, 0, 7*time.Hour),
+		mustQuietWindow(t, time.Tuesday, 7*time.Hour, 8*time.Hour),
+	})
+	require.NoError(t, err)
+
+	// 2030-01-07 is a Monday.
+	end, quiet := quietHours.QuietUntil(time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC), time.UTC)
+
+	assert.True(t, quiet)
+	assert.True(t, time.Date(2030, 1, 8, 8, 0, 0, 0, time.UTC).Equal(end), "got %s", end)
+
+	t.Run("week covered entirely", func(t *testing.T) {
+		windows := make([]domain.QuietWindow, 0, 14)
+		for d := time.Sunday; d <= time.Saturday; d++ {
+			windows = append(windows,
+				mustQuietWindow(t, d, 0, 12*time.Hour),
+				mustQuietWindow(t, d, 12*time.Hour, 0),
+			)
+		}
+
+		allWeek, err := domain.NewQuietHours(windows)
+		require.NoError(t, err)
+
+		_, quiet := allWeek.QuietUntil(time.Date(2030, 1, 7, 23, 0, 0, 0, time.UTC), time.UTC)
+
+		assert.True(t, quiet)
+	})
+}
+
+func TestQuietHoursQuietUntilDSTSuccess(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{
+		mustQuietWindow(t, time.Saturday, 22*time.Hour, 7*time.Hour),
+		mustQuietWindow(t, time.Sunday, 12*time.Hour, 13*time.Hour),
+	})
+	require.NoError(t, err)
+
+	// Clocks skip from 02:00 to 03:00 on Sunday 2030-03-10 in New York, and
+	// the windows still follow the wall clock.
+	end, quiet := quietHours.QuietUntil(time.Date(2030, 3, 10, 6, 30, 0, 0, newYork), newYork)
+	assert.True(t, quiet)
+	assert.True(t, time.Date(2030, 3, 10, 7, 0, 0, 0, newYork).Equal(end), "got %s", end)
+
+	_, quiet = quietHours.QuietUntil(time.Date(2030, 3, 10, 7, 30, 0, 0, newYork), newYork)
+	assert.False(t, quiet)
+
+	end, quiet = quietHours.QuietUntil(time.Date(2030, 3, 10, 12, 0, 0, 0, newYork), newYork)
+	assert.True(t, quiet)
+	assert.True(t, time.Date(2030, 3, 10, 13, 0, 0, 0, newYork).Equal(end), "got %s", end)
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// maxSpreadWindows bounds how far Spread pushes a remind back, in rate limit
+// windows. A remind that finds no room within it is dropped.
+const maxSpreadWindows = 10
+
+// RateLimit caps how many of a user's reminds may fire within any rolling
+// window. The zero value is unlimited.
+type RateLimit struct {
+	max    int
+	window time.Duration
+}
+
+func NewRateLimit(maxReminds int, window time.Duration) (RateLimit, error) {
+	if maxReminds < 0 {
+		return RateLimit{}, fmt.Errorf("%w: max must not be negative, got %d", ErrInvalidRateLimit, maxReminds)
+	}
+
+	if maxReminds > 0 && window <= 0 {
+		return RateLimit{}, fmt.Errorf("%w: window must be positive, got %s", ErrInvalidRateLimit, window)
+	}
+
+	return RateLimit{
+		max:    maxReminds,
+		window: window,
+	}, nil
+}
+
+func (l RateLimit) Max() int {
+	return l.max
+}
+
+func (l RateLimit) Window() time.Duration {
+	return l.window
+}
+
+func (l RateLimit) IsUnlimited() bool {
+	return l.max == 0
+}
+
+// fits reports whether one more remind at t keeps every rolling window
+// containing t within the cap. A window's count only grows when its start
+// moves up to the next remind, so the windows starting at t and at each
+// remind within a window before t cover them all.
+func (l RateLimit) fits(t time.Time, scheduled []scheduledCount) bool {
+	starts := []time.Time{t}
+
+	for _, s := range scheduled {
+		if s.time.After(t.Add(-l.window)) && s.time.Before(t) {
+			starts = append(starts, s.time)
+		}
+	}
+
+	for _, start := range starts {
+		end := start.Add(l.window)
+		n := 1
+
+		for _, s := range scheduled {
+			if !s.time.Before(start) && s.time.Before(end) {
+				n += s.count
+			}
+		}
+
+		if n > l.max {
+			return false
+		}
+	}
+
+	return true
+}
+
+// step is how far a remind is pushed back when its neighborhood is full.
+func (l RateLimit) step() time.Duration {
+	return l.window / time.Duration(l.max)
+}
+
+type scheduledCount struct {
+	time  time.Time
+	count int
+}
+
+type RateLimitPolicy struct {
+	limits map[Type]RateLimit
+}
+
+// NewRateLimitPolicy returns a policy with the given limit per task type;
+// task types without a limit are unlimited.
+func NewRateLimitPolicy(limits map[Type]RateLimit) *RateLimitPolicy {
+	return &RateLimitPolicy{
+		limits: limits,
+	}
+}
+
+func (p *RateLimitPolicy) Limit(taskType Type) RateLimit {
+	return p.limits[taskType]
+}
+
+// Allows reports whether one more remind of the task type at t keeps the
+// user's reminds within the rate limit. stored holds the user's reminds
+// already scheduled per time.
+func (p *RateLimitPolicy) Allows(t time.Time, taskType Type, stored map[time.Time]int) bool {
+	limit := p.Limit(taskType)
+	if limit.IsUnlimited() {
+		return true
+	}
+
+	scheduled := make([]scheduledCount, 0, len(stored))
+	for s, count := range stored {
+		scheduled = append(scheduled, scheduledCount{time: s, count: count})
+	}
+
+	return limit.fits(t, scheduled)
+}
+
+// Spread pushes each remind time back until every rolling window containing
+// it holds at most the task type's max reminds, and returns where each time
+// went. stored holds the user's reminds already scheduled per time.
+//
+// The last (TargetAt) time is never moved, and earlier times are only pushed
+// to where they stay minSpacing apart from the other times and before the
+// TargetAt. Times finding no room are missing from placed and returned as
+// dropped.
+func (p *RateLimitPolicy) Spread(
+	times []time.Time,
+	taskType Type,
+	minSpacing time.Duration,
+	stored map[time.Time]int,
+) (placed map[time.Time]time.Time, dropped []time.Time) {
+	placed = make(map[time.Time]time.Time, len(times))
+
+	limit := p.Limit(taskType)
+	if limit.IsUnlimited() || len(times) == 0 {
+		for _, t := range times {
+			placed[t] = t
+		}
+
+		return placed, nil
+	}
+
+	sorted := slices.SortedFunc(slices.Values(times), time.Time.Compare)
+	target := sorted[len(sorted)-1]
+
+	scheduled := make([]scheduledCount, 0, len(stored)+len(sorted))
+	for t, count := range stored {
+		scheduled = append(scheduled, scheduledCount{time: t, count: count})
+	}
+
+	scheduled = append(scheduled, scheduledCount{time: target, count: 1})
+	spread := make([]time.Time, 0, len(sorted))
+
+	for _, requested := range sorted[:len(sorted)-1] {
+		latest := requested.Add(maxSpreadWindows * limit.window)
+		if bound := target.Add(-max(minSpacing, time.Nanosecond)); bound.Before(latest) {
+			latest = bound
+		}
+
+		found := false
+
+		for candidate := requested; !candidate.After(latest); candidate = candidate.Add(limit.step()) {
+			if tooClose(candidate, spread, minSpacing) || !limit.fits(candidate, scheduled) {
+				continue
+			}
+
+			spread = append(spread, candidate)
+			scheduled = append(scheduled, scheduledCount{time: candidate, count: 1})
+			placed[requested] = candidate
+			found = true
+
+			break
+		}
+
+		if !found {
+			dropped = append(dropped, requested)
+		}
+	}
+
+	placed[target] = target
+
+	return placed, dropped
+}
+
+// tooClose reports whether t is less than minSpacing away from, or equal to,
+// any of the times.
+func tooClose(t time.Time, times []time.Time, minSpacing time.Duration) bool {
+	for _, other := range times {
+		gap := t.Sub(other).Abs()
+		if gap == 0 || gap < minSpacing {
+			return true
+		}
+	}
+
+	return false
+}
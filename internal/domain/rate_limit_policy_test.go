@@ -0,0 +1,228 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func mustRateLimit(t *testing.T, maxReminds int, window time.Duration) domain.RateLimit {
+	t.Helper()
+
+	limit, err := domain.NewRateLimit(maxReminds, window)
+	require.NoError(t, err)
+
+	return limit
+}
+
+// storedCounts counts the stored times per time.
+func storedCounts(stored ...time.Time) map[time.Time]int {
+	counts := make(map[time.Time]int, len(stored))
+	for _, s := range stored {
+		counts[s]++
+	}
+
+	return counts
+}
+
+func TestNewRateLimitSuccess(t *testing.T) {
+	limit := mustRateLimit(t, 3, time.Minute)
+
+	assert.Equal(t, 3, limit.Max())
+	assert.Equal(t, time.Minute, limit.Window())
+	assert.False(t, limit.IsUnlimited())
+	assert.True(t, mustRateLimit(t, 0, 0).IsUnlimited())
+}
+
+func TestNewRateLimitError(t *testing.T) {
+	tests := []struct {
+		name       string
+		maxReminds int
+		window     time.Duration
+	}{
+		{name: "negative max", maxReminds: -1, window: time.Minute},
+		{name: "zero window", maxReminds: 2, window: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewRateLimit(tt.maxReminds, tt.window)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidRateLimit)
+		})
+	}
+}
+
+func TestRateLimitPolicySpreadSuccess(t *testing.T) {
+	base := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	target := base.Add(5 * time.Minute)
+	policy := domain.NewRateLimitPolicy(map[domain.Type]domain.RateLimit{
+		domain.TypeShort: mustRateLimit(t, 2, time.Minute),
+	})
+
+	tests := []struct {
+		name            string
+		taskType        domain.Type
+		times           []time.Time
+		minSpacing      time.Duration
+		stored          []time.Time
+		expected        map[time.Time]time.Time
+		expectedDropped []time.Time
+	}{
+		{
+			name:     "unlimited task types are untouched",
+			taskType: domain.TypeScheduled,
+			times:    []time.Time{base},
+			stored:   []time.Time{base, base.Add(10 * time.Second)},
+			expected: map[time.Time]time.Time{base: base},
+		},
+		{
+			name:     "times with room are kept",
+			taskType: domain.TypeShort,
+			times:    []time.Time{target, base},
+			expected: map[time.Time]time.Time{base: base, target: target},
+		},
+		{
+			name:     "time in a full window is pushed back",
+			taskType: domain.TypeShort,
+			times:    []time.Time{base, target},
+			stored:   []time.Time{base, base.Add(10 * time.Second)},
+			expected: map[time.Time]time.Time{base: base.Add(time.Minute), target: target},
+		},
+		{
+			name:     "only windows containing the time count",
+			taskType: domain.TypeShort,
+			times:    []time.Time{base, target},
+			stored:   []time.Time{base.Add(-50 * time.Second), base.Add(50 * time.Second)},
+			expected: map[time.Time]time.Time{base: base, target: target},
+		},
+		{
+			name:     "spread times count against later ones",
+			taskType: domain.TypeShort,
+			times:    []time.Time{base, base.Add(10 * time.Second), target},
+			stored:   []time.Time{base.Add(-30 * time.Second)},
+			expected: map[time.Time]time.Time{
+				base:                       base,
+				base.Add(10 * time.Second): base.Add(40 * time.Second),
+				target:                     target,
+			},
+		},
+		{
+			name:       "pushed times keep the minimum spacing",
+			taskType:   domain.TypeShort,
+			times:      []time.Time{base, base.Add(time.Minute), target},
+			minSpacing: time.Minute,
+			stored:     []time.Time{base, base.Add(10 * time.Second)},
+			expected: map[time.Time]time.Time{
+				base:                  base.Add(time.Minute),
+				base.Add(time.Minute): base.Add(2 * time.Minute),
+				target:                target,
+			},
+		},
+		{
+			name:     "the TargetAt time is never moved",
+			taskType: domain.TypeShort,
+			times:    []time.Time{target},
+			stored:   []time.Time{target, target.Add(10 * time.Second)},
+			expected: map[time.Time]time.Time{target: target},
+		},
+		{
+			name:            "times without room before the TargetAt are dropped",
+			taskType:        domain.TypeShort,
+			times:           []time.Time{base, base.Add(time.Minute)},
+			minSpacing:      time.Minute,
+			stored:          []time.Time{base.Add(20 * time.Second), base.Add(30 * time.Second)},
+			expected:        map[time.Time]time.Time{base.Add(time.Minute): base.Add(time.Minute)},
+			expectedDropped: []time.Time{base},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			placed, dropped := policy.Spread(tt.times, tt.taskType, tt.minSpacing, storedCounts(tt.stored...))
+
+			assert.Equal(t, tt.expected, placed)
+			assert.Equal(t, tt.expectedDropped, dropped)
+		})
+	}
+}
+
+func TestRateLimitPolicyAllowsSuccess(t *testing.T) {
+	base := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	policy := domain.NewRateLimitPolicy(map[domain.Type]domain.RateLimit{
+		domain.TypeShort: mustRateLimit(t, 2, time.Minute),
+	})
+
+	tests := []struct {
+		name     string
+		taskType domain.Type
+		stored   []time.Time
+		expected bool
+	}{
+		{
+			name:     "unlimited task types are allowed",
+			taskType: domain.TypeScheduled,
+			stored:   []time.Time{base, base},
+			expected: true,
+		},
+		{
+			name:     "window with room is allowed",
+			taskType: domain.TypeShort,
+			stored:   []time.Time{base.Add(-30 * time.Second)},
+			expected: true,
+		},
+		{
+			name:     "full window before the time is not allowed",
+			taskType: domain.TypeShort,
+			stored:   []time.Time{base.Add(-50 * time.Second), base.Add(-10 * time.Second)},
+			expected: false,
+		},
+		{
+			name:     "full window after the time is not allowed",
+			taskType: domain.TypeShort,
+			stored:   []time.Time{base.Add(10 * time.Second), base.Add(50 * time.Second)},
+			expected: false,
+		},
+		{
+			name:     "reminds a window apart do not count",
+			taskType: domain.TypeShort,
+			stored:   []time.Time{base.Add(-time.Minute), base.Add(time.Minute)},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, policy.Allows(base, tt.taskType, storedCounts(tt.stored...)))
+		})
+	}
+}
+
+func TestRateLimitPolicySpreadWithoutRoomSuccess(t *testing.T) {
+	policy := domain.NewRateLimitPolicy(map[domain.Type]domain.RateLimit{
+		domain.TypeShort: mustRateLimit(t, 2, time.Minute),
+	})
+	requested := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+	target := requested.Add(24 * time.Hour)
+
+	// Every window is full, so the remind is dropped rather than being pushed
+	// back indefinitely.
+	full := make([]time.Time, 0, 60)
+	for i := range 60 {
+		full = append(full, requested.Add(time.Duration(i)*15*time.Second))
+	}
+
+	counts := storedCounts(full...)
+	for at := range counts {
+		counts[at] = 2
+	}
+
+	placed, dropped := policy.Spread([]time.Time{requested, target}, domain.TypeShort, 0, counts)
+
+	assert.Equal(t, map[time.Time]time.Time{target: target}, placed)
+	assert.Equal(t, []time.Time{requested}, dropped)
+}
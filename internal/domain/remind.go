@@ -13,6 +13,11 @@ type Remind struct {
 	taskType         Type
 	throttled        bool
 	slideWindowWidth SlideWindowWidth
+	acknowledgedAt   *time.Time
+	escalationPolicy EscalationPolicy
+	escalationLevel  int
+	paused           bool
+	payload          Payload
 	createdAt        time.Time
 	updatedAt        time.Time
 }
@@ -25,12 +30,12 @@ func NewRemind(
 	taskType Type,
 	slideWindowWidth SlideWindowWidth,
 ) (*Remind, error) {
-	if remindTime.Before(time.Now().Add(-1 * time.Minute)) {
+	now := time.Now()
+
+	if IsPastRemindTime(remindTime, now) {
 		return nil, ErrPastRemindTime
 	}
 
-	now := time.Now()
-
 	return &Remind{
 		id:               NewRemindID(),
 		time:             remindTime,
@@ -40,6 +45,11 @@ func NewRemind(
 		taskType:         taskType,
 		throttled:        false,
 		slideWindowWidth: slideWindowWidth,
+		acknowledgedAt:   nil,
+		escalationPolicy: EscalationPolicy{},
+		escalationLevel:  0,
+		paused:           false,
+		payload:          Payload{},
 		createdAt:        now,
 		updatedAt:        now,
 	}, nil
@@ -54,6 +64,11 @@ func Reconstitute(
 	taskType Type,
 	throttled bool,
 	slideWindowWidth SlideWindowWidth,
+	acknowledgedAt *time.Time,
+	escalationPolicy EscalationPolicy,
+	escalationLevel int,
+	paused bool,
+	payload Payload,
 	createdAt time.Time,
 	updatedAt time.Time,
 ) *Remind {
@@ -66,6 +81,11 @@ func Reconstitute(
 		taskType:         taskType,
 		throttled:        throttled,
 		slideWindowWidth: slideWindowWidth,
+		acknowledgedAt:   acknowledgedAt,
+		escalationPolicy: escalationPolicy,
+		escalationLevel:  escalationLevel,
+		paused:           paused,
+		payload:          payload,
 		createdAt:        createdAt,
 		updatedAt:        updatedAt,
 	}
@@ -86,10 +106,195 @@ func (r *Remind) IsThrottled() bool {
 	return r.throttled
 }
 
+// Acknowledge records that the user reacted to this remind at the given time.
+func (r *Remind) Acknowledge(at time.Time) error {
+	if r.acknowledgedAt != nil {
+		return ErrAlreadyAcknowledged
+	}
+
+	r.acknowledgedAt = &at
+	r.updatedAt = time.Now()
+
+	return nil
+}
+
+func (r *Remind) IsAcknowledged() bool {
+	return r.acknowledgedAt != nil
+}
+
+// AcknowledgedAt returns nil when the remind has not been acknowledged.
+func (r *Remind) AcknowledgedAt() *time.Time {
+	return r.acknowledgedAt
+}
+
+// Pause holds the remind back from dispatch until Resume is called.
+func (r *Remind) Pause() {
+	if r.paused {
+		return
+	}
+
+	r.paused = true
+	r.updatedAt = time.Now()
+}
+
+func (r *Remind) Resume() {
+	if !r.paused {
+		return
+	}
+
+	r.paused = false
+	r.updatedAt = time.Now()
+}
+
+func (r *Remind) IsPaused() bool {
+	return r.paused
+}
+
+// ReassignSlideWindowWidth replaces the width, e.g. after the window policy
+// changed, and reports whether it differed.
+func (r *Remind) ReassignSlideWindowWidth(width SlideWindowWidth) bool {
+	if r.slideWindowWidth == width {
+		return false
+	}
+
+	r.slideWindowWidth = width
+	r.updatedAt = time.Now()
+
+	return true
+}
+
+// AssignEscalationPolicy sets how the remind escalates while unacknowledged.
+func (r *Remind) AssignEscalationPolicy(policy EscalationPolicy) {
+	r.escalationPolicy = policy
+}
+
+// AssignPayload sets the notification content rendered when the remind fires.
+func (r *Remind) AssignPayload(payload Payload) {
+	r.payload = payload
+}
+
+func (r *Remind) Payload() Payload {
+	return r.payload
+}
+
+// NextEscalationAt returns when the next escalation step is due, or false
+// when the remind will not escalate any further.
+func (r *Remind) NextEscalationAt() (time.Time, bool) {
+	if r.acknowledgedAt != nil || r.escalationLevel >= r.escalationPolicy.MaxLevel() {
+		return time.Time{}, false
+	}
+
+	return r.time.Add(time.Duration(r.escalationLevel+1) * r.escalationPolicy.After()), true
+}
+
+// Escalate runs the next escalation step and returns it. The remind has
+// already fired, so a step only changes delivery through the task's later
+// reminds: add_devices records extraDevices here so a follow-up notifies them
+// too, and the caller applies add_devices and narrow_window to the task's
+// next pending remind with ApplyEscalation. For the follow_up step the
+// caller places the new remind with FollowUp.
+func (r *Remind) Escalate(now time.Time, extraDevices Devices) (EscalationAction, error) {
+	dueAt, ok := r.NextEscalationAt()
+	if !ok || now.Before(dueAt) {
+		return "", ErrNotEscalatable
+	}
+
+	step := r.escalationPolicy.Steps()[r.escalationLevel]
+
+	if step == EscalationAddDevices {
+		r.devices = r.devices.Merge(extraDevices)
+	}
+
+	r.escalationLevel++
+	r.updatedAt = time.Now()
+
+	return step, nil
+}
+
+// ApplyEscalation makes a not yet delivered remind carry an escalation step
+// of an earlier remind of its task: add_devices adds extraDevices and
+// narrow_window narrows it to the TargetAt window. It reports whether the
+// remind changed.
+func (r *Remind) ApplyEscalation(step EscalationAction, extraDevices Devices) bool {
+	switch step {
+	case EscalationAddDevices:
+		merged := r.devices.Merge(extraDevices)
+		if merged.Count() == r.devices.Count() {
+			return false
+		}
+
+		r.devices = merged
+	case EscalationNarrowWindow:
+		target := GetTargetAtWindowWidth(r.taskType)
+		if target.Duration() >= r.slideWindowWidth.Duration() {
+			return false
+		}
+
+		r.slideWindowWidth = target
+	case EscalationFollowUp:
+		return false
+	}
+
+	r.updatedAt = time.Now()
+
+	return true
+}
+
+// IsPendingAt reports whether the remind is still to be delivered after at:
+// it is scheduled later and neither acknowledged, paused nor throttled.
+func (r *Remind) IsPendingAt(at time.Time) bool {
+	return r.time.After(at) && r.acknowledgedAt == nil && !r.paused && !r.throttled
+}
+
+// FollowUp returns a new remind for the same task at at, with the narrow
+// TargetAt window. The follow-up has no escalation policy of its own so
+// escalation cannot chain.
+func (r *Remind) FollowUp(at time.Time) (*Remind, error) {
+	followUp, err := NewRemind(at, r.userID, r.devices, r.taskID, r.taskType, GetTargetAtWindowWidth(r.taskType))
+	if err != nil {
+		return nil, err
+	}
+
+	followUp.AssignPayload(r.payload)
+
+	return followUp, nil
+}
+
+func (r *Remind) EscalationPolicy() EscalationPolicy {
+	return r.escalationPolicy
+}
+
+func (r *Remind) EscalationLevel() int {
+	return r.escalationLevel
+}
+
 func (r *Remind) IsDue() bool {
 	return time.Now().After(r.time)
 }
 
+// SlideWindow is the range the remind may be delivered in: its time plus and
+// minus the slide window width, both ends inclusive.
+func (r *Remind) SlideWindow() TimeRange {
+	width := r.slideWindowWidth.Duration()
+
+	return TimeRange{
+		Start: r.time.Add(-width),
+		End:   r.time.Add(width),
+	}
+}
+
+// IsDueAt reports whether the remind may be delivered at the given instant:
+// at lies in its slide window and it is neither paused nor acknowledged.
+func (r *Remind) IsDueAt(at time.Time) bool {
+	if r.paused || r.acknowledgedAt != nil {
+		return false
+	}
+
+	window := r.SlideWindow()
+
+	return !at.Before(window.Start) && !at.After(window.End)
+}
+
 func (r *Remind) ID() RemindID {
 	return r.id
 }
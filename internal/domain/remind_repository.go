@@ -10,13 +10,60 @@ type TimeRange struct {
 	End   time.Time
 }
 
+// RemindField is a group of stored remind attributes written by Update.
+type RemindField int
+
+const (
+	RemindFieldThrottled      RemindField = iota + 1
+	RemindFieldAcknowledgedAt             // also clears the next escalation time
+	RemindFieldEscalation                 // escalation level and next escalation time
+	RemindFieldDevices
+	RemindFieldSlideWindowWidth
+)
+
 type RemindRepository interface {
 	Save(ctx context.Context, remind *Remind) error
 	FindByID(ctx context.Context, id RemindID) (*Remind, error)
 	FindByTaskID(ctx context.Context, taskID TaskID) ([]*Remind, error)
+	// FindByTimeRange returns the reminds of the range still to be delivered,
+	// leaving out paused and acknowledged ones.
 	FindByTimeRange(ctx context.Context, timeRange TimeRange) ([]*Remind, error)
-	Update(ctx context.Context, remind *Remind) error
+	// Update writes only the given fields of the remind, so concurrent changes
+	// to its other attributes are kept.
+	Update(ctx context.Context, remind *Remind, fields ...RemindField) error
+	// ReassignSlideWindowWidth replaces the remind's width with to only while
+	// it is still from, and reports whether it did.
+	ReassignSlideWindowWidth(ctx context.Context, id RemindID, from, to SlideWindowWidth) (bool, error)
 	Delete(ctx context.Context, id RemindID) error
 	DeleteByTaskID(ctx context.Context, taskID TaskID) ([]RemindID, error)
+	// DeleteByTaskIDAfter deletes the task's reminds scheduled strictly after
+	// the given time, other than keep.
+	DeleteByTaskIDAfter(ctx context.Context, taskID TaskID, after time.Time, keep RemindID) ([]RemindID, error)
+	// FindDueAt returns the unpaused, unacknowledged reminds whose slide window
+	// contains at, ordered by time, optionally leaving out throttled ones.
+	FindDueAt(ctx context.Context, at time.Time, excludeThrottled bool) ([]*Remind, error)
+	// FindEscalationDue returns unpaused, unacknowledged reminds whose next escalation step is due at now.
+	FindEscalationDue(ctx context.Context, now time.Time, limit int) ([]*Remind, error)
+	// ClaimEscalationDue locks the remind until the enclosing transaction ends
+	// if its next escalation step is still due at now. It returns
+	// ErrRemindNotFound when the step is no longer due or another transaction
+	// holds the remind, so concurrent escalation jobs never run a step twice.
+	ClaimEscalationDue(ctx context.Context, id RemindID, now time.Time) (*Remind, error)
+	// CountByUserIDPerTime counts the user's unpaused reminds in the range,
+	// start inclusive and end exclusive, per remind time.
+	CountByUserIDPerTime(ctx context.Context, userID UserID, timeRange TimeRange) (map[time.Time]int, error)
+	// CountByMinutes counts all users' unpaused reminds in each of the
+	// one-minute buckets starting at the given minutes.
+	CountByMinutes(ctx context.Context, minutes []time.Time) (map[time.Time]int, error)
+	// FindTaskIDsWithRemindsAfter returns up to limit task IDs greater than
+	// afterTaskID, in ascending order, of tasks with reminds scheduled strictly
+	// after the given time. The zero TaskID starts from the first task.
+	FindTaskIDsWithRemindsAfter(ctx context.Context, after time.Time, afterTaskID TaskID, limit int) ([]TaskID, error)
+	// PauseByUserIDAfter pauses the user's unacknowledged reminds scheduled strictly after the given time.
+	PauseByUserIDAfter(ctx context.Context, userID UserID, after time.Time) ([]RemindID, error)
+	// DeletePausedByUserIDBefore deletes the user's paused reminds scheduled strictly before the given time.
+	DeletePausedByUserIDBefore(ctx context.Context, userID UserID, before time.Time) ([]RemindID, error)
+	// ResumeByUserID clears the paused flag of all the user's reminds.
+	ResumeByUserID(ctx context.Context, userID UserID) ([]RemindID, error)
 	WithTx(ctx context.Context, fn func(repo RemindRepository) error) error
 }
@@ -0,0 +1,173 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+const (
+	MaxTemplateOffsets = 10
+	MaxTemplateOffset  = 30 * 24 * time.Hour
+)
+
+var (
+	ErrInvalidTemplateName     = errors.New("invalid template name: must be 1 to 64 lowercase letters, digits, '-' or '_'")
+	ErrInvalidRemindTemplate   = errors.New("invalid remind template")
+	ErrRemindTemplateNotFound  = errors.New("remind template not found")
+	ErrTemplateTaskTypeMissing = errors.New("remind template defines no offsets for the task type")
+	ErrAllTemplateTimesInPast  = errors.New("all remind times generated from the template are in the past")
+)
+
+var templateNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,63}$`)
+
+type TemplateName string
+
+func NewTemplateName(name string) (TemplateName, error) {
+	if !templateNamePattern.MatchString(name) {
+		return "", fmt.Errorf("%w: %q", ErrInvalidTemplateName, name)
+	}
+
+	return TemplateName(name), nil
+}
+
+func (n TemplateName) String() string {
+	return string(n)
+}
+
+// TemplateOffsets lists, per task type, how long before the target time each
+// remind fires. A zero offset fires at the target time itself.
+type TemplateOffsets map[Type][]time.Duration
+
+// RemindTemplate is a named schedule such as "1 day, 1 hour and 10 minutes
+// before", used to generate remind times from a single target time.
+type RemindTemplate struct {
+	name      TemplateName
+	offsets   TemplateOffsets
+	createdAt time.Time
+	updatedAt time.Time
+}
+
+func NewRemindTemplate(name TemplateName, offsets TemplateOffsets) (*RemindTemplate, error) {
+	normalized, err := normalizeTemplateOffsets(offsets)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	return &RemindTemplate{
+		name:      name,
+		offsets:   normalized,
+		createdAt: now,
+		updatedAt: now,
+	}, nil
+}
+
+func ReconstituteRemindTemplate(
+	name TemplateName,
+	offsets TemplateOffsets,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *RemindTemplate {
+	return &RemindTemplate{
+		name:      name,
+		offsets:   offsets,
+		createdAt: createdAt,
+		updatedAt: updatedAt,
+	}
+}
+
+// Replace swaps the template's offsets, keeping its name and creation time.
+func (t *RemindTemplate) Replace(offsets TemplateOffsets) error {
+	normalized, err := normalizeTemplateOffsets(offsets)
+	if err != nil {
+		return err
+	}
+
+	t.offsets = normalized
+	t.updatedAt = time.Now()
+
+	return nil
+}
+
+// normalizeTemplateOffsets validates the offsets and sorts each task type's
+// offsets from the largest (earliest remind) to the smallest.
+func normalizeTemplateOffsets(offsets TemplateOffsets) (TemplateOffsets, error) {
+	if len(offsets) == 0 {
+		return nil, fmt.Errorf("%w: at least one task type is required", ErrInvalidRemindTemplate)
+	}
+
+	normalized := make(TemplateOffsets, len(offsets))
+
+	for taskType, durations := range offsets {
+		if _, err := NewType(string(taskType)); err != nil {
+			return nil, errors.Join(ErrInvalidRemindTemplate, err)
+		}
+
+		if len(durations) == 0 || len(durations) > MaxTemplateOffsets {
+			return nil, fmt.Errorf("%w: %s must have 1 to %d offsets", ErrInvalidRemindTemplate, taskType, MaxTemplateOffsets)
+		}
+
+		sorted := slices.Clone(durations)
+		slices.SortFunc(sorted, func(a, b time.Duration) int {
+			return cmp.Compare(b, a)
+		})
+
+		for i, d := range sorted {
+			if d < 0 || d > MaxTemplateOffset {
+				return nil, fmt.Errorf("%w: %s offset %s is outside 0 to %s", ErrInvalidRemindTemplate, taskType, d, MaxTemplateOffset)
+			}
+
+			if i > 0 && sorted[i-1] == d {
+				return nil, fmt.Errorf("%w: %s has duplicate offset %s", ErrInvalidRemindTemplate, taskType, d)
+			}
+		}
+
+		normalized[taskType] = sorted
+	}
+
+	return normalized, nil
+}
+
+// TimesFor returns the remind times for a target time, earliest first.
+// Times already past at now are left out, so a template with a "1 day before"
+// offset still works for a target a few hours away.
+func (t *RemindTemplate) TimesFor(target time.Time, taskType Type, now time.Time) ([]time.Time, error) {
+	offsets, ok := t.offsets[taskType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrTemplateTaskTypeMissing, taskType)
+	}
+
+	times := make([]time.Time, 0, len(offsets))
+	for _, offset := range offsets {
+		if remindTime := target.Add(-offset); !remindTime.Before(now) {
+			times = append(times, remindTime)
+		}
+	}
+
+	if len(times) == 0 {
+		return nil, ErrAllTemplateTimesInPast
+	}
+
+	return times, nil
+}
+
+func (t *RemindTemplate) Name() TemplateName {
+	return t.name
+}
+
+func (t *RemindTemplate) Offsets() TemplateOffsets {
+	return t.offsets
+}
+
+func (t *RemindTemplate) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *RemindTemplate) UpdatedAt() time.Time {
+	return t.updatedAt
+}
@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+type RemindTemplateRepository interface {
+	// FindByName returns ErrRemindTemplateNotFound when no template has the name.
+	FindByName(ctx context.Context, name TemplateName) (*RemindTemplate, error)
+	// FindAll returns every template ordered by name.
+	FindAll(ctx context.Context) ([]*RemindTemplate, error)
+	// Save inserts the template or replaces the stored one.
+	Save(ctx context.Context, template *RemindTemplate) error
+	// Delete returns ErrRemindTemplateNotFound when no template has the name.
+	Delete(ctx context.Context, name TemplateName) error
+}
@@ -0,0 +1,137 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewTemplateNameSuccess(t *testing.T) {
+	for _, name := range []string{"standard", "day-hour-10m", "a", "meeting_v2"} {
+		t.Run(name, func(t *testing.T) {
+			templateName, err := domain.NewTemplateName(name)
+
+			require.NoError(t, err)
+			assert.Equal(t, name, templateName.String())
+		})
+	}
+}
+
+func TestNewTemplateNameError(t *testing.T) {
+	for _, name := range []string{"", "Standard", "-leading-dash", "has space", string(make([]byte, 65))} {
+		t.Run(name, func(t *testing.T) {
+			_, err := domain.NewTemplateName(name)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidTemplateName)
+		})
+	}
+}
+
+func TestNewRemindTemplateSuccess(t *testing.T) {
+	template, err := domain.NewRemindTemplate("standard", domain.TemplateOffsets{
+		domain.TypeScheduled: {10 * time.Minute, 24 * time.Hour, time.Hour},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, domain.TemplateName("standard"), template.Name())
+	// Offsets are kept from the earliest remind to the latest.
+	assert.Equal(t, []time.Duration{24 * time.Hour, time.Hour, 10 * time.Minute}, template.Offsets()[domain.TypeScheduled])
+}
+
+func TestNewRemindTemplateError(t *testing.T) {
+	tests := []struct {
+		name    string
+		offsets domain.TemplateOffsets
+	}{
+		{name: "no task types", offsets: domain.TemplateOffsets{}},
+		{name: "unknown task type", offsets: domain.TemplateOffsets{"urgent": {time.Hour}}},
+		{name: "no offsets", offsets: domain.TemplateOffsets{domain.TypeNear: {}}},
+		{name: "negative offset", offsets: domain.TemplateOffsets{domain.TypeNear: {-time.Minute}}},
+		{name: "offset too large", offsets: domain.TemplateOffsets{domain.TypeNear: {domain.MaxTemplateOffset + time.Hour}}},
+		{name: "duplicate offset", offsets: domain.TemplateOffsets{domain.TypeNear: {time.Hour, time.Hour}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewRemindTemplate("standard", tt.offsets)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidRemindTemplate)
+		})
+	}
+}
+
+func TestRemindTemplateTimesForSuccess(t *testing.T) {
+	template, err := domain.NewRemindTemplate("standard", domain.TemplateOffsets{
+		domain.TypeScheduled: {24 * time.Hour, time.Hour, 10 * time.Minute},
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		target   time.Time
+		expected []time.Time
+	}{
+		{
+			name:   "all offsets in the future",
+			target: now.Add(48 * time.Hour),
+			expected: []time.Time{
+				now.Add(24 * time.Hour),
+				now.Add(47 * time.Hour),
+				now.Add(48*time.Hour - 10*time.Minute),
+			},
+		},
+		{
+			name:   "past offsets are left out",
+			target: now.Add(3 * time.Hour),
+			expected: []time.Time{
+				now.Add(2 * time.Hour),
+				now.Add(3*time.Hour - 10*time.Minute),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := template.TimesFor(tt.target, domain.TypeScheduled, now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, times)
+		})
+	}
+}
+
+func TestRemindTemplateTimesForError(t *testing.T) {
+	template, err := domain.NewRemindTemplate("standard", domain.TemplateOffsets{
+		domain.TypeScheduled: {time.Hour},
+	})
+	require.NoError(t, err)
+
+	now := time.Date(2030, 1, 7, 9, 0, 0, 0, time.UTC)
+
+	_, err = template.TimesFor(now.Add(2*time.Hour), domain.TypeNear, now)
+	require.ErrorIs(t, err, domain.ErrTemplateTaskTypeMissing)
+
+	_, err = template.TimesFor(now.Add(30*time.Minute), domain.TypeScheduled, now)
+	require.ErrorIs(t, err, domain.ErrAllTemplateTimesInPast)
+}
+
+func TestRemindTemplateReplaceSuccess(t *testing.T) {
+	createdAt := time.Now().Add(-1 * time.Hour)
+	template := domain.ReconstituteRemindTemplate("standard", domain.TemplateOffsets{
+		domain.TypeNear: {time.Hour},
+	}, createdAt, createdAt)
+
+	require.NoError(t, template.Replace(domain.TemplateOffsets{domain.TypeNear: {0, 30 * time.Minute}}))
+
+	assert.Equal(t, []time.Duration{30 * time.Minute, 0}, template.Offsets()[domain.TypeNear])
+	assert.Equal(t, createdAt, template.CreatedAt())
+	assert.True(t, template.UpdatedAt().After(createdAt))
+
+	assert.ErrorIs(t, template.Replace(nil), domain.ErrInvalidRemindTemplate)
+}
@@ -247,6 +247,87 @@ func TestMarkAsThrottledError(t *testing.T) {
 	}
 }
 
+func TestAcknowledgeSuccess(t *testing.T) {
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+	assert.False(t, remind.IsAcknowledged())
+	assert.Nil(t, remind.AcknowledgedAt())
+
+	at := time.Now()
+	require.NoError(t, remind.Acknowledge(at))
+
+	assert.True(t, remind.IsAcknowledged())
+	require.NotNil(t, remind.AcknowledgedAt())
+	assert.True(t, at.Equal(*remind.AcknowledgedAt()))
+}
+
+func TestAcknowledgeError(t *testing.T) {
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+
+	first := time.Now()
+	require.NoError(t, remind.Acknowledge(first))
+
+	err = remind.Acknowledge(first.Add(time.Minute))
+
+	assert.ErrorIs(t, err, domain.ErrAlreadyAcknowledged)
+	assert.True(t, first.Equal(*remind.AcknowledgedAt()))
+}
+
+func TestPauseAndResumeSuccess(t *testing.T) {
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeRelaxed,
+		domain.MustSlideWindowWidth(10*time.Minute),
+	)
+	require.NoError(t, err)
+	assert.False(t, remind.IsPaused())
+
+	remind.Pause()
+	assert.True(t, remind.IsPaused())
+
+	// Pausing twice is a no-op.
+	remind.Pause()
+	assert.True(t, remind.IsPaused())
+
+	remind.Resume()
+	assert.False(t, remind.IsPaused())
+}
+
+func TestReassignSlideWindowWidthSuccess(t *testing.T) {
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+
+	assert.False(t, remind.ReassignSlideWindowWidth(domain.MustSlideWindowWidth(5*time.Minute)))
+
+	assert.True(t, remind.ReassignSlideWindowWidth(domain.MustSlideWindowWidth(8*time.Minute)))
+	assert.Equal(t, int32(480), remind.SlideWindowWidth().Seconds())
+}
+
 func TestIsDueSuccess(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -285,6 +366,11 @@ func TestIsDueSuccess(t *testing.T) {
 				domain.TypeNear,
 				false,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				time.Now(),
 				time.Now(),
 			)
@@ -294,6 +380,84 @@ func TestIsDueSuccess(t *testing.T) {
 	}
 }
 
+func TestIsDueAtSuccess(t *testing.T) {
+	remindTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+	acknowledgedAt := remindTime.Add(-time.Hour)
+
+	tests := []struct {
+		name           string
+		at             time.Time
+		paused         bool
+		acknowledgedAt *time.Time
+		expected       bool
+	}{
+		{
+			name:     "remind time is due",
+			at:       remindTime,
+			expected: true,
+		},
+		{
+			name:     "window start is due",
+			at:       remindTime.Add(-5 * time.Minute),
+			expected: true,
+		},
+		{
+			name:     "window end is due",
+			at:       remindTime.Add(5 * time.Minute),
+			expected: true,
+		},
+		{
+			name:     "before the window is not due",
+			at:       remindTime.Add(-5*time.Minute - time.Second),
+			expected: false,
+		},
+		{
+			name:     "after the window is not due",
+			at:       remindTime.Add(5*time.Minute + time.Second),
+			expected: false,
+		},
+		{
+			name:     "paused remind is not due",
+			at:       remindTime,
+			paused:   true,
+			expected: false,
+		},
+		{
+			name:           "acknowledged remind is not due",
+			at:             remindTime,
+			acknowledgedAt: &acknowledgedAt,
+			expected:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remind := domain.Reconstitute(
+				domain.NewRemindID(),
+				remindTime,
+				createValidUserID(t),
+				createValidDevices(t, 1),
+				createValidTaskID(t),
+				domain.TypeNear,
+				false,
+				domain.MustSlideWindowWidth(5*time.Minute),
+				tt.acknowledgedAt,
+				domain.EscalationPolicy{},
+				0,
+				tt.paused,
+				domain.Payload{},
+				time.Now(),
+				time.Now(),
+			)
+
+			window := remind.SlideWindow()
+			assert.True(t, remindTime.Add(-5*time.Minute).Equal(window.Start))
+			assert.True(t, remindTime.Add(5*time.Minute).Equal(window.End))
+			assert.Equal(t, tt.expected, remind.IsDueAt(tt.at))
+		})
+	}
+}
+
 func TestReconstituteSuccess(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -329,6 +493,11 @@ func TestReconstituteSuccess(t *testing.T) {
 				taskType,
 				tt.throttled,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				createdAt,
 				updatedAt,
 			)
@@ -376,6 +545,11 @@ func TestReconstituteWithPastTimeSuccess(t *testing.T) {
 				domain.TypeNear,
 				false,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				time.Now(),
 				time.Now(),
 			)
@@ -415,6 +589,11 @@ func TestRemindGettersSuccess(t *testing.T) {
 				taskType,
 				true,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				createdAt,
 				updatedAt,
 			)
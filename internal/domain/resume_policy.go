@@ -0,0 +1,29 @@
+package domain
+
+// ResumePolicy decides what happens to paused reminds whose time passed
+// while the user was paused.
+type ResumePolicy string
+
+const (
+	// ResumeSkipPast deletes the reminds whose time has passed.
+	ResumeSkipPast ResumePolicy = "skip_past"
+	// ResumeRestoreAll restores every remind, leaving past ones for catch-up handling.
+	ResumeRestoreAll ResumePolicy = "restore_all"
+)
+
+// NewResumePolicy parses a policy name; an empty name selects ResumeSkipPast.
+func NewResumePolicy(s string) (ResumePolicy, error) {
+	switch policy := ResumePolicy(s); policy {
+	case "":
+		return ResumeSkipPast, nil
+	case ResumeSkipPast, ResumeRestoreAll:
+		return policy, nil
+	default:
+		return "", ErrInvalidResumePolicy
+	}
+}
+
+// SkipsPast reports whether reminds whose time passed during the pause are dropped.
+func (p ResumePolicy) SkipsPast() bool {
+	return p == ResumeSkipPast
+}
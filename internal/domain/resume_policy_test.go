@@ -0,0 +1,54 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewResumePolicySuccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		expected  domain.ResumePolicy
+		skipsPast bool
+	}{
+		{
+			name:      "empty defaults to skip_past",
+			input:     "",
+			expected:  domain.ResumeSkipPast,
+			skipsPast: true,
+		},
+		{
+			name:      "skip_past",
+			input:     "skip_past",
+			expected:  domain.ResumeSkipPast,
+			skipsPast: true,
+		},
+		{
+			name:      "restore_all",
+			input:     "restore_all",
+			expected:  domain.ResumeRestoreAll,
+			skipsPast: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy, err := domain.NewResumePolicy(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, policy)
+			assert.Equal(t, tt.skipsPast, policy.SkipsPast())
+		})
+	}
+}
+
+func TestNewResumePolicyError(t *testing.T) {
+	_, err := domain.NewResumePolicy("restore_some")
+
+	assert.ErrorIs(t, err, domain.ErrInvalidResumePolicy)
+}
@@ -0,0 +1,92 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// minScheduleLead keeps generated reminds other than the deadline itself from
+// firing right after the task is created.
+const minScheduleLead = time.Minute
+
+var ErrDeadlineNotInFuture = errors.New("deadline must be in the future")
+
+// ScheduledTime is one generated remind time with its slide window width.
+type ScheduledTime struct {
+	Time  time.Time
+	Width SlideWindowWidth
+}
+
+// ScheduleGenerator recommends remind times leading up to a task deadline so
+// every client nags the same way.
+type ScheduleGenerator struct {
+	offsets    map[Type][]time.Duration
+	calculator *SlideWindowWidthCalculator
+}
+
+// NewScheduleGenerator returns the registered schedules, with widths from
+// calculator, given as offsets before the deadline:
+//   - short: 2h, 1h, 30m, 15m, 5m
+//   - near: 6h, 3h, 1h, 15m
+//   - relaxed: 3d, 1d, 6h
+//   - scheduled: 1d, 1h, 10m
+//
+// The deadline itself is always the last remind.
+func NewScheduleGenerator(calculator *SlideWindowWidthCalculator) *ScheduleGenerator {
+	defs := taskTypes.Definitions()
+
+	offsets := make(map[Type][]time.Duration, len(defs))
+	for _, def := range defs {
+		offsets[def.Type] = def.Schedule
+	}
+
+	return &ScheduleGenerator{
+		offsets:    offsets,
+		calculator: calculator,
+	}
+}
+
+// Times returns the recommended remind times in ascending order, ending with
+// the deadline. Offsets that would fire before now, or within a minute of it,
+// are dropped.
+func (g *ScheduleGenerator) Times(deadline, now time.Time, taskType Type) ([]time.Time, error) {
+	if !deadline.After(now) {
+		return nil, fmt.Errorf("%w: %s", ErrDeadlineNotInFuture, deadline.Format(time.RFC3339))
+	}
+
+	offsets := g.offsets[taskType]
+
+	times := make([]time.Time, 0, len(offsets)+1)
+	for _, offset := range offsets {
+		t := deadline.Add(-offset)
+		if t.Sub(now) < minScheduleLead {
+			continue
+		}
+
+		times = append(times, t)
+	}
+
+	return append(times, deadline), nil
+}
+
+// Schedule returns the recommended times together with the slide window
+// widths the calculator assigns them.
+func (g *ScheduleGenerator) Schedule(deadline, now time.Time, taskType Type) ([]ScheduledTime, error) {
+	times, err := g.Times(deadline, now, taskType)
+	if err != nil {
+		return nil, err
+	}
+
+	widths := g.calculator.CalculateSlideWindowWidths(times, taskType)
+
+	schedule := make([]ScheduledTime, 0, len(times))
+	for _, t := range times {
+		schedule = append(schedule, ScheduledTime{
+			Time:  t,
+			Width: widths[t],
+		})
+	}
+
+	return schedule, nil
+}
@@ -0,0 +1,89 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestScheduleGeneratorTimesSuccess(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		deadline time.Time
+		taskType domain.Type
+		expected []time.Duration // offsets before the deadline
+	}{
+		{
+			name:     "short with room for every remind",
+			deadline: now.Add(3 * time.Hour),
+			taskType: domain.TypeShort,
+			expected: []time.Duration{2 * time.Hour, time.Hour, 30 * time.Minute, 15 * time.Minute, 5 * time.Minute, 0},
+		},
+		{
+			name:     "relaxed is sparser",
+			deadline: now.Add(96 * time.Hour),
+			taskType: domain.TypeRelaxed,
+			expected: []time.Duration{72 * time.Hour, 24 * time.Hour, 6 * time.Hour, 0},
+		},
+		{
+			name:     "past offsets are dropped",
+			deadline: now.Add(2 * time.Hour),
+			taskType: domain.TypeNear,
+			expected: []time.Duration{time.Hour, 15 * time.Minute, 0},
+		},
+		{
+			name:     "offset within the minimum lead is dropped",
+			deadline: now.Add(10*time.Minute + 30*time.Second),
+			taskType: domain.TypeScheduled,
+			expected: []time.Duration{0},
+		},
+	}
+
+	generator := domain.NewScheduleGenerator(domain.NewSlideWindowWidthCalculator())
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := generator.Times(tt.deadline, now, tt.taskType)
+			require.NoError(t, err)
+
+			expected := make([]time.Time, 0, len(tt.expected))
+			for _, offset := range tt.expected {
+				expected = append(expected, tt.deadline.Add(-offset))
+			}
+
+			assert.Equal(t, expected, times)
+		})
+	}
+}
+
+func TestScheduleGeneratorTimesError(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	generator := domain.NewScheduleGenerator(domain.NewSlideWindowWidthCalculator())
+
+	for _, deadline := range []time.Time{now, now.Add(-time.Minute)} {
+		_, err := generator.Times(deadline, now, domain.TypeShort)
+
+		assert.ErrorIs(t, err, domain.ErrDeadlineNotInFuture)
+	}
+}
+
+func TestScheduleGeneratorScheduleSuccess(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	deadline := now.Add(20 * time.Minute)
+
+	schedule, err := domain.NewScheduleGenerator(domain.NewSlideWindowWidthCalculator()).Schedule(deadline, now, domain.TypeShort)
+	require.NoError(t, err)
+
+	require.Len(t, schedule, 3)
+	assert.Equal(t, deadline.Add(-15*time.Minute), schedule[0].Time)
+	assert.Equal(t, 180*time.Second, schedule[0].Width.Duration()) // 30% of 10m
+	assert.Equal(t, 90*time.Second, schedule[1].Width.Duration())  // 30% of 5m
+	assert.Equal(t, deadline, schedule[2].Time)
+	assert.Equal(t, domain.GetTargetAtWindowWidth(domain.TypeShort), schedule[2].Width)
+}
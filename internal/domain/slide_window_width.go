@@ -11,19 +11,20 @@ type SlideWindowWidth struct {
 
 const (
 	MinSlideWindowWidth = 1 * time.Minute
-	MaxSlideWindowWidth = 10 * time.Minute
+	MaxSlideWindowWidth = 30 * time.Minute
 
 	WindowWidthShort = 2 * time.Minute
 	WindowWidthBase  = 5 * time.Minute
 
 	IntermediateMaxWidthShort = 5 * time.Minute
+	IntermediateMaxWidthBase  = 10 * time.Minute
 
 	IntermediateIntervalRatio = 0.30
 )
 
 var (
 	ErrSlideWindowWidthTooSmall = errors.New("slide window width must be at least 1 minute")
-	ErrSlideWindowWidthTooLarge = errors.New("slide window width must not exceed 10 minutes")
+	ErrSlideWindowWidthTooLarge = errors.New("slide window width must not exceed 30 minutes")
 )
 
 func NewSlideWindowWidth(d time.Duration) (SlideWindowWidth, error) {
@@ -22,7 +22,12 @@ func TestNewSlideWindowWidthSuccess(t *testing.T) {
 			expectedSeconds: 60,
 		},
 		{
-			name:            "maximum valid width (10 minutes)",
+			name:            "maximum valid width (30 minutes)",
+			duration:        30 * time.Minute,
+			expectedSeconds: 1800,
+		},
+		{
+			name:            "intermediate default maximum (10 minutes)",
 			duration:        10 * time.Minute,
 			expectedSeconds: 600,
 		},
@@ -72,13 +77,13 @@ func TestNewSlideWindowWidthError(t *testing.T) {
 			expectedErr: domain.ErrSlideWindowWidthTooSmall,
 		},
 		{
-			name:        "too large (11 minutes)",
-			duration:    11 * time.Minute,
+			name:        "too large (31 minutes)",
+			duration:    31 * time.Minute,
 			expectedErr: domain.ErrSlideWindowWidthTooLarge,
 		},
 		{
-			name:        "way too large (30 minutes)",
-			duration:    30 * time.Minute,
+			name:        "way too large (1 hour)",
+			duration:    1 * time.Hour,
 			expectedErr: domain.ErrSlideWindowWidthTooLarge,
 		},
 	}
@@ -104,9 +109,9 @@ func TestSlideWindowWidthFromSecondsSuccess(t *testing.T) {
 			expectedDuration: 1 * time.Minute,
 		},
 		{
-			name:             "maximum (600 seconds)",
-			seconds:          600,
-			expectedDuration: 10 * time.Minute,
+			name:             "maximum (1800 seconds)",
+			seconds:          1800,
+			expectedDuration: 30 * time.Minute,
 		},
 		{
 			name:             "medium (300 seconds)",
@@ -140,8 +145,8 @@ func TestSlideWindowWidthFromSecondsError(t *testing.T) {
 			seconds: 0,
 		},
 		{
-			name:    "too large (601 seconds)",
-			seconds: 601,
+			name:    "too large (1801 seconds)",
+			seconds: 1801,
 		},
 	}
 
@@ -165,7 +170,7 @@ func TestMustSlideWindowWidthSuccess(t *testing.T) {
 		},
 		{
 			name:     "valid maximum",
-			duration: 10 * time.Minute,
+			duration: 30 * time.Minute,
 		},
 	}
 
@@ -190,7 +195,7 @@ func TestMustSlideWindowWidthPanic(t *testing.T) {
 		},
 		{
 			name:     "too large",
-			duration: 11 * time.Minute,
+			duration: 31 * time.Minute,
 		},
 	}
 
@@ -11,11 +11,11 @@ const (
 	TypeScheduled Type = "scheduled"
 )
 
+// NewType accepts the built-in types and those registered with TaskTypes.
 func NewType(t string) (Type, error) {
-	switch t {
-	case string(TypeShort), string(TypeNear), string(TypeRelaxed), string(TypeScheduled):
-		return Type(t), nil
-	default:
+	if _, ok := taskTypes.Lookup(Type(t)); !ok {
 		return "", fmt.Errorf("%w: %s", ErrInvalidTaskType, t)
 	}
+
+	return Type(t), nil
 }
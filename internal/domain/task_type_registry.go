@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+)
+
+var ErrInvalidTaskTypeDefinition = errors.New("invalid task type definition")
+
+var taskTypeNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// TaskTypeDefinition is everything that varies by task type: its value in
+// the common.v1.TaskType enum and the defaults of every per-type policy.
+type TaskTypeDefinition struct {
+	Type        Type
+	EnumValue   int32
+	Window      WindowParams
+	Escalation  EscalationPolicy
+	Schedule    []time.Duration // automatic schedule offsets before the deadline
+	QuietHours  QuietHoursAction
+	Acknowledge AcknowledgeAction
+}
+
+// TaskTypeRegistry holds the known task types. NewType accepts exactly the
+// types registered in the process-wide registry returned by TaskTypes.
+type TaskTypeRegistry struct {
+	mu          sync.RWMutex
+	definitions map[Type]TaskTypeDefinition
+}
+
+// NewTaskTypeRegistry returns a registry holding the built-in task types:
+//   - short: 2m target width, reminds 2h, 1h, 30m, 15m and 5m ahead, dropped
+//     in quiet hours, escalated after 5m by narrowing then following up
+//   - near: 5m target width, reminds 6h, 3h, 1h and 15m ahead, shifted out of
+//     quiet hours, escalated after 15m by narrowing, adding devices and
+//     following up
+//   - relaxed: 5m target width, reminds 3d, 1d and 6h ahead, shifted out of
+//     quiet hours, followed up after 30m
+//   - scheduled: 2m target width, reminds 1d, 1h and 10m ahead, kept in quiet
+//     hours, never escalated, and later reminds survive an acknowledgment
+func NewTaskTypeRegistry() *TaskTypeRegistry {
+	builtins := []TaskTypeDefinition{
+		{
+			Type:      TypeShort,
+			EnumValue: 1,
+			Window:    builtinWindowParams(WindowWidthShort, IntermediateMaxWidthShort),
+			Escalation: EscalationPolicy{
+				after: 5 * time.Minute,
+				steps: []EscalationAction{EscalationNarrowWindow, EscalationFollowUp},
+			},
+			Schedule:    []time.Duration{2 * time.Hour, time.Hour, 30 * time.Minute, 15 * time.Minute, 5 * time.Minute},
+			QuietHours:  QuietHoursDrop,
+			Acknowledge: AcknowledgeCancelRemaining,
+		},
+		{
+			Type:      TypeNear,
+			EnumValue: 2,
+			Window:    builtinWindowParams(WindowWidthBase, IntermediateMaxWidthBase),
+			Escalation: EscalationPolicy{
+				after: 15 * time.Minute,
+				steps: []EscalationAction{EscalationNarrowWindow, EscalationAddDevices, EscalationFollowUp},
+			},
+			Schedule:    []time.Duration{6 * time.Hour, 3 * time.Hour, time.Hour, 15 * time.Minute},
+			QuietHours:  QuietHoursShift,
+			Acknowledge: AcknowledgeCancelRemaining,
+		},
+		{
+			Type:      TypeRelaxed,
+			EnumValue: 3,
+			Window:    builtinWindowParams(WindowWidthBase, IntermediateMaxWidthBase),
+			Escalation: EscalationPolicy{
+				after: 30 * time.Minute,
+				steps: []EscalationAction{EscalationFollowUp},
+			},
+			Schedule:    []time.Duration{72 * time.Hour, 24 * time.Hour, 6 * time.Hour},
+			QuietHours:  QuietHoursShift,
+			Acknowledge: AcknowledgeCancelRemaining,
+		},
+		{
+			Type:        TypeScheduled,
+			EnumValue:   4,
+			Window:      builtinWindowParams(WindowWidthShort, IntermediateMaxWidthBase),
+			Schedule:    []time.Duration{24 * time.Hour, time.Hour, 10 * time.Minute},
+			QuietHours:  QuietHoursKeep,
+			Acknowledge: AcknowledgeKeepRemaining,
+		},
+	}
+
+	r := &TaskTypeRegistry{
+		definitions: make(map[Type]TaskTypeDefinition, len(builtins)),
+	}
+
+	for _, def := range builtins {
+		r.definitions[def.Type] = def
+	}
+
+	return r
+}
+
+func builtinWindowParams(targetWidth, intermediateMax time.Duration) WindowParams {
+	return WindowParams{
+		targetWidth:       targetWidth,
+		intermediateRatio: IntermediateIntervalRatio,
+		intermediateMin:   MinSlideWindowWidth,
+		intermediateMax:   intermediateMax,
+	}
+}
+
+// Register adds a task type. Names are lowercase identifiers; neither the
+// name nor the enum value may be taken. Schedule offsets are sorted from the
+// largest (earliest remind) to the smallest. An empty QuietHours keeps reminds in
+// quiet hours and an empty Acknowledge cancels the remaining reminds.
+func (r *TaskTypeRegistry) Register(def TaskTypeDefinition) error {
+	if !taskTypeNamePattern.MatchString(string(def.Type)) {
+		return fmt.Errorf("%w: name must be a lowercase identifier, got %q", ErrInvalidTaskTypeDefinition, def.Type)
+	}
+
+	if def.EnumValue <= 0 {
+		return fmt.Errorf("%w: enum value of %s must be positive, got %d",
+			ErrInvalidTaskTypeDefinition, def.Type, def.EnumValue)
+	}
+
+	if def.Window == (WindowParams{}) {
+		return fmt.Errorf("%w: window params of %s are required", ErrInvalidTaskTypeDefinition, def.Type)
+	}
+
+	schedule := slices.Clone(def.Schedule)
+	slices.SortFunc(schedule, func(a, b time.Duration) int {
+		return cmp.Compare(b, a)
+	})
+
+	for i, offset := range schedule {
+		if offset <= 0 {
+			return fmt.Errorf("%w: schedule offsets of %s must be positive, got %s",
+				ErrInvalidTaskTypeDefinition, def.Type, offset)
+		}
+
+		if i > 0 && schedule[i-1] == offset {
+			return fmt.Errorf("%w: %s has duplicate schedule offset %s",
+				ErrInvalidTaskTypeDefinition, def.Type, offset)
+		}
+	}
+
+	switch def.QuietHours {
+	case "":
+		def.QuietHours = QuietHoursKeep
+	case QuietHoursKeep, QuietHoursShift, QuietHoursDrop:
+	default:
+		return fmt.Errorf("%w: unknown quiet hours action %q", ErrInvalidTaskTypeDefinition, def.QuietHours)
+	}
+
+	switch def.Acknowledge {
+	case "":
+		def.Acknowledge = AcknowledgeCancelRemaining
+	case AcknowledgeCancelRemaining, AcknowledgeKeepRemaining:
+	default:
+		return fmt.Errorf("%w: unknown acknowledge action %q", ErrInvalidTaskTypeDefinition, def.Acknowledge)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.definitions[def.Type]; ok {
+		return fmt.Errorf("%w: %s is already registered", ErrInvalidTaskTypeDefinition, def.Type)
+	}
+
+	for _, existing := range r.definitions {
+		if existing.EnumValue == def.EnumValue {
+			return fmt.Errorf("%w: enum value %d is already used by %s",
+				ErrInvalidTaskTypeDefinition, def.EnumValue, existing.Type)
+		}
+	}
+
+	def.Schedule = schedule
+	r.definitions[def.Type] = def
+
+	return nil
+}
+
+func (r *TaskTypeRegistry) Lookup(taskType Type) (TaskTypeDefinition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	def, ok := r.definitions[taskType]
+
+	return def, ok
+}
+
+func (r *TaskTypeRegistry) LookupEnumValue(enumValue int32) (TaskTypeDefinition, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, def := range r.definitions {
+		if def.EnumValue == enumValue {
+			return def, true
+		}
+	}
+
+	return TaskTypeDefinition{}, false
+}
+
+// Definitions returns every registered task type ordered by enum value.
+func (r *TaskTypeRegistry) Definitions() []TaskTypeDefinition {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	defs := make([]TaskTypeDefinition, 0, len(r.definitions))
+	for _, def := range r.definitions {
+		defs = append(defs, def)
+	}
+
+	slices.SortFunc(defs, func(a, b TaskTypeDefinition) int {
+		return int(a.EnumValue - b.EnumValue)
+	})
+
+	return defs
+}
+
+// window returns the task type's window params, or those of near for an
+// unknown type.
+func (r *TaskTypeRegistry) window(taskType Type) WindowParams {
+	if def, ok := r.Lookup(taskType); ok {
+		return def.Window
+	}
+
+	return builtinWindowParams(WindowWidthBase, IntermediateMaxWidthBase)
+}
+
+var taskTypes = NewTaskTypeRegistry()
+
+// TaskTypes returns the process-wide registry. Types are registered at
+// startup, before the policies reading it are built.
+func TaskTypes() *TaskTypeRegistry {
+	return taskTypes
+}
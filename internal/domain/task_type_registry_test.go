@@ -0,0 +1,156 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func createValidTaskTypeDefinition(t *testing.T, name string, enumValue int32) domain.TaskTypeDefinition {
+	t.Helper()
+
+	window, err := domain.NewWindowParams(3*time.Minute, 0.5, time.Minute, 4*time.Minute)
+	require.NoError(t, err)
+
+	escalation, err := domain.NewEscalationPolicy(2*time.Minute, []domain.EscalationAction{domain.EscalationFollowUp})
+	require.NoError(t, err)
+
+	return domain.TaskTypeDefinition{
+		Type:       domain.Type(name),
+		EnumValue:  enumValue,
+		Window:     window,
+		Escalation: escalation,
+		Schedule:   []time.Duration{10 * time.Minute},
+	}
+}
+
+func TestTaskTypeRegistryBuiltinsSuccess(t *testing.T) {
+	registry := domain.NewTaskTypeRegistry()
+
+	defs := registry.Definitions()
+	require.Len(t, defs, 4)
+
+	expected := []domain.Type{domain.TypeShort, domain.TypeNear, domain.TypeRelaxed, domain.TypeScheduled}
+	for i, def := range defs {
+		assert.Equal(t, expected[i], def.Type)
+		assert.Equal(t, int32(i+1), def.EnumValue)
+	}
+
+	scheduled, ok := registry.LookupEnumValue(4)
+	require.True(t, ok)
+	assert.Equal(t, domain.TypeScheduled, scheduled.Type)
+	assert.Equal(t, domain.AcknowledgeKeepRemaining, scheduled.Acknowledge)
+	assert.True(t, scheduled.Escalation.IsZero())
+}
+
+func TestTaskTypeRegistryRegisterSuccess(t *testing.T) {
+	registry := domain.NewTaskTypeRegistry()
+
+	urgent := createValidTaskTypeDefinition(t, "urgent", 5)
+	urgent.Schedule = []time.Duration{10 * time.Minute, time.Hour, 30 * time.Minute}
+	require.NoError(t, registry.Register(urgent))
+
+	def, ok := registry.Lookup("urgent")
+	require.True(t, ok)
+	assert.Equal(t, int32(5), def.EnumValue)
+	assert.Equal(t, []time.Duration{time.Hour, 30 * time.Minute, 10 * time.Minute}, def.Schedule)
+	assert.Equal(t, domain.QuietHoursKeep, def.QuietHours)
+	assert.Equal(t, domain.AcknowledgeCancelRemaining, def.Acknowledge)
+
+	byEnum, ok := registry.LookupEnumValue(5)
+	require.True(t, ok)
+	assert.Equal(t, domain.Type("urgent"), byEnum.Type)
+
+	assert.Len(t, registry.Definitions(), 5)
+
+	_, ok = registry.LookupEnumValue(6)
+	assert.False(t, ok)
+}
+
+func TestTaskTypeRegistryRegisterError(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(def *domain.TaskTypeDefinition)
+	}{
+		{
+			name:   "uppercase name",
+			modify: func(def *domain.TaskTypeDefinition) { def.Type = "Urgent" },
+		},
+		{
+			name:   "empty name",
+			modify: func(def *domain.TaskTypeDefinition) { def.Type = "" },
+		},
+		{
+			name:   "builtin name",
+			modify: func(def *domain.TaskTypeDefinition) { def.Type = domain.TypeNear },
+		},
+		{
+			name:   "zero enum value",
+			modify: func(def *domain.TaskTypeDefinition) { def.EnumValue = 0 },
+		},
+		{
+			name:   "enum value of a builtin",
+			modify: func(def *domain.TaskTypeDefinition) { def.EnumValue = 2 },
+		},
+		{
+			name:   "missing window params",
+			modify: func(def *domain.TaskTypeDefinition) { def.Window = domain.WindowParams{} },
+		},
+		{
+			name:   "negative schedule offset",
+			modify: func(def *domain.TaskTypeDefinition) { def.Schedule = []time.Duration{-time.Minute} },
+		},
+		{
+			name: "duplicate schedule offset",
+			modify: func(def *domain.TaskTypeDefinition) {
+				def.Schedule = []time.Duration{time.Hour, 10 * time.Minute, time.Hour}
+			},
+		},
+		{
+			name:   "unknown quiet hours action",
+			modify: func(def *domain.TaskTypeDefinition) { def.QuietHours = "snooze" },
+		},
+		{
+			name:   "unknown acknowledge action",
+			modify: func(def *domain.TaskTypeDefinition) { def.Acknowledge = "archive" },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := domain.NewTaskTypeRegistry()
+			def := createValidTaskTypeDefinition(t, "urgent", 5)
+			tt.modify(&def)
+
+			err := registry.Register(def)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidTaskTypeDefinition)
+		})
+	}
+}
+
+func TestRegisteredTaskTypeDefaultsSuccess(t *testing.T) {
+	// The process-wide registry outlives the test, so register only once.
+	if _, ok := domain.TaskTypes().Lookup("habit"); !ok {
+		require.NoError(t, domain.TaskTypes().Register(createValidTaskTypeDefinition(t, "habit", 106)))
+	}
+
+	habit, err := domain.NewType("habit")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3*time.Minute, domain.GetTargetAtWindowWidth(habit).Duration())
+	assert.Equal(t, 4*time.Minute, domain.GetIntermediateWindowWidth(habit, time.Hour).Duration())
+	assert.Equal(t, 4*time.Minute, domain.NewDefaultWindowPolicy().MaxWidth(habit).Duration())
+	assert.Equal(t, 1, domain.DefaultEscalationPolicy(habit).MaxLevel())
+	assert.Equal(t, domain.QuietHoursKeep, domain.NewQuietHoursPolicy().Action(habit))
+	assert.True(t, domain.NewAcknowledgePolicy().CancelsRemaining(habit))
+
+	deadline := time.Now().Add(time.Hour)
+	times, err := domain.NewScheduleGenerator(domain.NewSlideWindowWidthCalculator()).Times(deadline, time.Now(), habit)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Time{deadline.Add(-10 * time.Minute), deadline}, times)
+}
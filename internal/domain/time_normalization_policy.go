@@ -0,0 +1,191 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	ErrInvalidTimeNormalization = errors.New("invalid time normalization policy")
+
+	ErrTooManyRemindTimes      = errors.New("too many remind times")
+	ErrRemindTimeBeyondHorizon = errors.New("remind time is too far in the future")
+	ErrRemindTimesTooClose     = errors.New("remind times are too close together")
+)
+
+const (
+	DefaultTimeTruncation = time.Second
+	DefaultMaxRemindTimes = 20
+	DefaultRemindHorizon  = 365 * 24 * time.Hour
+	DefaultMinSpacing     = time.Minute
+)
+
+// RemindTimeError reports which requested time broke a normalization rule.
+type RemindTimeError struct {
+	Index int // position in the requested times
+	Err   error
+}
+
+func (e *RemindTimeError) Error() string {
+	return fmt.Sprintf("times[%d]: %v", e.Index, e.Err)
+}
+
+func (e *RemindTimeError) Unwrap() error {
+	return e.Err
+}
+
+// TimeNormalizationPolicy cleans up requested remind times before anything
+// else looks at them. Times that differ only below the truncation collapse
+// into one remind, so they never collide on the (time, task_id) index.
+type TimeNormalizationPolicy struct {
+	truncation time.Duration
+	maxTimes   int
+	horizon    time.Duration
+	minSpacing map[Type]time.Duration
+}
+
+// NewTimeNormalizationPolicy returns a policy truncating times to truncation
+// (zero keeps them exact), allowing at most maxTimes distinct times no
+// further than horizon ahead, spaced at least minSpacing apart per task type.
+// Task types missing from minSpacing are not spaced.
+func NewTimeNormalizationPolicy(
+	truncation time.Duration,
+	maxTimes int,
+	horizon time.Duration,
+	minSpacing map[Type]time.Duration,
+) (*TimeNormalizationPolicy, error) {
+	if truncation < 0 {
+		return nil, fmt.Errorf("%w: truncation must not be negative, got %s", ErrInvalidTimeNormalization, truncation)
+	}
+
+	if maxTimes <= 0 {
+		return nil, fmt.Errorf("%w: max times must be positive, got %d", ErrInvalidTimeNormalization, maxTimes)
+	}
+
+	if horizon <= 0 {
+		return nil, fmt.Errorf("%w: horizon must be positive, got %s", ErrInvalidTimeNormalization, horizon)
+	}
+
+	for taskType, spacing := range minSpacing {
+		if spacing < 0 {
+			return nil, fmt.Errorf("%w: min spacing for %s must not be negative, got %s",
+				ErrInvalidTimeNormalization, taskType, spacing)
+		}
+	}
+
+	return &TimeNormalizationPolicy{
+		truncation: truncation,
+		maxTimes:   maxTimes,
+		horizon:    horizon,
+		minSpacing: minSpacing,
+	}, nil
+}
+
+// NewDefaultTimeNormalizationPolicy truncates to whole seconds and allows 20
+// times up to a year ahead, one minute apart for every registered task type.
+func NewDefaultTimeNormalizationPolicy() *TimeNormalizationPolicy {
+	defs := taskTypes.Definitions()
+
+	minSpacing := make(map[Type]time.Duration, len(defs))
+	for _, def := range defs {
+		minSpacing[def.Type] = DefaultMinSpacing
+	}
+
+	return &TimeNormalizationPolicy{
+		truncation: DefaultTimeTruncation,
+		maxTimes:   DefaultMaxRemindTimes,
+		horizon:    DefaultRemindHorizon,
+		minSpacing: minSpacing,
+	}
+}
+
+// NormalizeTime truncates t and converts it to UTC.
+func (p *TimeNormalizationPolicy) NormalizeTime(t time.Time) time.Time {
+	return t.Truncate(p.truncation).UTC()
+}
+
+// MinSpacing returns how far apart the task type's times must be.
+func (p *TimeNormalizationPolicy) MinSpacing(taskType Type) time.Duration {
+	return p.minSpacing[taskType]
+}
+
+// Space re-checks the minimum spacing of sorted times after policies such as
+// quiet hours moved them. The last (TargetAt) time is kept; walking back from
+// it, a time closer than the spacing to the next kept one is dropped.
+func (p *TimeNormalizationPolicy) Space(times []time.Time, taskType Type) (kept, dropped []time.Time) {
+	if len(times) == 0 {
+		return times, nil
+	}
+
+	spacing := p.minSpacing[taskType]
+	kept = make([]time.Time, 0, len(times))
+	kept = append(kept, times[len(times)-1])
+
+	for i := len(times) - 2; i >= 0; i-- {
+		if kept[len(kept)-1].Sub(times[i]) < spacing {
+			dropped = append(dropped, times[i])
+
+			continue
+		}
+
+		kept = append(kept, times[i])
+	}
+
+	slices.Reverse(kept)
+	slices.Reverse(dropped)
+
+	return kept, dropped
+}
+
+// Normalize returns the requested times normalized, sorted and without
+// duplicates. A time beyond the horizon or too close to an earlier one is
+// reported as a *RemindTimeError; too many times as ErrTooManyRemindTimes.
+func (p *TimeNormalizationPolicy) Normalize(times []time.Time, taskType Type, now time.Time) ([]time.Time, error) {
+	type indexed struct {
+		time  time.Time
+		index int
+	}
+
+	limit := now.Add(p.horizon)
+	normalized := make([]indexed, 0, len(times))
+
+	for i, t := range times {
+		n := p.NormalizeTime(t)
+		if n.After(limit) {
+			return nil, &RemindTimeError{Index: i, Err: ErrRemindTimeBeyondHorizon}
+		}
+
+		normalized = append(normalized, indexed{time: n, index: i})
+	}
+
+	slices.SortStableFunc(normalized, func(a, b indexed) int {
+		return a.time.Compare(b.time)
+	})
+
+	normalized = slices.CompactFunc(normalized, func(a, b indexed) bool {
+		return a.time.Equal(b.time)
+	})
+
+	if len(normalized) > p.maxTimes {
+		return nil, fmt.Errorf("%w: at most %d, got %d", ErrTooManyRemindTimes, p.maxTimes, len(normalized))
+	}
+
+	spacing := p.minSpacing[taskType]
+	result := make([]time.Time, 0, len(normalized))
+
+	for i, n := range normalized {
+		if i > 0 && n.time.Sub(normalized[i-1].time) < spacing {
+			// Report the later of the two in request order.
+			return nil, &RemindTimeError{
+				Index: max(n.index, normalized[i-1].index),
+				Err:   fmt.Errorf("%w: at least %s apart", ErrRemindTimesTooClose, spacing),
+			}
+		}
+
+		result = append(result, n.time)
+	}
+
+	return result, nil
+}
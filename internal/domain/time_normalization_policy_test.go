@@ -0,0 +1,194 @@
+package domain_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewTimeNormalizationPolicyError(t *testing.T) {
+	tests := []struct {
+		name       string
+		truncation time.Duration
+		maxTimes   int
+		horizon    time.Duration
+		minSpacing map[domain.Type]time.Duration
+	}{
+		{name: "negative truncation", truncation: -time.Second, maxTimes: 10, horizon: time.Hour},
+		{name: "zero max times", truncation: time.Second, maxTimes: 0, horizon: time.Hour},
+		{name: "zero horizon", truncation: time.Second, maxTimes: 10, horizon: 0},
+		{
+			name:       "negative spacing",
+			truncation: time.Second,
+			maxTimes:   10,
+			horizon:    time.Hour,
+			minSpacing: map[domain.Type]time.Duration{domain.TypeNear: -time.Minute},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewTimeNormalizationPolicy(tt.truncation, tt.maxTimes, tt.horizon, tt.minSpacing)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidTimeNormalization)
+		})
+	}
+}
+
+func TestTimeNormalizationPolicyNormalizeSuccess(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	tokyo := time.FixedZone("JST", 9*60*60)
+	at := now.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		taskType domain.Type
+		times    []time.Time
+		expected []time.Time
+	}{
+		{
+			name:     "truncated to seconds in UTC",
+			taskType: domain.TypeNear,
+			times:    []time.Time{at.Add(300 * time.Millisecond).In(tokyo)},
+			expected: []time.Time{at},
+		},
+		{
+			name:     "sub-second duplicates collapse",
+			taskType: domain.TypeNear,
+			times:    []time.Time{at.Add(2 * time.Hour), at.Add(100 * time.Millisecond), at},
+			expected: []time.Time{at, at.Add(2 * time.Hour)},
+		},
+		{
+			name:     "types without spacing keep close times",
+			taskType: domain.TypeScheduled,
+			times:    []time.Time{at, at.Add(time.Second)},
+			expected: []time.Time{at, at.Add(time.Second)},
+		},
+	}
+
+	policy, err := domain.NewTimeNormalizationPolicy(time.Second, 3, 24*time.Hour, map[domain.Type]time.Duration{
+		domain.TypeNear: time.Minute,
+	})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			times, err := policy.Normalize(tt.times, tt.taskType, now)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, times)
+		})
+	}
+}
+
+func TestTimeNormalizationPolicySpaceSuccess(t *testing.T) {
+	at := time.Date(2026, 10, 18, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		taskType        domain.Type
+		times           []time.Time
+		expected        []time.Time
+		expectedDropped []time.Time
+	}{
+		{
+			name:     "spaced times are kept",
+			taskType: domain.TypeNear,
+			times:    []time.Time{at, at.Add(time.Minute), at.Add(2 * time.Minute)},
+			expected: []time.Time{at, at.Add(time.Minute), at.Add(2 * time.Minute)},
+		},
+		{
+			name:            "times too close to the next kept one are dropped",
+			taskType:        domain.TypeNear,
+			times:           []time.Time{at, at.Add(30 * time.Second), at.Add(50 * time.Second), at.Add(2 * time.Minute)},
+			expected:        []time.Time{at.Add(50 * time.Second), at.Add(2 * time.Minute)},
+			expectedDropped: []time.Time{at, at.Add(30 * time.Second)},
+		},
+		{
+			name:            "the TargetAt time is kept",
+			taskType:        domain.TypeNear,
+			times:           []time.Time{at, at.Add(10 * time.Second)},
+			expected:        []time.Time{at.Add(10 * time.Second)},
+			expectedDropped: []time.Time{at},
+		},
+		{
+			name:     "types without spacing keep close times",
+			taskType: domain.TypeScheduled,
+			times:    []time.Time{at, at.Add(time.Second)},
+			expected: []time.Time{at, at.Add(time.Second)},
+		},
+	}
+
+	policy, err := domain.NewTimeNormalizationPolicy(time.Second, 3, 24*time.Hour, map[domain.Type]time.Duration{
+		domain.TypeNear: time.Minute,
+	})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kept, dropped := policy.Space(tt.times, tt.taskType)
+
+			assert.Equal(t, tt.expected, kept)
+			assert.Equal(t, tt.expectedDropped, dropped)
+		})
+	}
+}
+
+func TestTimeNormalizationPolicyNormalizeError(t *testing.T) {
+	now := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	at := now.Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		times         []time.Time
+		expectedErr   error
+		expectedIndex int // -1 when the error is not about one time
+	}{
+		{
+			name:          "too many times",
+			times:         []time.Time{at, at.Add(time.Hour), at.Add(2 * time.Hour), at.Add(3 * time.Hour)},
+			expectedErr:   domain.ErrTooManyRemindTimes,
+			expectedIndex: -1,
+		},
+		{
+			name:          "beyond the horizon",
+			times:         []time.Time{at, now.Add(25 * time.Hour)},
+			expectedErr:   domain.ErrRemindTimeBeyondHorizon,
+			expectedIndex: 1,
+		},
+		{
+			name:          "too close reports the later request index",
+			times:         []time.Time{at.Add(30 * time.Second), at.Add(2 * time.Hour), at},
+			expectedErr:   domain.ErrRemindTimesTooClose,
+			expectedIndex: 2,
+		},
+	}
+
+	policy, err := domain.NewTimeNormalizationPolicy(time.Second, 3, 24*time.Hour, map[domain.Type]time.Duration{
+		domain.TypeNear: time.Minute,
+	})
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.Normalize(tt.times, domain.TypeNear, now)
+
+			require.ErrorIs(t, err, tt.expectedErr)
+
+			var timeErr *domain.RemindTimeError
+			if tt.expectedIndex < 0 {
+				assert.False(t, errors.As(err, &timeErr))
+
+				return
+			}
+
+			require.ErrorAs(t, err, &timeErr)
+			assert.Equal(t, tt.expectedIndex, timeErr.Index)
+		})
+	}
+}
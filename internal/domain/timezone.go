@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+type Timezone struct {
+	location *time.Location
+}
+
+var ErrInvalidTimezone = errors.New("invalid timezone: must be an IANA time zone name")
+
+func NewTimezone(name string) (Timezone, error) {
+	if name == "" {
+		return Timezone{}, ErrInvalidTimezone
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return Timezone{}, ErrInvalidTimezone
+	}
+
+	return Timezone{location: loc}, nil
+}
+
+// UTCTimezone is used when a user has not chosen a timezone.
+func UTCTimezone() Timezone {
+	return Timezone{location: time.UTC}
+}
+
+func (tz Timezone) Location() *time.Location {
+	if tz.location == nil {
+		return time.UTC
+	}
+
+	return tz.location
+}
+
+func (tz Timezone) String() string {
+	return tz.Location().String()
+}
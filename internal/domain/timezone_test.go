@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewTimezoneSuccess(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "IANA zone",
+			input: "Asia/Tokyo",
+		},
+		{
+			name:  "UTC",
+			input: "UTC",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tz, err := domain.NewTimezone(tt.input)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.input, tz.String())
+			assert.Equal(t, tt.input, tz.Location().String())
+		})
+	}
+}
+
+func TestNewTimezoneError(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{
+			name:  "empty string",
+			input: "",
+		},
+		{
+			name:  "unknown zone",
+			input: "Mars/Olympus_Mons",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewTimezone(tt.input)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidTimezone)
+		})
+	}
+}
+
+func TestTimezoneZeroValueSuccess(t *testing.T) {
+	var tz domain.Timezone
+
+	assert.Equal(t, time.UTC, tz.Location())
+	assert.Equal(t, domain.UTCTimezone().String(), tz.String())
+}
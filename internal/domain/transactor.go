@@ -0,0 +1,14 @@
+package domain
+
+import "context"
+
+// TxRepositories are repositories bound to the same transaction.
+type TxRepositories struct {
+	Reminds     RemindRepository
+	Preferences UserPreferencesRepository
+}
+
+// Transactor runs work spanning several repositories in one transaction.
+type Transactor interface {
+	WithTx(ctx context.Context, fn func(repos TxRepositories) error) error
+}
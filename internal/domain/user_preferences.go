@@ -0,0 +1,160 @@
+package domain
+
+import "time"
+
+// UserPreferences holds the per-user settings that shape how reminds are scheduled.
+type UserPreferences struct {
+	userID          UserID
+	defaultDevices  Devices
+	timezone        Timezone
+	quietHours      QuietHours
+	windowOverrides WindowOverrides
+	paused          bool
+	resumeAt        *time.Time
+	createdAt       time.Time
+	updatedAt       time.Time
+}
+
+func NewUserPreferences(
+	userID UserID,
+	defaultDevices Devices,
+	timezone Timezone,
+	quietHours QuietHours,
+	windowOverrides WindowOverrides,
+) *UserPreferences {
+	now := time.Now()
+
+	return &UserPreferences{
+		userID:          userID,
+		defaultDevices:  defaultDevices,
+		timezone:        timezone,
+		quietHours:      quietHours,
+		windowOverrides: windowOverrides,
+		paused:          false,
+		resumeAt:        nil,
+		createdAt:       now,
+		updatedAt:       now,
+	}
+}
+
+func ReconstituteUserPreferences(
+	userID UserID,
+	defaultDevices Devices,
+	timezone Timezone,
+	quietHours QuietHours,
+	windowOverrides WindowOverrides,
+	paused bool,
+	resumeAt *time.Time,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *UserPreferences {
+	return &UserPreferences{
+		userID:          userID,
+		defaultDevices:  defaultDevices,
+		timezone:        timezone,
+		quietHours:      quietHours,
+		windowOverrides: windowOverrides,
+		paused:          paused,
+		resumeAt:        resumeAt,
+		createdAt:       createdAt,
+		updatedAt:       updatedAt,
+	}
+}
+
+// Replace overwrites every setting, keeping the creation time and the pause
+// state, which only Pause and Resume change.
+func (p *UserPreferences) Replace(
+	defaultDevices Devices,
+	timezone Timezone,
+	quietHours QuietHours,
+	windowOverrides WindowOverrides,
+) {
+	p.defaultDevices = defaultDevices
+	p.timezone = timezone
+	p.quietHours = quietHours
+	p.windowOverrides = windowOverrides
+	p.updatedAt = time.Now()
+}
+
+// Pause marks the user as paused. A nil resumeAt keeps the pause until Resume
+// is called; otherwise it must be after now. Pausing again replaces the resume time.
+func (p *UserPreferences) Pause(now time.Time, resumeAt *time.Time) error {
+	if resumeAt != nil && !resumeAt.After(now) {
+		return ErrInvalidResumeAt
+	}
+
+	p.paused = true
+	p.resumeAt = resumeAt
+	p.updatedAt = time.Now()
+
+	return nil
+}
+
+// Resume clears the pause and its resume time.
+func (p *UserPreferences) Resume() {
+	if !p.paused {
+		return
+	}
+
+	p.paused = false
+	p.resumeAt = nil
+	p.updatedAt = time.Now()
+}
+
+// IsResumeDue reports whether the pause has an automatic end that has passed at now.
+func (p *UserPreferences) IsResumeDue(now time.Time) bool {
+	return p.paused && p.resumeAt != nil && !p.resumeAt.After(now)
+}
+
+// QuietUntil reports whether t falls inside the user's quiet hours and, if so,
+// when they end.
+func (p *UserPreferences) QuietUntil(t time.Time) (time.Time, bool) {
+	return p.quietHours.QuietUntil(t, p.timezone.Location())
+}
+
+// HasDefaultDevices reports whether reminds can fall back to stored devices.
+func (p *UserPreferences) HasDefaultDevices() bool {
+	return p.defaultDevices.Count() > 0
+}
+
+// WindowOverride returns the user's override for taskType, or the zero override.
+func (p *UserPreferences) WindowOverride(taskType Type) WindowOverride {
+	return p.windowOverrides.For(taskType)
+}
+
+func (p *UserPreferences) UserID() UserID {
+	return p.userID
+}
+
+func (p *UserPreferences) DefaultDevices() Devices {
+	return p.defaultDevices
+}
+
+func (p *UserPreferences) Timezone() Timezone {
+	return p.timezone
+}
+
+func (p *UserPreferences) QuietHours() QuietHours {
+	return p.quietHours
+}
+
+func (p *UserPreferences) WindowOverrides() WindowOverrides {
+	return p.windowOverrides
+}
+
+func (p *UserPreferences) IsPaused() bool {
+	return p.paused
+}
+
+// ResumeAt returns nil unless the user is paused with an automatic resume.
+func (p *UserPreferences) ResumeAt() *time.Time {
+	return p.resumeAt
+}
+
+func (p *UserPreferences) CreatedAt() time.Time {
+	return p.createdAt
+}
+
+func (p *UserPreferences) UpdatedAt() time.Time {
+	return p.updatedAt
+}
@@ -0,0 +1,20 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+type UserPreferencesRepository interface {
+	// FindByUserID returns ErrUserPreferencesNotFound when the user has no preferences.
+	FindByUserID(ctx context.Context, userID UserID) (*UserPreferences, error)
+	// FindByUserIDForUpdate is FindByUserID locking the row until the
+	// transaction ends; it is used within Transactor.WithTx.
+	FindByUserIDForUpdate(ctx context.Context, userID UserID) (*UserPreferences, error)
+	// Save inserts the preferences or replaces the stored ones.
+	Save(ctx context.Context, prefs *UserPreferences) error
+	// Delete returns ErrUserPreferencesNotFound when the user has no preferences.
+	Delete(ctx context.Context, userID UserID) error
+	// FindResumeDue returns paused preferences whose resume time is at or before now.
+	FindResumeDue(ctx context.Context, now time.Time, limit int) ([]*UserPreferences, error)
+}
@@ -0,0 +1,114 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewUserPreferencesSuccess(t *testing.T) {
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	device, err := domain.NewDevice("device-1", "token-1")
+	require.NoError(t, err)
+
+	devices, err := domain.NewDevices([]domain.Device{device})
+	require.NoError(t, err)
+
+	override, err := domain.NewWindowOverride(3*time.Minute, 0)
+	require.NoError(t, err)
+
+	prefs := domain.NewUserPreferences(
+		userID,
+		devices,
+		domain.UTCTimezone(),
+		nil,
+		domain.WindowOverrides{domain.TypeNear: override},
+	)
+
+	assert.Equal(t, userID, prefs.UserID())
+	assert.True(t, prefs.HasDefaultDevices())
+	assert.Equal(t, devices, prefs.DefaultDevices())
+	assert.Equal(t, override, prefs.WindowOverride(domain.TypeNear))
+	assert.True(t, prefs.WindowOverride(domain.TypeShort).IsZero())
+	assert.False(t, prefs.IsPaused())
+	assert.Nil(t, prefs.ResumeAt())
+	assert.Equal(t, prefs.CreatedAt(), prefs.UpdatedAt())
+}
+
+func TestUserPreferencesReplaceSuccess(t *testing.T) {
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	createdAt := time.Now().Add(-24 * time.Hour)
+	prefs := domain.ReconstituteUserPreferences(
+		userID, nil, domain.UTCTimezone(), nil, nil, true, nil, createdAt, createdAt,
+	)
+
+	tokyo, err := domain.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	prefs.Replace(nil, tokyo, nil, nil)
+
+	assert.False(t, prefs.HasDefaultDevices())
+	assert.Equal(t, "Asia/Tokyo", prefs.Timezone().String())
+	assert.True(t, prefs.IsPaused(), "replacing settings keeps the pause")
+	assert.Equal(t, createdAt, prefs.CreatedAt())
+	assert.True(t, prefs.UpdatedAt().After(createdAt))
+}
+
+func TestUserPreferencesPauseSuccess(t *testing.T) {
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	now := time.Now()
+	resumeAt := now.Add(24 * time.Hour)
+
+	prefs := domain.NewUserPreferences(userID, nil, domain.UTCTimezone(), nil, nil)
+
+	require.NoError(t, prefs.Pause(now, &resumeAt))
+	assert.True(t, prefs.IsPaused())
+	assert.Equal(t, &resumeAt, prefs.ResumeAt())
+	assert.False(t, prefs.IsResumeDue(now))
+	assert.True(t, prefs.IsResumeDue(resumeAt))
+
+	// Pausing again without a resume time makes the pause open-ended.
+	require.NoError(t, prefs.Pause(now, nil))
+	assert.Nil(t, prefs.ResumeAt())
+	assert.False(t, prefs.IsResumeDue(resumeAt))
+
+	prefs.Resume()
+	assert.False(t, prefs.IsPaused())
+	assert.Nil(t, prefs.ResumeAt())
+}
+
+func TestUserPreferencesPauseError(t *testing.T) {
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	now := time.Now()
+	tests := []struct {
+		name     string
+		resumeAt time.Time
+	}{
+		{name: "resume time in the past", resumeAt: now.Add(-time.Minute)},
+		{name: "resume time equal to now", resumeAt: now},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prefs := domain.NewUserPreferences(userID, nil, domain.UTCTimezone(), nil, nil)
+
+			err := prefs.Pause(now, &tt.resumeAt)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidResumeAt)
+			assert.False(t, prefs.IsPaused())
+		})
+	}
+}
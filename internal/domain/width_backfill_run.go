@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+var (
+	ErrWidthBackfillRunNotFound = errors.New("width backfill run not found")
+	ErrWidthBackfillRunActive   = errors.New("width backfill run is active")
+	ErrWidthBackfillLeaseLost   = errors.New("width backfill lease lost")
+)
+
+// WidthChange is a stored slide window width a backfill changed, or would
+// change in a dry run.
+type WidthChange struct {
+	RemindID string
+	TaskID   string
+	Time     time.Time
+	OldWidth int32 // seconds
+	NewWidth int32 // seconds
+}
+
+// WidthBackfillRun is the running or last finished slide window width
+// backfill. LastTaskID is the cursor a run resumes from. While the run is
+// running, Owner is the instance working on it, which holds the run until
+// LeaseUntil.
+type WidthBackfillRun struct {
+	Running    bool
+	DryRun     bool
+	StartedAt  time.Time
+	FinishedAt time.Time // zero while running
+	LastTaskID string
+	Tasks      int
+	Reminds    int
+	Changed    int
+	Overlap    int
+	Skipped    int
+	Failed     int
+	Changes    []WidthChange // dry runs only
+	Truncated  bool
+	Error      string
+	Owner      string
+	LeaseUntil time.Time
+}
+
+type WidthBackfillRunRepository interface {
+	// Find returns ErrWidthBackfillRunNotFound before the first run.
+	Find(ctx context.Context) (*WidthBackfillRun, error)
+	// Start replaces the last finished run with run. It returns
+	// ErrWidthBackfillRunActive while a run is running, including one whose
+	// owner is gone; such a run is claimed and resumed instead.
+	Start(ctx context.Context, run *WidthBackfillRun) error
+	// Claim leases the running run to owner until leaseUntil when owner
+	// already holds it or the lease of its owner expired before now. It
+	// returns ErrWidthBackfillRunNotFound when there is no run to claim.
+	Claim(ctx context.Context, owner string, now, leaseUntil time.Time) (*WidthBackfillRun, error)
+	// Save stores the progress and lease of a run held by run.Owner. It
+	// returns ErrWidthBackfillLeaseLost when another instance took the run
+	// over.
+	Save(ctx context.Context, run *WidthBackfillRun) error
+}
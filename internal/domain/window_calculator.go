@@ -5,10 +5,20 @@ import (
 	"time"
 )
 
-type SlideWindowWidthCalculator struct{}
+type SlideWindowWidthCalculator struct {
+	policy WindowPolicy
+}
 
 func NewSlideWindowWidthCalculator() *SlideWindowWidthCalculator {
-	return &SlideWindowWidthCalculator{}
+	return NewSlideWindowWidthCalculatorWithPolicy(NewDefaultWindowPolicy())
+}
+
+// NewSlideWindowWidthCalculatorWithPolicy creates a calculator taking widths
+// from policy instead of the defaults below.
+func NewSlideWindowWidthCalculatorWithPolicy(policy WindowPolicy) *SlideWindowWidthCalculator {
+	return &SlideWindowWidthCalculator{
+		policy: policy,
+	}
 }
 
 // 1. Sort times
@@ -26,9 +36,22 @@ func NewSlideWindowWidthCalculator() *SlideWindowWidthCalculator {
 //   - 5 minutes for short type
 //
 //   - 10 minutes for other types
+//
+// A calculator created with another WindowPolicy takes the widths of steps 2
+// and 3 from it instead.
 func (c *SlideWindowWidthCalculator) CalculateSlideWindowWidths(
 	times []time.Time,
 	taskType Type,
+) map[time.Time]SlideWindowWidth {
+	return c.CalculateSlideWindowWidthsWithOverride(times, taskType, WindowOverride{})
+}
+
+// CalculateSlideWindowWidthsWithOverride works like CalculateSlideWindowWidths but
+// uses the widths set in override instead of the task type defaults.
+func (c *SlideWindowWidthCalculator) CalculateSlideWindowWidthsWithOverride(
+	times []time.Time,
+	taskType Type,
+	override WindowOverride,
 ) map[time.Time]SlideWindowWidth {
 	if len(times) == 0 {
 		return nil
@@ -46,10 +69,10 @@ func (c *SlideWindowWidthCalculator) CalculateSlideWindowWidths(
 	for i, t := range sortedTimes {
 		if i == lastIndex {
 			// TargetAt (last reminder)
-			result[t] = GetTargetAtWindowWidth(taskType)
+			result[t] = c.targetWidth(taskType, override)
 		} else {
 			// Intermediate reminder: 30% of interval to next reminder, clamped per task type
-			result[t] = c.calculateIntermediateWidth(sortedTimes, i, taskType)
+			result[t] = c.calculateIntermediateWidth(sortedTimes, i, taskType, override)
 		}
 	}
 
@@ -58,15 +81,38 @@ func (c *SlideWindowWidthCalculator) CalculateSlideWindowWidths(
 
 // CalculateSingleSlideWindowWidth calculates slide window width for a single reminder time.
 func (c *SlideWindowWidthCalculator) CalculateSingleSlideWindowWidth(taskType Type) SlideWindowWidth {
-	return GetTargetAtWindowWidth(taskType)
+	return c.policy.TargetWidth(taskType)
 }
 
-func (c *SlideWindowWidthCalculator) calculateIntermediateWidth(times []time.Time, idx int, taskType Type) SlideWindowWidth {
+// MaxSlideWindowWidth is the widest window the calculator gives the task type;
+// a user override's intermediate max width takes precedence.
+func (c *SlideWindowWidthCalculator) MaxSlideWindowWidth(taskType Type, override WindowOverride) SlideWindowWidth {
+	if override.IntermediateMaxWidth() != 0 {
+		return MustSlideWindowWidth(override.IntermediateMaxWidth())
+	}
+
+	return c.policy.MaxWidth(taskType)
+}
+
+func (c *SlideWindowWidthCalculator) calculateIntermediateWidth(
+	times []time.Time,
+	idx int,
+	taskType Type,
+	override WindowOverride,
+) SlideWindowWidth {
 	if idx >= len(times)-1 {
 		return MustSlideWindowWidth(MinSlideWindowWidth)
 	}
 
 	intervalToNext := times[idx+1].Sub(times[idx])
 
-	return GetIntermediateWindowWidth(taskType, intervalToNext)
+	return c.policy.IntermediateWidth(taskType, intervalToNext, override.IntermediateMaxWidth())
+}
+
+func (c *SlideWindowWidthCalculator) targetWidth(taskType Type, override WindowOverride) SlideWindowWidth {
+	if override.TargetWidth() != 0 {
+		return MustSlideWindowWidth(override.TargetWidth())
+	}
+
+	return c.policy.TargetWidth(taskType)
 }
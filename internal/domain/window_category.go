@@ -2,45 +2,25 @@ package domain
 
 import "time"
 
-// GetTargetAtWindowWidth returns the window width for the TargetAt (last) reminder.
-// Uses switch statement to determine width based on task type:
+// WindowCategory names the rule a remind's slide window width follows.
+type WindowCategory string
+
+const (
+	// WindowCategoryTarget is the TargetAt (last) reminder with its fixed width.
+	WindowCategoryTarget WindowCategory = "target"
+	// WindowCategoryIntermediate is an earlier reminder sized by the interval
+	// to the next one.
+	WindowCategoryIntermediate WindowCategory = "intermediate"
+)
+
+// GetTargetAtWindowWidth returns the window width for the TargetAt (last)
+// reminder from the task type's registered window params:
 //   - short/scheduled: 2 minutes
 //   - near/relaxed: 5 minutes
 func GetTargetAtWindowWidth(taskType Type) SlideWindowWidth {
-	switch taskType {
-	case TypeShort, TypeScheduled:
-		return MustSlideWindowWidth(WindowWidthShort)
-	case TypeNear, TypeRelaxed:
-		return MustSlideWindowWidth(WindowWidthBase)
-	default:
-		return MustSlideWindowWidth(WindowWidthBase)
-	}
+	return MustSlideWindowWidth(taskTypes.window(taskType).targetWidth)
 }
 
 func GetIntermediateWindowWidth(taskType Type, intervalToNext time.Duration) SlideWindowWidth {
-	// Calculate 30% of interval
-	rawWidth := time.Duration(float64(intervalToNext) * IntermediateIntervalRatio)
-
-	// Determine max width based on task type
-	var maxWidth time.Duration
-
-	switch taskType {
-	case TypeShort:
-		maxWidth = IntermediateMaxWidthShort
-	case TypeNear, TypeRelaxed, TypeScheduled:
-		maxWidth = MaxSlideWindowWidth
-	default:
-		maxWidth = MaxSlideWindowWidth
-	}
-
-	// Clamp
-	if rawWidth < MinSlideWindowWidth {
-		return MustSlideWindowWidth(MinSlideWindowWidth)
-	}
-
-	if rawWidth > maxWidth {
-		return MustSlideWindowWidth(maxWidth)
-	}
-
-	return MustSlideWindowWidth(rawWidth)
+	return taskTypes.window(taskType).intermediateWidth(intervalToNext, 0)
 }
@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"slices"
+	"time"
+)
+
+// OverlapResult is what overlap resolution did to one remind.
+type OverlapResult string
+
+const (
+	OverlapShrunk  OverlapResult = "shrunk"
+	OverlapDropped OverlapResult = "dropped"
+)
+
+// OverlapAdjustment records one remind whose window overlapped the next one.
+// Width is the new width of a shrunk remind and zero for a dropped one.
+type OverlapAdjustment struct {
+	Time   time.Time
+	Result OverlapResult
+	Width  SlideWindowWidth
+}
+
+// ResolveWindowOverlaps makes the windows of a task's reminds disjoint. A
+// remind at t with width w is deliverable within [t-w, t+w], so two
+// consecutive reminds overlap when their widths add up to more than the gap
+// between them; windows may touch.
+//
+// Reminds are walked from the last (TargetAt) one backwards and later reminds
+// keep their widths: an earlier remind is shrunk to the room left before the
+// next kept remind's window, or dropped when that room is below
+// MinSlideWindowWidth. The returned widths omit dropped times.
+func ResolveWindowOverlaps(
+	times []time.Time,
+	widths map[time.Time]SlideWindowWidth,
+) (map[time.Time]SlideWindowWidth, []OverlapAdjustment) {
+	sorted := slices.Clone(times)
+	slices.SortFunc(sorted, time.Time.Compare)
+
+	resolved := make(map[time.Time]SlideWindowWidth, len(widths))
+	for t, width := range widths {
+		resolved[t] = width
+	}
+
+	if len(sorted) < 2 {
+		return resolved, nil
+	}
+
+	var adjustments []OverlapAdjustment
+
+	next := sorted[len(sorted)-1]
+
+	for i := len(sorted) - 2; i >= 0; i-- {
+		t := sorted[i]
+		room := (next.Sub(t) - resolved[next].Duration()).Truncate(time.Second)
+
+		if resolved[t].Duration() <= room {
+			next = t
+
+			continue
+		}
+
+		if room < MinSlideWindowWidth {
+			delete(resolved, t)
+			adjustments = append(adjustments, OverlapAdjustment{Time: t, Result: OverlapDropped})
+
+			continue
+		}
+
+		resolved[t] = MustSlideWindowWidth(room)
+		adjustments = append(adjustments, OverlapAdjustment{Time: t, Result: OverlapShrunk, Width: resolved[t]})
+		next = t
+	}
+
+	// Report in time order.
+	slices.Reverse(adjustments)
+
+	return resolved, adjustments
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestResolveWindowOverlapsSuccess(t *testing.T) {
+	base := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	at := func(minutes int) time.Time {
+		return base.Add(time.Duration(minutes) * time.Minute)
+	}
+	width := func(minutes float64) domain.SlideWindowWidth {
+		return domain.MustSlideWindowWidth(time.Duration(minutes * float64(time.Minute)))
+	}
+
+	tests := []struct {
+		name                string
+		times               []time.Time
+		widths              []domain.SlideWindowWidth
+		expected            map[time.Time]domain.SlideWindowWidth
+		expectedAdjustments []domain.OverlapAdjustment
+	}{
+		{
+			name:     "single remind",
+			times:    []time.Time{at(0)},
+			widths:   []domain.SlideWindowWidth{width(30)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(30)},
+		},
+		{
+			name:     "disjoint windows are kept",
+			times:    []time.Time{at(0), at(10)},
+			widths:   []domain.SlideWindowWidth{width(3), width(2)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(3), at(10): width(2)},
+		},
+		{
+			name:     "touching windows are kept",
+			times:    []time.Time{at(0), at(3)},
+			widths:   []domain.SlideWindowWidth{width(1), width(2)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(1), at(3): width(2)},
+		},
+		{
+			name:     "earlier window is shrunk",
+			times:    []time.Time{at(0), at(5)},
+			widths:   []domain.SlideWindowWidth{width(4), width(2)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(3), at(5): width(2)},
+			expectedAdjustments: []domain.OverlapAdjustment{
+				{Time: at(0), Result: domain.OverlapShrunk, Width: width(3)},
+			},
+		},
+		{
+			name:     "earlier remind without room is dropped",
+			times:    []time.Time{at(0), at(2)},
+			widths:   []domain.SlideWindowWidth{width(1), width(2)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(2): width(2)},
+			expectedAdjustments: []domain.OverlapAdjustment{
+				{Time: at(0), Result: domain.OverlapDropped},
+			},
+		},
+		{
+			name:     "reminds before a dropped one are checked against the next kept one",
+			times:    []time.Time{at(4), at(0), at(2)},
+			widths:   []domain.SlideWindowWidth{width(2), width(1.5), width(1)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(1.5), at(4): width(2)},
+			expectedAdjustments: []domain.OverlapAdjustment{
+				{Time: at(2), Result: domain.OverlapDropped},
+			},
+		},
+		{
+			name:     "shrunk width leaves room for earlier reminds",
+			times:    []time.Time{at(0), at(6), at(10)},
+			widths:   []domain.SlideWindowWidth{width(3), width(3), width(2)},
+			expected: map[time.Time]domain.SlideWindowWidth{at(0): width(3), at(6): width(2), at(10): width(2)},
+			expectedAdjustments: []domain.OverlapAdjustment{
+				{Time: at(6), Result: domain.OverlapShrunk, Width: width(2)},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			widths := make(map[time.Time]domain.SlideWindowWidth, len(tt.times))
+			for i, remindTime := range tt.times {
+				widths[remindTime] = tt.widths[i]
+			}
+
+			resolved, adjustments := domain.ResolveWindowOverlaps(tt.times, widths)
+
+			assert.Equal(t, tt.expected, resolved)
+			assert.Equal(t, tt.expectedAdjustments, adjustments)
+			assert.Len(t, widths, len(tt.times), "input widths are not modified")
+		})
+	}
+}
+
+func TestResolveWindowOverlapsShortSeriesSuccess(t *testing.T) {
+	// A short remind two minutes before the deadline gets the minimum width,
+	// which overlaps the 2 minute TargetAt window.
+	deadline := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	times := []time.Time{deadline.Add(-2 * time.Minute), deadline}
+
+	widths := domain.NewSlideWindowWidthCalculator().CalculateSlideWindowWidths(times, domain.TypeShort)
+	resolved, adjustments := domain.ResolveWindowOverlaps(times, widths)
+
+	assert.Len(t, resolved, 1)
+	assert.Equal(t, []domain.OverlapAdjustment{
+		{Time: times[0], Result: domain.OverlapDropped},
+	}, adjustments)
+}
@@ -0,0 +1,53 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var ErrInvalidWindowOverride = errors.New("invalid window override: widths must be between 1 and 30 minutes")
+
+// WindowOverride replaces the default slide window widths of a task type for
+// one user. A zero width keeps the default.
+type WindowOverride struct {
+	targetWidth          time.Duration
+	intermediateMaxWidth time.Duration
+}
+
+func NewWindowOverride(targetWidth, intermediateMaxWidth time.Duration) (WindowOverride, error) {
+	for _, d := range []time.Duration{targetWidth, intermediateMaxWidth} {
+		if d == 0 {
+			continue
+		}
+
+		if _, err := NewSlideWindowWidth(d); err != nil {
+			return WindowOverride{}, errors.Join(ErrInvalidWindowOverride, err)
+		}
+	}
+
+	return WindowOverride{
+		targetWidth:          targetWidth,
+		intermediateMaxWidth: intermediateMaxWidth,
+	}, nil
+}
+
+// TargetWidth is the width of the last (TargetAt) remind, or zero for the default.
+func (o WindowOverride) TargetWidth() time.Duration {
+	return o.targetWidth
+}
+
+// IntermediateMaxWidth caps intermediate remind widths, or zero for the default.
+func (o WindowOverride) IntermediateMaxWidth() time.Duration {
+	return o.intermediateMaxWidth
+}
+
+func (o WindowOverride) IsZero() bool {
+	return o.targetWidth == 0 && o.intermediateMaxWidth == 0
+}
+
+type WindowOverrides map[Type]WindowOverride
+
+// For returns the override for taskType, or the zero override.
+func (o WindowOverrides) For(taskType Type) WindowOverride {
+	return o[taskType]
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestNewWindowOverrideSuccess(t *testing.T) {
+	tests := []struct {
+		name                 string
+		targetWidth          time.Duration
+		intermediateMaxWidth time.Duration
+		expectedZero         bool
+	}{
+		{
+			name:                 "both widths set",
+			targetWidth:          3 * time.Minute,
+			intermediateMaxWidth: 8 * time.Minute,
+		},
+		{
+			name:                 "only target width set",
+			targetWidth:          1 * time.Minute,
+			intermediateMaxWidth: 0,
+		},
+		{
+			name:         "no widths set",
+			expectedZero: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o, err := domain.NewWindowOverride(tt.targetWidth, tt.intermediateMaxWidth)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.targetWidth, o.TargetWidth())
+			assert.Equal(t, tt.intermediateMaxWidth, o.IntermediateMaxWidth())
+			assert.Equal(t, tt.expectedZero, o.IsZero())
+		})
+	}
+}
+
+func TestNewWindowOverrideError(t *testing.T) {
+	tests := []struct {
+		name                 string
+		targetWidth          time.Duration
+		intermediateMaxWidth time.Duration
+	}{
+		{
+			name:        "target width too small",
+			targetWidth: 30 * time.Second,
+		},
+		{
+			name:                 "intermediate width too large",
+			intermediateMaxWidth: 31 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewWindowOverride(tt.targetWidth, tt.intermediateMaxWidth)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidWindowOverride)
+		})
+	}
+}
+
+func TestCalculateSlideWindowWidthsWithOverrideSuccess(t *testing.T) {
+	calc := domain.NewSlideWindowWidthCalculator()
+	now := time.Now()
+	times := []time.Time{now, now.Add(1 * time.Hour)}
+
+	tests := []struct {
+		name                 string
+		override             func(t *testing.T) domain.WindowOverride
+		expectedIntermediate time.Duration
+		expectedTarget       time.Duration
+	}{
+		{
+			name: "zero override keeps short defaults",
+			override: func(_ *testing.T) domain.WindowOverride {
+				return domain.WindowOverride{}
+			},
+			expectedIntermediate: 5 * time.Minute,
+			expectedTarget:       2 * time.Minute,
+		},
+		{
+			name: "override replaces target width and intermediate cap",
+			override: func(t *testing.T) domain.WindowOverride {
+				o, err := domain.NewWindowOverride(4*time.Minute, 10*time.Minute)
+				require.NoError(t, err)
+
+				return o
+			},
+			expectedIntermediate: 10 * time.Minute,
+			expectedTarget:       4 * time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := calc.CalculateSlideWindowWidthsWithOverride(times, domain.TypeShort, tt.override(t))
+
+			assert.Equal(t, tt.expectedIntermediate, result[times[0]].Duration())
+			assert.Equal(t, tt.expectedTarget, result[times[1]].Duration())
+		})
+	}
+}
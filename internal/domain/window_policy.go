@@ -0,0 +1,162 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var ErrInvalidWindowParams = errors.New("invalid window params")
+
+// WindowPolicy decides the slide window widths of a task type's reminds.
+type WindowPolicy interface {
+	// TargetWidth is the width of the last (TargetAt) remind.
+	TargetWidth(taskType Type) SlideWindowWidth
+	// IntermediateWidth is the width of a remind followed by the next one after
+	// intervalToNext. A non-zero maxWidth replaces the policy's upper bound.
+	IntermediateWidth(taskType Type, intervalToNext, maxWidth time.Duration) SlideWindowWidth
+	// MaxWidth is the widest window the policy gives the task type.
+	MaxWidth(taskType Type) SlideWindowWidth
+}
+
+// DefaultWindowPolicy is the behavior described on
+// SlideWindowWidthCalculator.CalculateSlideWindowWidths, using the window
+// params of the registered task types.
+type DefaultWindowPolicy struct{}
+
+func NewDefaultWindowPolicy() *DefaultWindowPolicy {
+	return &DefaultWindowPolicy{}
+}
+
+func (p *DefaultWindowPolicy) TargetWidth(taskType Type) SlideWindowWidth {
+	return GetTargetAtWindowWidth(taskType)
+}
+
+func (p *DefaultWindowPolicy) IntermediateWidth(taskType Type, intervalToNext, maxWidth time.Duration) SlideWindowWidth {
+	return taskTypes.window(taskType).intermediateWidth(intervalToNext, maxWidth)
+}
+
+func (p *DefaultWindowPolicy) MaxWidth(taskType Type) SlideWindowWidth {
+	return taskTypes.window(taskType).maxWidth()
+}
+
+// WindowParams are the tunable widths of one task type: the TargetAt width,
+// and intermediate widths as a ratio of the interval to the next remind
+// clamped to [intermediateMin, intermediateMax].
+type WindowParams struct {
+	targetWidth       time.Duration
+	intermediateRatio float64
+	intermediateMin   time.Duration
+	intermediateMax   time.Duration
+}
+
+func NewWindowParams(
+	targetWidth time.Duration,
+	intermediateRatio float64,
+	intermediateMin time.Duration,
+	intermediateMax time.Duration,
+) (WindowParams, error) {
+	for _, d := range []time.Duration{targetWidth, intermediateMin, intermediateMax} {
+		if _, err := NewSlideWindowWidth(d); err != nil {
+			return WindowParams{}, errors.Join(ErrInvalidWindowParams, err)
+		}
+	}
+
+	if intermediateMin > intermediateMax {
+		return WindowParams{}, fmt.Errorf("%w: intermediate min %s exceeds max %s",
+			ErrInvalidWindowParams, intermediateMin, intermediateMax)
+	}
+
+	if intermediateRatio <= 0 || intermediateRatio > 1 {
+		return WindowParams{}, fmt.Errorf("%w: intermediate ratio must be in (0, 1], got %v",
+			ErrInvalidWindowParams, intermediateRatio)
+	}
+
+	return WindowParams{
+		targetWidth:       targetWidth,
+		intermediateRatio: intermediateRatio,
+		intermediateMin:   intermediateMin,
+		intermediateMax:   intermediateMax,
+	}, nil
+}
+
+func (p WindowParams) TargetWidth() time.Duration {
+	return p.targetWidth
+}
+
+func (p WindowParams) IntermediateRatio() float64 {
+	return p.intermediateRatio
+}
+
+func (p WindowParams) IntermediateMin() time.Duration {
+	return p.intermediateMin
+}
+
+func (p WindowParams) IntermediateMax() time.Duration {
+	return p.intermediateMax
+}
+
+// intermediateWidth is the ratio of intervalToNext clamped to the params'
+// bounds. A non-zero maxWidth replaces the upper bound and wins over the
+// lower one so a narrower user override always applies.
+func (p WindowParams) intermediateWidth(intervalToNext, maxWidth time.Duration) SlideWindowWidth {
+	upper := p.intermediateMax
+	if maxWidth != 0 {
+		upper = maxWidth
+	}
+
+	width := time.Duration(float64(intervalToNext) * p.intermediateRatio)
+	width = max(width, p.intermediateMin)
+	width = min(width, upper)
+
+	return MustSlideWindowWidth(width)
+}
+
+func (p WindowParams) maxWidth() SlideWindowWidth {
+	return MustSlideWindowWidth(max(p.targetWidth, p.intermediateMax))
+}
+
+// ParameterizedWindowPolicy uses configured params per task type and falls
+// back to DefaultWindowPolicy for task types without params.
+type ParameterizedWindowPolicy struct {
+	params   map[Type]WindowParams
+	fallback WindowPolicy
+}
+
+func NewParameterizedWindowPolicy(params map[Type]WindowParams) *ParameterizedWindowPolicy {
+	return &ParameterizedWindowPolicy{
+		params:   params,
+		fallback: NewDefaultWindowPolicy(),
+	}
+}
+
+func (p *ParameterizedWindowPolicy) TargetWidth(taskType Type) SlideWindowWidth {
+	params, ok := p.params[taskType]
+	if !ok {
+		return p.fallback.TargetWidth(taskType)
+	}
+
+	return MustSlideWindowWidth(params.targetWidth)
+}
+
+func (p *ParameterizedWindowPolicy) IntermediateWidth(
+	taskType Type,
+	intervalToNext time.Duration,
+	maxWidth time.Duration,
+) SlideWindowWidth {
+	params, ok := p.params[taskType]
+	if !ok {
+		return p.fallback.IntermediateWidth(taskType, intervalToNext, maxWidth)
+	}
+
+	return params.intermediateWidth(intervalToNext, maxWidth)
+}
+
+func (p *ParameterizedWindowPolicy) MaxWidth(taskType Type) SlideWindowWidth {
+	params, ok := p.params[taskType]
+	if !ok {
+		return p.fallback.MaxWidth(taskType)
+	}
+
+	return params.maxWidth()
+}
@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+func TestDefaultWindowPolicySuccess(t *testing.T) {
+	policy := domain.NewDefaultWindowPolicy()
+
+	for _, taskType := range []domain.Type{domain.TypeShort, domain.TypeNear, domain.TypeRelaxed, domain.TypeScheduled} {
+		assert.Equal(t, domain.GetTargetAtWindowWidth(taskType), policy.TargetWidth(taskType))
+		assert.Equal(t,
+			domain.GetIntermediateWindowWidth(taskType, time.Hour),
+			policy.IntermediateWidth(taskType, time.Hour, 0),
+		)
+	}
+
+	assert.Equal(t, domain.IntermediateMaxWidthShort, policy.MaxWidth(domain.TypeShort).Duration())
+	assert.Equal(t, domain.IntermediateMaxWidthBase, policy.MaxWidth(domain.TypeRelaxed).Duration())
+
+	// A user override replaces the upper bound.
+	assert.Equal(t, 3*time.Minute, policy.IntermediateWidth(domain.TypeNear, time.Hour, 3*time.Minute).Duration())
+}
+
+func TestNewWindowParamsError(t *testing.T) {
+	tests := []struct {
+		name     string
+		target   time.Duration
+		ratio    float64
+		minWidth time.Duration
+		maxWidth time.Duration
+	}{
+		{name: "target too large", target: 31 * time.Minute, ratio: 0.3, minWidth: time.Minute, maxWidth: 10 * time.Minute},
+		{name: "min too small", target: 2 * time.Minute, ratio: 0.3, minWidth: 30 * time.Second, maxWidth: 10 * time.Minute},
+		{name: "min above max", target: 2 * time.Minute, ratio: 0.3, minWidth: 10 * time.Minute, maxWidth: 5 * time.Minute},
+		{name: "zero ratio", target: 2 * time.Minute, ratio: 0, minWidth: time.Minute, maxWidth: 10 * time.Minute},
+		{name: "ratio above one", target: 2 * time.Minute, ratio: 1.5, minWidth: time.Minute, maxWidth: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := domain.NewWindowParams(tt.target, tt.ratio, tt.minWidth, tt.maxWidth)
+
+			assert.ErrorIs(t, err, domain.ErrInvalidWindowParams)
+		})
+	}
+}
+
+func TestParameterizedWindowPolicySuccess(t *testing.T) {
+	params, err := domain.NewWindowParams(15*time.Minute, 0.5, 2*time.Minute, 30*time.Minute)
+	require.NoError(t, err)
+
+	policy := domain.NewParameterizedWindowPolicy(map[domain.Type]domain.WindowParams{
+		domain.TypeRelaxed: params,
+	})
+
+	tests := []struct {
+		name           string
+		taskType       domain.Type
+		intervalToNext time.Duration
+		maxWidth       time.Duration
+		expected       time.Duration
+	}{
+		{name: "ratio of interval", taskType: domain.TypeRelaxed, intervalToNext: 20 * time.Minute, expected: 10 * time.Minute},
+		{name: "clamped to min", taskType: domain.TypeRelaxed, intervalToNext: 2 * time.Minute, expected: 2 * time.Minute},
+		{name: "clamped to max beyond the old 10 minutes", taskType: domain.TypeRelaxed, intervalToNext: 2 * time.Hour, expected: 30 * time.Minute},
+		{name: "override max wins", taskType: domain.TypeRelaxed, intervalToNext: 2 * time.Hour, maxWidth: time.Minute, expected: time.Minute},
+		{name: "unconfigured type uses defaults", taskType: domain.TypeShort, intervalToNext: 2 * time.Hour, expected: domain.IntermediateMaxWidthShort},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			width := policy.IntermediateWidth(tt.taskType, tt.intervalToNext, tt.maxWidth)
+
+			assert.Equal(t, tt.expected, width.Duration())
+		})
+	}
+
+	assert.Equal(t, 15*time.Minute, policy.TargetWidth(domain.TypeRelaxed).Duration())
+	assert.Equal(t, 30*time.Minute, policy.MaxWidth(domain.TypeRelaxed).Duration())
+	assert.Equal(t, domain.GetTargetAtWindowWidth(domain.TypeShort), policy.TargetWidth(domain.TypeShort))
+}
+
+func TestSlideWindowWidthCalculatorWithPolicySuccess(t *testing.T) {
+	params, err := domain.NewWindowParams(20*time.Minute, 0.5, time.Minute, 30*time.Minute)
+	require.NoError(t, err)
+
+	calculator := domain.NewSlideWindowWidthCalculatorWithPolicy(
+		domain.NewParameterizedWindowPolicy(map[domain.Type]domain.WindowParams{domain.TypeNear: params}),
+	)
+
+	base := time.Date(2026, 10, 18, 9, 0, 0, 0, time.UTC)
+	times := []time.Time{base, base.Add(time.Hour)}
+
+	widths := calculator.CalculateSlideWindowWidths(times, domain.TypeNear)
+
+	assert.Equal(t, 30*time.Minute, widths[times[0]].Duration())
+	assert.Equal(t, 20*time.Minute, widths[times[1]].Duration())
+	assert.Equal(t, 20*time.Minute, calculator.CalculateSingleSlideWindowWidth(domain.TypeNear).Duration())
+
+	override, err := domain.NewWindowOverride(0, 4*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, 30*time.Minute, calculator.MaxSlideWindowWidth(domain.TypeNear, domain.WindowOverride{}).Duration())
+	assert.Equal(t, 4*time.Minute, calculator.MaxSlideWindowWidth(domain.TypeNear, override).Duration())
+}
@@ -0,0 +1,526 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: remind/v1/pause.proto
+
+package remindv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ResumePolicy decides what happens to paused reminds whose time passed during the pause
+type ResumePolicy int32
+
+const (
+	ResumePolicy_RESUME_POLICY_UNSPECIFIED ResumePolicy = 0 // same as RESUME_POLICY_SKIP_PAST
+	ResumePolicy_RESUME_POLICY_SKIP_PAST   ResumePolicy = 1 // delete reminds whose time has passed
+	ResumePolicy_RESUME_POLICY_RESTORE_ALL ResumePolicy = 2 // restore every remind, including past ones
+)
+
+// Enum value maps for ResumePolicy.
+var (
+	ResumePolicy_name = map[int32]string{
+		0: "RESUME_POLICY_UNSPECIFIED",
+		1: "RESUME_POLICY_SKIP_PAST",
+		2: "RESUME_POLICY_RESTORE_ALL",
+	}
+	ResumePolicy_value = map[string]int32{
+		"RESUME_POLICY_UNSPECIFIED": 0,
+		"RESUME_POLICY_SKIP_PAST":   1,
+		"RESUME_POLICY_RESTORE_ALL": 2,
+	}
+)
+
+func (x ResumePolicy) Enum() *ResumePolicy {
+	p := new(ResumePolicy)
+	*p = x
+	return p
+}
+
+func (x ResumePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ResumePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_pause_proto_enumTypes[0].Descriptor()
+}
+
+func (ResumePolicy) Type() protoreflect.EnumType {
+	return &file_remind_v1_pause_proto_enumTypes[0]
+}
+
+func (x ResumePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ResumePolicy.Descriptor instead.
+func (ResumePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{0}
+}
+
+// PauseUserRequest pauses all future reminds of a user
+type PauseUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResumeAt      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"` // optional automatic resume, must be in the future
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseUserRequest) Reset() {
+	*x = PauseUserRequest{}
+	mi := &file_remind_v1_pause_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseUserRequest) ProtoMessage() {}
+
+func (x *PauseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseUserRequest.ProtoReflect.Descriptor instead.
+func (*PauseUserRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PauseUserRequest) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+// PauseUserResponse is the response after pausing a user's reminds
+type PauseUserResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PausedRemindIds []string               `protobuf:"bytes,2,rep,name=paused_remind_ids,json=pausedRemindIds,proto3" json:"paused_remind_ids,omitempty"`
+	ResumeAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PauseUserResponse) Reset() {
+	*x = PauseUserResponse{}
+	mi := &file_remind_v1_pause_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseUserResponse) ProtoMessage() {}
+
+func (x *PauseUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseUserResponse.ProtoReflect.Descriptor instead.
+func (*PauseUserResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PauseUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *PauseUserResponse) GetPausedRemindIds() []string {
+	if x != nil {
+		return x.PausedRemindIds
+	}
+	return nil
+}
+
+func (x *PauseUserResponse) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+// ResumeUserRequest resumes the paused reminds of a user
+type ResumeUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policy        ResumePolicy           `protobuf:"varint,1,opt,name=policy,proto3,enum=remind.v1.ResumePolicy" json:"policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeUserRequest) Reset() {
+	*x = ResumeUserRequest{}
+	mi := &file_remind_v1_pause_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeUserRequest) ProtoMessage() {}
+
+func (x *ResumeUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeUserRequest.ProtoReflect.Descriptor instead.
+func (*ResumeUserRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ResumeUserRequest) GetPolicy() ResumePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return ResumePolicy_RESUME_POLICY_UNSPECIFIED
+}
+
+// ResumeUserResponse is the response after resuming a user's reminds
+type ResumeUserResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestoredRemindIds []string               `protobuf:"bytes,2,rep,name=restored_remind_ids,json=restoredRemindIds,proto3" json:"restored_remind_ids,omitempty"`
+	SkippedRemindIds  []string               `protobuf:"bytes,3,rep,name=skipped_remind_ids,json=skippedRemindIds,proto3" json:"skipped_remind_ids,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ResumeUserResponse) Reset() {
+	*x = ResumeUserResponse{}
+	mi := &file_remind_v1_pause_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeUserResponse) ProtoMessage() {}
+
+func (x *ResumeUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeUserResponse.ProtoReflect.Descriptor instead.
+func (*ResumeUserResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ResumeUserResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ResumeUserResponse) GetRestoredRemindIds() []string {
+	if x != nil {
+		return x.RestoredRemindIds
+	}
+	return nil
+}
+
+func (x *ResumeUserResponse) GetSkippedRemindIds() []string {
+	if x != nil {
+		return x.SkippedRemindIds
+	}
+	return nil
+}
+
+// RemindsPausedEvent is published when a user's reminds are paused
+type RemindsPausedEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// List of remind IDs that were paused, used to hold back queued notifications
+	RemindIds     []string               `protobuf:"bytes,2,rep,name=remind_ids,json=remindIds,proto3" json:"remind_ids,omitempty"`
+	PausedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=paused_at,json=pausedAt,proto3" json:"paused_at,omitempty"`
+	ResumeAt      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"` // unset when the pause has no automatic end
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindsPausedEvent) Reset() {
+	*x = RemindsPausedEvent{}
+	mi := &file_remind_v1_pause_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindsPausedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindsPausedEvent) ProtoMessage() {}
+
+func (x *RemindsPausedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindsPausedEvent.ProtoReflect.Descriptor instead.
+func (*RemindsPausedEvent) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemindsPausedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemindsPausedEvent) GetRemindIds() []string {
+	if x != nil {
+		return x.RemindIds
+	}
+	return nil
+}
+
+func (x *RemindsPausedEvent) GetPausedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PausedAt
+	}
+	return nil
+}
+
+func (x *RemindsPausedEvent) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+// RemindsResumedEvent is published when a user's paused reminds are resumed
+type RemindsResumedEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RestoredRemindIds []string               `protobuf:"bytes,2,rep,name=restored_remind_ids,json=restoredRemindIds,proto3" json:"restored_remind_ids,omitempty"`
+	// List of remind IDs whose time passed during the pause and that were deleted
+	SkippedRemindIds []string               `protobuf:"bytes,3,rep,name=skipped_remind_ids,json=skippedRemindIds,proto3" json:"skipped_remind_ids,omitempty"`
+	ResumedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=resumed_at,json=resumedAt,proto3" json:"resumed_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *RemindsResumedEvent) Reset() {
+	*x = RemindsResumedEvent{}
+	mi := &file_remind_v1_pause_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindsResumedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindsResumedEvent) ProtoMessage() {}
+
+func (x *RemindsResumedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_pause_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindsResumedEvent.ProtoReflect.Descriptor instead.
+func (*RemindsResumedEvent) Descriptor() ([]byte, []int) {
+	return file_remind_v1_pause_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RemindsResumedEvent) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RemindsResumedEvent) GetRestoredRemindIds() []string {
+	if x != nil {
+		return x.RestoredRemindIds
+	}
+	return nil
+}
+
+func (x *RemindsResumedEvent) GetSkippedRemindIds() []string {
+	if x != nil {
+		return x.SkippedRemindIds
+	}
+	return nil
+}
+
+func (x *RemindsResumedEvent) GetResumedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumedAt
+	}
+	return nil
+}
+
+var File_remind_v1_pause_proto protoreflect.FileDescriptor
+
+const file_remind_v1_pause_proto_rawDesc = "" +
+	"\n" +
+	"\x15remind/v1/pause.proto\x12\tremind.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"K\n" +
+	"\x10PauseUserRequest\x127\n" +
+	"\tresume_at\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\bresumeAt\"\x91\x01\n" +
+	"\x11PauseUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12*\n" +
+	"\x11paused_remind_ids\x18\x02 \x03(\tR\x0fpausedRemindIds\x127\n" +
+	"\tresume_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bresumeAt\"D\n" +
+	"\x11ResumeUserRequest\x12/\n" +
+	"\x06policy\x18\x01 \x01(\x0e2\x17.remind.v1.ResumePolicyR\x06policy\"\x8b\x01\n" +
+	"\x12ResumeUserResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12.\n" +
+	"\x13restored_remind_ids\x18\x02 \x03(\tR\x11restoredRemindIds\x12,\n" +
+	"\x12skipped_remind_ids\x18\x03 \x03(\tR\x10skippedRemindIds\"\xc8\x01\n" +
+	"\x12RemindsPausedEvent\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12\x1d\n" +
+	"\n" +
+	"remind_ids\x18\x02 \x03(\tR\tremindIds\x127\n" +
+	"\tpaused_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bpausedAt\x127\n" +
+	"\tresume_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bresumeAt\"\xd1\x01\n" +
+	"\x13RemindsResumedEvent\x12!\n" +
+	"\auser_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12.\n" +
+	"\x13restored_remind_ids\x18\x02 \x03(\tR\x11restoredRemindIds\x12,\n" +
+	"\x12skipped_remind_ids\x18\x03 \x03(\tR\x10skippedRemindIds\x129\n" +
+	"\n" +
+	"resumed_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tresumedAt*i\n" +
+	"\fResumePolicy\x12\x1d\n" +
+	"\x19RESUME_POLICY_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17RESUME_POLICY_SKIP_PAST\x10\x01\x12\x1d\n" +
+	"\x19RESUME_POLICY_RESTORE_ALL\x10\x02B\xb3\x01\n" +
+	"\rcom.remind.v1B\n" +
+	"PauseProtoP\x01ZQgithub.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1;remindv1\xa2\x02\x03RXX\xaa\x02\tRemind.V1\xca\x02\tRemind\\V1\xe2\x02\x15Remind\\V1\\GPBMetadata\xea\x02\n" +
+	"Remind::V1b\x06proto3"
+
+var (
+	file_remind_v1_pause_proto_rawDescOnce sync.Once
+	file_remind_v1_pause_proto_rawDescData []byte
+)
+
+func file_remind_v1_pause_proto_rawDescGZIP() []byte {
+	file_remind_v1_pause_proto_rawDescOnce.Do(func() {
+		file_remind_v1_pause_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_remind_v1_pause_proto_rawDesc), len(file_remind_v1_pause_proto_rawDesc)))
+	})
+	return file_remind_v1_pause_proto_rawDescData
+}
+
+var file_remind_v1_pause_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_remind_v1_pause_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_remind_v1_pause_proto_goTypes = []any{
+	(ResumePolicy)(0),             // 0: remind.v1.ResumePolicy
+	(*PauseUserRequest)(nil),      // 1: remind.v1.PauseUserRequest
+	(*PauseUserResponse)(nil),     // 2: remind.v1.PauseUserResponse
+	(*ResumeUserRequest)(nil),     // 3: remind.v1.ResumeUserRequest
+	(*ResumeUserResponse)(nil),    // 4: remind.v1.ResumeUserResponse
+	(*RemindsPausedEvent)(nil),    // 5: remind.v1.RemindsPausedEvent
+	(*RemindsResumedEvent)(nil),   // 6: remind.v1.RemindsResumedEvent
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
+}
+var file_remind_v1_pause_proto_depIdxs = []int32{
+	7, // 0: remind.v1.PauseUserRequest.resume_at:type_name -> google.protobuf.Timestamp
+	7, // 1: remind.v1.PauseUserResponse.resume_at:type_name -> google.protobuf.Timestamp
+	0, // 2: remind.v1.ResumeUserRequest.policy:type_name -> remind.v1.ResumePolicy
+	7, // 3: remind.v1.RemindsPausedEvent.paused_at:type_name -> google.protobuf.Timestamp
+	7, // 4: remind.v1.RemindsPausedEvent.resume_at:type_name -> google.protobuf.Timestamp
+	7, // 5: remind.v1.RemindsResumedEvent.resumed_at:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_remind_v1_pause_proto_init() }
+func file_remind_v1_pause_proto_init() {
+	if File_remind_v1_pause_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remind_v1_pause_proto_rawDesc), len(file_remind_v1_pause_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_remind_v1_pause_proto_goTypes,
+		DependencyIndexes: file_remind_v1_pause_proto_depIdxs,
+		EnumInfos:         file_remind_v1_pause_proto_enumTypes,
+		MessageInfos:      file_remind_v1_pause_proto_msgTypes,
+	}.Build()
+	File_remind_v1_pause_proto = out.File
+	file_remind_v1_pause_proto_goTypes = nil
+	file_remind_v1_pause_proto_depIdxs = nil
+}
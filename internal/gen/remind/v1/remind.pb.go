@@ -24,30 +24,1088 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CatchUpPolicy decides what happens to requested times that are already past
+type CatchUpPolicy int32
+
+const (
+	CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED CatchUpPolicy = 0 // same as CATCH_UP_POLICY_REJECT
+	CatchUpPolicy_CATCH_UP_POLICY_REJECT      CatchUpPolicy = 1 // fail the whole request
+	CatchUpPolicy_CATCH_UP_POLICY_DROP        CatchUpPolicy = 2 // discard past times and keep the rest
+	CatchUpPolicy_CATCH_UP_POLICY_CLAMP       CatchUpPolicy = 3 // move past times to now with a minimal window, or drop them when a kept time fires within the minimum spacing
+)
+
+// Enum value maps for CatchUpPolicy.
+var (
+	CatchUpPolicy_name = map[int32]string{
+		0: "CATCH_UP_POLICY_UNSPECIFIED",
+		1: "CATCH_UP_POLICY_REJECT",
+		2: "CATCH_UP_POLICY_DROP",
+		3: "CATCH_UP_POLICY_CLAMP",
+	}
+	CatchUpPolicy_value = map[string]int32{
+		"CATCH_UP_POLICY_UNSPECIFIED": 0,
+		"CATCH_UP_POLICY_REJECT":      1,
+		"CATCH_UP_POLICY_DROP":        2,
+		"CATCH_UP_POLICY_CLAMP":       3,
+	}
+)
+
+func (x CatchUpPolicy) Enum() *CatchUpPolicy {
+	p := new(CatchUpPolicy)
+	*p = x
+	return p
+}
+
+func (x CatchUpPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatchUpPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_remind_proto_enumTypes[0].Descriptor()
+}
+
+func (CatchUpPolicy) Type() protoreflect.EnumType {
+	return &file_remind_v1_remind_proto_enumTypes[0]
+}
+
+func (x CatchUpPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatchUpPolicy.Descriptor instead.
+func (CatchUpPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{0}
+}
+
+// CatchUpResult is what the catch-up policy did to one requested time
+type CatchUpResult int32
+
+const (
+	CatchUpResult_CATCH_UP_RESULT_UNSPECIFIED CatchUpResult = 0
+	CatchUpResult_CATCH_UP_RESULT_KEPT        CatchUpResult = 1
+	CatchUpResult_CATCH_UP_RESULT_DROPPED     CatchUpResult = 2
+	CatchUpResult_CATCH_UP_RESULT_CLAMPED     CatchUpResult = 3
+)
+
+// Enum value maps for CatchUpResult.
+var (
+	CatchUpResult_name = map[int32]string{
+		0: "CATCH_UP_RESULT_UNSPECIFIED",
+		1: "CATCH_UP_RESULT_KEPT",
+		2: "CATCH_UP_RESULT_DROPPED",
+		3: "CATCH_UP_RESULT_CLAMPED",
+	}
+	CatchUpResult_value = map[string]int32{
+		"CATCH_UP_RESULT_UNSPECIFIED": 0,
+		"CATCH_UP_RESULT_KEPT":        1,
+		"CATCH_UP_RESULT_DROPPED":     2,
+		"CATCH_UP_RESULT_CLAMPED":     3,
+	}
+)
+
+func (x CatchUpResult) Enum() *CatchUpResult {
+	p := new(CatchUpResult)
+	*p = x
+	return p
+}
+
+func (x CatchUpResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CatchUpResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_remind_proto_enumTypes[1].Descriptor()
+}
+
+func (CatchUpResult) Type() protoreflect.EnumType {
+	return &file_remind_v1_remind_proto_enumTypes[1]
+}
+
+func (x CatchUpResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CatchUpResult.Descriptor instead.
+func (CatchUpResult) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{1}
+}
+
+// EscalationAction is one step taken when a remind stays unacknowledged
+type EscalationAction int32
+
+const (
+	EscalationAction_ESCALATION_ACTION_UNSPECIFIED   EscalationAction = 0
+	EscalationAction_ESCALATION_ACTION_ADD_DEVICES   EscalationAction = 1
+	EscalationAction_ESCALATION_ACTION_NARROW_WINDOW EscalationAction = 2
+	EscalationAction_ESCALATION_ACTION_FOLLOW_UP     EscalationAction = 3
+)
+
+// Enum value maps for EscalationAction.
+var (
+	EscalationAction_name = map[int32]string{
+		0: "ESCALATION_ACTION_UNSPECIFIED",
+		1: "ESCALATION_ACTION_ADD_DEVICES",
+		2: "ESCALATION_ACTION_NARROW_WINDOW",
+		3: "ESCALATION_ACTION_FOLLOW_UP",
+	}
+	EscalationAction_value = map[string]int32{
+		"ESCALATION_ACTION_UNSPECIFIED":   0,
+		"ESCALATION_ACTION_ADD_DEVICES":   1,
+		"ESCALATION_ACTION_NARROW_WINDOW": 2,
+		"ESCALATION_ACTION_FOLLOW_UP":     3,
+	}
+)
+
+func (x EscalationAction) Enum() *EscalationAction {
+	p := new(EscalationAction)
+	*p = x
+	return p
+}
+
+func (x EscalationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EscalationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_remind_proto_enumTypes[2].Descriptor()
+}
+
+func (EscalationAction) Type() protoreflect.EnumType {
+	return &file_remind_v1_remind_proto_enumTypes[2]
+}
+
+func (x EscalationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EscalationAction.Descriptor instead.
+func (EscalationAction) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{2}
+}
+
+// WindowCategory is the rule a remind's slide window width follows
+type WindowCategory int32
+
+const (
+	WindowCategory_WINDOW_CATEGORY_UNSPECIFIED  WindowCategory = 0
+	WindowCategory_WINDOW_CATEGORY_TARGET       WindowCategory = 1 // the last remind, with the task type's fixed width
+	WindowCategory_WINDOW_CATEGORY_INTERMEDIATE WindowCategory = 2 // earlier reminds, sized by the interval to the next
+)
+
+// Enum value maps for WindowCategory.
+var (
+	WindowCategory_name = map[int32]string{
+		0: "WINDOW_CATEGORY_UNSPECIFIED",
+		1: "WINDOW_CATEGORY_TARGET",
+		2: "WINDOW_CATEGORY_INTERMEDIATE",
+	}
+	WindowCategory_value = map[string]int32{
+		"WINDOW_CATEGORY_UNSPECIFIED":  0,
+		"WINDOW_CATEGORY_TARGET":       1,
+		"WINDOW_CATEGORY_INTERMEDIATE": 2,
+	}
+)
+
+func (x WindowCategory) Enum() *WindowCategory {
+	p := new(WindowCategory)
+	*p = x
+	return p
+}
+
+func (x WindowCategory) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WindowCategory) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_remind_proto_enumTypes[3].Descriptor()
+}
+
+func (WindowCategory) Type() protoreflect.EnumType {
+	return &file_remind_v1_remind_proto_enumTypes[3]
+}
+
+func (x WindowCategory) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WindowCategory.Descriptor instead.
+func (WindowCategory) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{3}
+}
+
+// PolicyAdjustment is a change a policy made to a requested remind
+type PolicyAdjustment int32
+
+const (
+	PolicyAdjustment_POLICY_ADJUSTMENT_UNSPECIFIED PolicyAdjustment = 0
+	PolicyAdjustment_POLICY_ADJUSTMENT_QUIET_HOURS PolicyAdjustment = 1 // time shifted out of the user's quiet hours
+	PolicyAdjustment_POLICY_ADJUSTMENT_RATE_LIMIT  PolicyAdjustment = 2 // time pushed back to stay within the rate limit
+	PolicyAdjustment_POLICY_ADJUSTMENT_DENSITY     PolicyAdjustment = 3 // window widened because many reminds share the minute
+	PolicyAdjustment_POLICY_ADJUSTMENT_CATCH_UP    PolicyAdjustment = 4 // past time clamped to now
+	PolicyAdjustment_POLICY_ADJUSTMENT_ADAPTIVE    PolicyAdjustment = 5 // window scaled by the user's delivery history
+	PolicyAdjustment_POLICY_ADJUSTMENT_OVERLAP     PolicyAdjustment = 6 // window shrunk so it does not overlap the next remind's
+)
+
+// Enum value maps for PolicyAdjustment.
+var (
+	PolicyAdjustment_name = map[int32]string{
+		0: "POLICY_ADJUSTMENT_UNSPECIFIED",
+		1: "POLICY_ADJUSTMENT_QUIET_HOURS",
+		2: "POLICY_ADJUSTMENT_RATE_LIMIT",
+		3: "POLICY_ADJUSTMENT_DENSITY",
+		4: "POLICY_ADJUSTMENT_CATCH_UP",
+		5: "POLICY_ADJUSTMENT_ADAPTIVE",
+		6: "POLICY_ADJUSTMENT_OVERLAP",
+	}
+	PolicyAdjustment_value = map[string]int32{
+		"POLICY_ADJUSTMENT_UNSPECIFIED": 0,
+		"POLICY_ADJUSTMENT_QUIET_HOURS": 1,
+		"POLICY_ADJUSTMENT_RATE_LIMIT":  2,
+		"POLICY_ADJUSTMENT_DENSITY":     3,
+		"POLICY_ADJUSTMENT_CATCH_UP":    4,
+		"POLICY_ADJUSTMENT_ADAPTIVE":    5,
+		"POLICY_ADJUSTMENT_OVERLAP":     6,
+	}
+)
+
+func (x PolicyAdjustment) Enum() *PolicyAdjustment {
+	p := new(PolicyAdjustment)
+	*p = x
+	return p
+}
+
+func (x PolicyAdjustment) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PolicyAdjustment) Descriptor() protoreflect.EnumDescriptor {
+	return file_remind_v1_remind_proto_enumTypes[4].Descriptor()
+}
+
+func (PolicyAdjustment) Type() protoreflect.EnumType {
+	return &file_remind_v1_remind_proto_enumTypes[4]
+}
+
+func (x PolicyAdjustment) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PolicyAdjustment.Descriptor instead.
+func (PolicyAdjustment) EnumDescriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{4}
+}
+
 // Device represents a user device with FCM token
 type Device struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
-	FcmToken      string                 `protobuf:"bytes,2,opt,name=fcm_token,json=fcmToken,proto3" json:"fcm_token,omitempty"`
+	DeviceId      string                 `protobuf:"bytes,1,opt,name=device_id,json=deviceId,proto3" json:"device_id,omitempty"`
+	FcmToken      string                 `protobuf:"bytes,2,opt,name=fcm_token,json=fcmToken,proto3" json:"fcm_token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Device) Reset() {
+	*x = Device{}
+	mi := &file_remind_v1_remind_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Device) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Device) ProtoMessage() {}
+
+func (x *Device) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Device.ProtoReflect.Descriptor instead.
+func (*Device) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Device) GetDeviceId() string {
+	if x != nil {
+		return x.DeviceId
+	}
+	return ""
+}
+
+func (x *Device) GetFcmToken() string {
+	if x != nil {
+		return x.FcmToken
+	}
+	return ""
+}
+
+// NotificationPayload is the content of a push notification, stored with the remind so it can be rendered without the task service
+type NotificationPayload struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Body          string                 `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+	DeepLink      string                 `protobuf:"bytes,3,opt,name=deep_link,json=deepLink,proto3" json:"deep_link,omitempty"`
+	Locale        string                 `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"` // BCP 47 language tag, e.g. ja-JP
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationPayload) Reset() {
+	*x = NotificationPayload{}
+	mi := &file_remind_v1_remind_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPayload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPayload) ProtoMessage() {}
+
+func (x *NotificationPayload) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPayload.ProtoReflect.Descriptor instead.
+func (*NotificationPayload) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *NotificationPayload) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *NotificationPayload) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *NotificationPayload) GetDeepLink() string {
+	if x != nil {
+		return x.DeepLink
+	}
+	return ""
+}
+
+func (x *NotificationPayload) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *NotificationPayload) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// CreateRemindRequest is sent from central-backend via primind-tasks to time-mgmt
+type CreateRemindRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// times may be omitted when target_at is given with template or auto_schedule
+	Times  []*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"`
+	UserId string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// devices may be omitted to use the user's stored default devices
+	Devices []*Device `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
+	TaskId  string    `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// task_type values without a name are custom task types registered by the service, sent as numbers
+	TaskType v1.TaskType `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
+	// escalation overrides the task type's default escalation policy
+	Escalation *EscalationPolicy `protobuf:"bytes,6,opt,name=escalation,proto3" json:"escalation,omitempty"`
+	// payload is the notification content; omitted when the notification side renders it itself
+	Payload *NotificationPayload `protobuf:"bytes,7,opt,name=payload,proto3" json:"payload,omitempty"`
+	// target_at is the deadline the generated times count back from
+	TargetAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=target_at,json=targetAt,proto3" json:"target_at,omitempty"`
+	// template names a stored remind template used to generate times instead of listing them
+	Template string `protobuf:"bytes,9,opt,name=template,proto3" json:"template,omitempty"`
+	// auto_schedule generates the recommended times for the task type instead of listing them
+	AutoSchedule bool `protobuf:"varint,10,opt,name=auto_schedule,json=autoSchedule,proto3" json:"auto_schedule,omitempty"`
+	// catch_up decides what happens to times that are already past, e.g. after a late sync
+	CatchUp       CatchUpPolicy `protobuf:"varint,11,opt,name=catch_up,json=catchUp,proto3,enum=remind.v1.CatchUpPolicy" json:"catch_up,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRemindRequest) Reset() {
+	*x = CreateRemindRequest{}
+	mi := &file_remind_v1_remind_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRemindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRemindRequest) ProtoMessage() {}
+
+func (x *CreateRemindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRemindRequest.ProtoReflect.Descriptor instead.
+func (*CreateRemindRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateRemindRequest) GetTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Times
+	}
+	return nil
+}
+
+func (x *CreateRemindRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateRemindRequest) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *CreateRemindRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CreateRemindRequest) GetTaskType() v1.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v1.TaskType(0)
+}
+
+func (x *CreateRemindRequest) GetEscalation() *EscalationPolicy {
+	if x != nil {
+		return x.Escalation
+	}
+	return nil
+}
+
+func (x *CreateRemindRequest) GetPayload() *NotificationPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *CreateRemindRequest) GetTargetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.TargetAt
+	}
+	return nil
+}
+
+func (x *CreateRemindRequest) GetTemplate() string {
+	if x != nil {
+		return x.Template
+	}
+	return ""
+}
+
+func (x *CreateRemindRequest) GetAutoSchedule() bool {
+	if x != nil {
+		return x.AutoSchedule
+	}
+	return false
+}
+
+func (x *CreateRemindRequest) GetCatchUp() CatchUpPolicy {
+	if x != nil {
+		return x.CatchUp
+	}
+	return CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED
+}
+
+// TimeOutcome reports the catch-up result of one requested time; a time later dropped for quiet hours, by the rate limit, for ending up closer than the minimum spacing or for overlapping windows is reported as dropped
+type TimeOutcome struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestedTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=requested_time,json=requestedTime,proto3" json:"requested_time,omitempty"`
+	Result        CatchUpResult          `protobuf:"varint,2,opt,name=result,proto3,enum=remind.v1.CatchUpResult" json:"result,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"` // where the remind finally landed; omitted when the time was dropped
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeOutcome) Reset() {
+	*x = TimeOutcome{}
+	mi := &file_remind_v1_remind_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeOutcome) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeOutcome) ProtoMessage() {}
+
+func (x *TimeOutcome) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeOutcome.ProtoReflect.Descriptor instead.
+func (*TimeOutcome) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *TimeOutcome) GetRequestedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedTime
+	}
+	return nil
+}
+
+func (x *TimeOutcome) GetResult() CatchUpResult {
+	if x != nil {
+		return x.Result
+	}
+	return CatchUpResult_CATCH_UP_RESULT_UNSPECIFIED
+}
+
+func (x *TimeOutcome) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+// EscalationPolicy runs one step each time the remind stays unacknowledged for another after_seconds
+type EscalationPolicy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AfterSeconds  int32                  `protobuf:"varint,1,opt,name=after_seconds,json=afterSeconds,proto3" json:"after_seconds,omitempty"`
+	Steps         []EscalationAction     `protobuf:"varint,2,rep,packed,name=steps,proto3,enum=remind.v1.EscalationAction" json:"steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EscalationPolicy) Reset() {
+	*x = EscalationPolicy{}
+	mi := &file_remind_v1_remind_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EscalationPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EscalationPolicy) ProtoMessage() {}
+
+func (x *EscalationPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EscalationPolicy.ProtoReflect.Descriptor instead.
+func (*EscalationPolicy) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EscalationPolicy) GetAfterSeconds() int32 {
+	if x != nil {
+		return x.AfterSeconds
+	}
+	return 0
+}
+
+func (x *EscalationPolicy) GetSteps() []EscalationAction {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+// CancelRemindRequest is sent from central-backend via primind-tasks to time-mgmt
+type CancelRemindRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelRemindRequest) Reset() {
+	*x = CancelRemindRequest{}
+	mi := &file_remind_v1_remind_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelRemindRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRemindRequest) ProtoMessage() {}
+
+func (x *CancelRemindRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRemindRequest.ProtoReflect.Descriptor instead.
+func (*CancelRemindRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CancelRemindRequest) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *CancelRemindRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Remind represents a single reminder entry
+type Remind struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Time             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Devices          []*Device              `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`
+	TaskId           string                 `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	TaskType         v1.TaskType            `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
+	Throttled        bool                   `protobuf:"varint,7,opt,name=throttled,proto3" json:"throttled,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	SlideWindowWidth int32                  `protobuf:"varint,10,opt,name=slide_window_width,json=slideWindowWidth,proto3" json:"slide_window_width,omitempty"` // slide window width in seconds for throttling (range: 60-1800)
+	AcknowledgedAt   *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=acknowledged_at,json=acknowledgedAt,proto3" json:"acknowledged_at,omitempty"`          // unset until the user reacts to the remind
+	EscalationLevel  int32                  `protobuf:"varint,12,opt,name=escalation_level,json=escalationLevel,proto3" json:"escalation_level,omitempty"`      // number of escalation steps already taken
+	Paused           bool                   `protobuf:"varint,13,opt,name=paused,proto3" json:"paused,omitempty"`                                               // true while the user's reminds are paused
+	Payload          *NotificationPayload   `protobuf:"bytes,14,opt,name=payload,proto3" json:"payload,omitempty"`                                              // unset when the remind has no payload
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Remind) Reset() {
+	*x = Remind{}
+	mi := &file_remind_v1_remind_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Remind) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Remind) ProtoMessage() {}
+
+func (x *Remind) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Remind.ProtoReflect.Descriptor instead.
+func (*Remind) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Remind) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Remind) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *Remind) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Remind) GetDevices() []*Device {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+func (x *Remind) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *Remind) GetTaskType() v1.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v1.TaskType(0)
+}
+
+func (x *Remind) GetThrottled() bool {
+	if x != nil {
+		return x.Throttled
+	}
+	return false
+}
+
+func (x *Remind) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Remind) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Remind) GetSlideWindowWidth() int32 {
+	if x != nil {
+		return x.SlideWindowWidth
+	}
+	return 0
+}
+
+func (x *Remind) GetAcknowledgedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcknowledgedAt
+	}
+	return nil
+}
+
+func (x *Remind) GetEscalationLevel() int32 {
+	if x != nil {
+		return x.EscalationLevel
+	}
+	return 0
+}
+
+func (x *Remind) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *Remind) GetPayload() *NotificationPayload {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// RemindsResponse is the response containing a list of reminds
+type RemindsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reminds       []*Remind              `protobuf:"bytes,1,rep,name=reminds,proto3" json:"reminds,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindsResponse) Reset() {
+	*x = RemindsResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindsResponse) ProtoMessage() {}
+
+func (x *RemindsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindsResponse.ProtoReflect.Descriptor instead.
+func (*RemindsResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RemindsResponse) GetReminds() []*Remind {
+	if x != nil {
+		return x.Reminds
+	}
+	return nil
+}
+
+func (x *RemindsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// CreateRemindsResponse is the response to a CreateRemindRequest; time_outcomes, dropped_times and shrunk_times are empty when the reminds already existed
+type CreateRemindsResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Reminds       []*Remind                `protobuf:"bytes,1,rep,name=reminds,proto3" json:"reminds,omitempty"`
+	Count         int32                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	TimeOutcomes  []*TimeOutcome           `protobuf:"bytes,3,rep,name=time_outcomes,json=timeOutcomes,proto3" json:"time_outcomes,omitempty"`
+	DroppedTimes  []*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=dropped_times,json=droppedTimes,proto3" json:"dropped_times,omitempty"` // requested times dropped as past, for quiet hours, by the rate limit, for ending up closer than the minimum spacing or for overlapping windows
+	ShrunkTimes   []*timestamppb.Timestamp `protobuf:"bytes,5,rep,name=shrunk_times,json=shrunkTimes,proto3" json:"shrunk_times,omitempty"`    // times of reminds whose windows were shrunk so they do not overlap the next remind's
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRemindsResponse) Reset() {
+	*x = CreateRemindsResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRemindsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRemindsResponse) ProtoMessage() {}
+
+func (x *CreateRemindsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRemindsResponse.ProtoReflect.Descriptor instead.
+func (*CreateRemindsResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CreateRemindsResponse) GetReminds() []*Remind {
+	if x != nil {
+		return x.Reminds
+	}
+	return nil
+}
+
+func (x *CreateRemindsResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *CreateRemindsResponse) GetTimeOutcomes() []*TimeOutcome {
+	if x != nil {
+		return x.TimeOutcomes
+	}
+	return nil
+}
+
+func (x *CreateRemindsResponse) GetDroppedTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.DroppedTimes
+	}
+	return nil
+}
+
+func (x *CreateRemindsResponse) GetShrunkTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.ShrunkTimes
+	}
+	return nil
+}
+
+// Digest groups one user's reminds whose slide windows overlap so they can be delivered as one notification
+type Digest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	UserId             string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RepresentativeTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=representative_time,json=representativeTime,proto3" json:"representative_time,omitempty"` // middle of the shared window
+	WindowStart        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`                      // start of the intersection of the members' slide windows
+	WindowEnd          *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`                            // end of the intersection of the members' slide windows
+	RemindIds          []string               `protobuf:"bytes,5,rep,name=remind_ids,json=remindIds,proto3" json:"remind_ids,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Digest) Reset() {
+	*x = Digest{}
+	mi := &file_remind_v1_remind_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Digest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Digest) ProtoMessage() {}
+
+func (x *Digest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Digest.ProtoReflect.Descriptor instead.
+func (*Digest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Digest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Digest) GetRepresentativeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RepresentativeTime
+	}
+	return nil
+}
+
+func (x *Digest) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *Digest) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+func (x *Digest) GetRemindIds() []string {
+	if x != nil {
+		return x.RemindIds
+	}
+	return nil
+}
+
+// DigestsResponse is the digest listing mode of the time range query
+type DigestsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Digests       []*Digest              `protobuf:"bytes,1,rep,name=digests,proto3" json:"digests,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Device) Reset() {
-	*x = Device{}
-	mi := &file_remind_v1_remind_proto_msgTypes[0]
+func (x *DigestsResponse) Reset() {
+	*x = DigestsResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Device) String() string {
+func (x *DigestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Device) ProtoMessage() {}
+func (*DigestsResponse) ProtoMessage() {}
 
-func (x *Device) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[0]
+func (x *DigestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,52 +1116,49 @@ func (x *Device) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Device.ProtoReflect.Descriptor instead.
-func (*Device) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DigestsResponse.ProtoReflect.Descriptor instead.
+func (*DigestsResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *Device) GetDeviceId() string {
+func (x *DigestsResponse) GetDigests() []*Digest {
 	if x != nil {
-		return x.DeviceId
+		return x.Digests
 	}
-	return ""
+	return nil
 }
 
-func (x *Device) GetFcmToken() string {
+func (x *DigestsResponse) GetCount() int32 {
 	if x != nil {
-		return x.FcmToken
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-// CreateRemindRequest is sent from central-backend via primind-tasks to time-mgmt
-type CreateRemindRequest struct {
-	state         protoimpl.MessageState   `protogen:"open.v1"`
-	Times         []*timestamppb.Timestamp `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"`
-	UserId        string                   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Devices       []*Device                `protobuf:"bytes,3,rep,name=devices,proto3" json:"devices,omitempty"`
-	TaskId        string                   `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType      v1.TaskType              `protobuf:"varint,5,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
+// PreviewScheduleRequest asks for the automatic schedule of a deadline without creating reminds
+type PreviewScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deadline      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	TaskType      v1.TaskType            `protobuf:"varint,2,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRemindRequest) Reset() {
-	*x = CreateRemindRequest{}
-	mi := &file_remind_v1_remind_proto_msgTypes[1]
+func (x *PreviewScheduleRequest) Reset() {
+	*x = PreviewScheduleRequest{}
+	mi := &file_remind_v1_remind_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRemindRequest) String() string {
+func (x *PreviewScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRemindRequest) ProtoMessage() {}
+func (*PreviewScheduleRequest) ProtoMessage() {}
 
-func (x *CreateRemindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[1]
+func (x *PreviewScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -114,70 +1169,101 @@ func (x *CreateRemindRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRemindRequest.ProtoReflect.Descriptor instead.
-func (*CreateRemindRequest) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PreviewScheduleRequest.ProtoReflect.Descriptor instead.
+func (*PreviewScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *CreateRemindRequest) GetTimes() []*timestamppb.Timestamp {
+func (x *PreviewScheduleRequest) GetDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Times
+		return x.Deadline
 	}
 	return nil
 }
 
-func (x *CreateRemindRequest) GetUserId() string {
+func (x *PreviewScheduleRequest) GetTaskType() v1.TaskType {
 	if x != nil {
-		return x.UserId
+		return x.TaskType
 	}
-	return ""
+	return v1.TaskType(0)
 }
 
-func (x *CreateRemindRequest) GetDevices() []*Device {
+// ScheduledTime is one remind time of a generated schedule
+type ScheduledTime struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Time             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	SlideWindowWidth int32                  `protobuf:"varint,2,opt,name=slide_window_width,json=slideWindowWidth,proto3" json:"slide_window_width,omitempty"` // slide window width in seconds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ScheduledTime) Reset() {
+	*x = ScheduledTime{}
+	mi := &file_remind_v1_remind_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledTime) ProtoMessage() {}
+
+func (x *ScheduledTime) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[12]
 	if x != nil {
-		return x.Devices
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateRemindRequest) GetTaskId() string {
+// Deprecated: Use ScheduledTime.ProtoReflect.Descriptor instead.
+func (*ScheduledTime) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ScheduledTime) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.TaskId
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateRemindRequest) GetTaskType() v1.TaskType {
+func (x *ScheduledTime) GetSlideWindowWidth() int32 {
 	if x != nil {
-		return x.TaskType
+		return x.SlideWindowWidth
 	}
-	return v1.TaskType(0)
+	return 0
 }
 
-// CancelRemindRequest is sent from central-backend via primind-tasks to time-mgmt
-type CancelRemindRequest struct {
+// ScheduleResponse is the generated schedule, in ascending order and ending with the deadline
+type ScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TaskId        string                 `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Times         []*ScheduledTime       `protobuf:"bytes,1,rep,name=times,proto3" json:"times,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelRemindRequest) Reset() {
-	*x = CancelRemindRequest{}
-	mi := &file_remind_v1_remind_proto_msgTypes[2]
+func (x *ScheduleResponse) Reset() {
+	*x = ScheduleResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelRemindRequest) String() string {
+func (x *ScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelRemindRequest) ProtoMessage() {}
+func (*ScheduleResponse) ProtoMessage() {}
 
-func (x *CancelRemindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[2]
+func (x *ScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,57 +1274,45 @@ func (x *CancelRemindRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelRemindRequest.ProtoReflect.Descriptor instead.
-func (*CancelRemindRequest) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{2}
-}
-
-func (x *CancelRemindRequest) GetTaskId() string {
-	if x != nil {
-		return x.TaskId
-	}
-	return ""
+// Deprecated: Use ScheduleResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *CancelRemindRequest) GetUserId() string {
+func (x *ScheduleResponse) GetTimes() []*ScheduledTime {
 	if x != nil {
-		return x.UserId
+		return x.Times
 	}
-	return ""
+	return nil
 }
 
-// Remind represents a single reminder entry
-type Remind struct {
+// RemindPreview is one remind a CreateRemindRequest would store
+type RemindPreview struct {
 	state            protoimpl.MessageState `protogen:"open.v1"`
-	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Time             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
-	UserId           string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Devices          []*Device              `protobuf:"bytes,4,rep,name=devices,proto3" json:"devices,omitempty"`
-	TaskId           string                 `protobuf:"bytes,5,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	TaskType         v1.TaskType            `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
-	Throttled        bool                   `protobuf:"varint,7,opt,name=throttled,proto3" json:"throttled,omitempty"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	SlideWindowWidth int32                  `protobuf:"varint,10,opt,name=slide_window_width,json=slideWindowWidth,proto3" json:"slide_window_width,omitempty"` // slide window width in seconds for throttling (range: 60-1800)
+	Time             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	SlideWindowWidth int32                  `protobuf:"varint,2,opt,name=slide_window_width,json=slideWindowWidth,proto3" json:"slide_window_width,omitempty"` // slide window width in seconds
+	Category         WindowCategory         `protobuf:"varint,3,opt,name=category,proto3,enum=remind.v1.WindowCategory" json:"category,omitempty"`
+	Adjustments      []PolicyAdjustment     `protobuf:"varint,4,rep,packed,name=adjustments,proto3,enum=remind.v1.PolicyAdjustment" json:"adjustments,omitempty"`
+	Paused           bool                   `protobuf:"varint,5,opt,name=paused,proto3" json:"paused,omitempty"`
 	unknownFields    protoimpl.UnknownFields
 	sizeCache        protoimpl.SizeCache
 }
 
-func (x *Remind) Reset() {
-	*x = Remind{}
-	mi := &file_remind_v1_remind_proto_msgTypes[3]
+func (x *RemindPreview) Reset() {
+	*x = RemindPreview{}
+	mi := &file_remind_v1_remind_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Remind) String() string {
+func (x *RemindPreview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Remind) ProtoMessage() {}
+func (*RemindPreview) ProtoMessage() {}
 
-func (x *Remind) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[3]
+func (x *RemindPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -249,105 +1323,154 @@ func (x *Remind) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Remind.ProtoReflect.Descriptor instead.
-func (*Remind) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use RemindPreview.ProtoReflect.Descriptor instead.
+func (*RemindPreview) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *Remind) GetId() string {
+func (x *RemindPreview) GetTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.Time
 	}
-	return ""
+	return nil
 }
 
-func (x *Remind) GetTime() *timestamppb.Timestamp {
+func (x *RemindPreview) GetSlideWindowWidth() int32 {
 	if x != nil {
-		return x.Time
+		return x.SlideWindowWidth
 	}
-	return nil
+	return 0
 }
 
-func (x *Remind) GetUserId() string {
+func (x *RemindPreview) GetCategory() WindowCategory {
 	if x != nil {
-		return x.UserId
+		return x.Category
 	}
-	return ""
+	return WindowCategory_WINDOW_CATEGORY_UNSPECIFIED
 }
 
-func (x *Remind) GetDevices() []*Device {
+func (x *RemindPreview) GetAdjustments() []PolicyAdjustment {
 	if x != nil {
-		return x.Devices
+		return x.Adjustments
 	}
 	return nil
 }
 
-func (x *Remind) GetTaskId() string {
+func (x *RemindPreview) GetPaused() bool {
 	if x != nil {
-		return x.TaskId
+		return x.Paused
 	}
-	return ""
+	return false
 }
 
-func (x *Remind) GetTaskType() v1.TaskType {
+// PreviewRemindsResponse is the dry run of a CreateRemindRequest; nothing is stored
+type PreviewRemindsResponse struct {
+	state             protoimpl.MessageState   `protogen:"open.v1"`
+	Reminds           []*RemindPreview         `protobuf:"bytes,1,rep,name=reminds,proto3" json:"reminds,omitempty"`
+	Count             int32                    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	RequestedTimes    []*timestamppb.Timestamp `protobuf:"bytes,3,rep,name=requested_times,json=requestedTimes,proto3" json:"requested_times,omitempty"` // times before catch-up, quiet hours and rate limiting
+	DroppedTimes      []*timestamppb.Timestamp `protobuf:"bytes,4,rep,name=dropped_times,json=droppedTimes,proto3" json:"dropped_times,omitempty"`       // requested times dropped as past, for quiet hours, by the rate limit, for ending up closer than the minimum spacing or for overlapping windows
+	TimeOutcomes      []*TimeOutcome           `protobuf:"bytes,5,rep,name=time_outcomes,json=timeOutcomes,proto3" json:"time_outcomes,omitempty"`
+	WindowExplanation string                   `protobuf:"bytes,6,opt,name=window_explanation,json=windowExplanation,proto3" json:"window_explanation,omitempty"` // why adaptive windows were scaled or not; empty when disabled
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PreviewRemindsResponse) Reset() {
+	*x = PreviewRemindsResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewRemindsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewRemindsResponse) ProtoMessage() {}
+
+func (x *PreviewRemindsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[15]
 	if x != nil {
-		return x.TaskType
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return v1.TaskType(0)
+	return mi.MessageOf(x)
 }
 
-func (x *Remind) GetThrottled() bool {
+// Deprecated: Use PreviewRemindsResponse.ProtoReflect.Descriptor instead.
+func (*PreviewRemindsResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *PreviewRemindsResponse) GetReminds() []*RemindPreview {
 	if x != nil {
-		return x.Throttled
+		return x.Reminds
 	}
-	return false
+	return nil
 }
 
-func (x *Remind) GetCreatedAt() *timestamppb.Timestamp {
+func (x *PreviewRemindsResponse) GetCount() int32 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Count
+	}
+	return 0
+}
+
+func (x *PreviewRemindsResponse) GetRequestedTimes() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.RequestedTimes
 	}
 	return nil
 }
 
-func (x *Remind) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *PreviewRemindsResponse) GetDroppedTimes() []*timestamppb.Timestamp {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DroppedTimes
 	}
 	return nil
 }
 
-func (x *Remind) GetSlideWindowWidth() int32 {
+func (x *PreviewRemindsResponse) GetTimeOutcomes() []*TimeOutcome {
 	if x != nil {
-		return x.SlideWindowWidth
+		return x.TimeOutcomes
 	}
-	return 0
+	return nil
 }
 
-// RemindsResponse is the response containing a list of reminds
-type RemindsResponse struct {
+func (x *PreviewRemindsResponse) GetWindowExplanation() string {
+	if x != nil {
+		return x.WindowExplanation
+	}
+	return ""
+}
+
+// RemindResponse is the response containing a single remind
+type RemindResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Reminds       []*Remind              `protobuf:"bytes,1,rep,name=reminds,proto3" json:"reminds,omitempty"`
-	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Remind        *Remind                `protobuf:"bytes,1,opt,name=remind,proto3" json:"remind,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemindsResponse) Reset() {
-	*x = RemindsResponse{}
-	mi := &file_remind_v1_remind_proto_msgTypes[4]
+func (x *RemindResponse) Reset() {
+	*x = RemindResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemindsResponse) String() string {
+func (x *RemindResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemindsResponse) ProtoMessage() {}
+func (*RemindResponse) ProtoMessage() {}
 
-func (x *RemindsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[4]
+func (x *RemindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -358,48 +1481,42 @@ func (x *RemindsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemindsResponse.ProtoReflect.Descriptor instead.
-func (*RemindsResponse) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use RemindResponse.ProtoReflect.Descriptor instead.
+func (*RemindResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *RemindsResponse) GetReminds() []*Remind {
+func (x *RemindResponse) GetRemind() *Remind {
 	if x != nil {
-		return x.Reminds
+		return x.Remind
 	}
 	return nil
 }
 
-func (x *RemindsResponse) GetCount() int32 {
-	if x != nil {
-		return x.Count
-	}
-	return 0
-}
-
-// RemindResponse is the response containing a single remind
-type RemindResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Remind        *Remind                `protobuf:"bytes,1,opt,name=remind,proto3" json:"remind,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+// AcknowledgeRemindResponse is the response to acknowledging a remind
+type AcknowledgeRemindResponse struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Remind             *Remind                `protobuf:"bytes,1,opt,name=remind,proto3" json:"remind,omitempty"`
+	CancelledRemindIds []string               `protobuf:"bytes,2,rep,name=cancelled_remind_ids,json=cancelledRemindIds,proto3" json:"cancelled_remind_ids,omitempty"` // upcoming reminds of the same task removed by the acknowledgment
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *RemindResponse) Reset() {
-	*x = RemindResponse{}
-	mi := &file_remind_v1_remind_proto_msgTypes[5]
+func (x *AcknowledgeRemindResponse) Reset() {
+	*x = AcknowledgeRemindResponse{}
+	mi := &file_remind_v1_remind_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemindResponse) String() string {
+func (x *AcknowledgeRemindResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemindResponse) ProtoMessage() {}
+func (*AcknowledgeRemindResponse) ProtoMessage() {}
 
-func (x *RemindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[5]
+func (x *AcknowledgeRemindResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -410,18 +1527,25 @@ func (x *RemindResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemindResponse.ProtoReflect.Descriptor instead.
-func (*RemindResponse) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use AcknowledgeRemindResponse.ProtoReflect.Descriptor instead.
+func (*AcknowledgeRemindResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RemindResponse) GetRemind() *Remind {
+func (x *AcknowledgeRemindResponse) GetRemind() *Remind {
 	if x != nil {
 		return x.Remind
 	}
 	return nil
 }
 
+func (x *AcknowledgeRemindResponse) GetCancelledRemindIds() []string {
+	if x != nil {
+		return x.CancelledRemindIds
+	}
+	return nil
+}
+
 // UpdateThrottledRequest is sent from throttling service to time-mgmt
 type UpdateThrottledRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -432,7 +1556,7 @@ type UpdateThrottledRequest struct {
 
 func (x *UpdateThrottledRequest) Reset() {
 	*x = UpdateThrottledRequest{}
-	mi := &file_remind_v1_remind_proto_msgTypes[6]
+	mi := &file_remind_v1_remind_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -444,7 +1568,7 @@ func (x *UpdateThrottledRequest) String() string {
 func (*UpdateThrottledRequest) ProtoMessage() {}
 
 func (x *UpdateThrottledRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[6]
+	mi := &file_remind_v1_remind_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,7 +1581,7 @@ func (x *UpdateThrottledRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateThrottledRequest.ProtoReflect.Descriptor instead.
 func (*UpdateThrottledRequest) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{6}
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *UpdateThrottledRequest) GetThrottled() bool {
@@ -479,7 +1603,7 @@ type ErrorResponse struct {
 
 func (x *ErrorResponse) Reset() {
 	*x = ErrorResponse{}
-	mi := &file_remind_v1_remind_proto_msgTypes[7]
+	mi := &file_remind_v1_remind_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -491,7 +1615,7 @@ func (x *ErrorResponse) String() string {
 func (*ErrorResponse) ProtoMessage() {}
 
 func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_remind_v1_remind_proto_msgTypes[7]
+	mi := &file_remind_v1_remind_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,7 +1628,7 @@ func (x *ErrorResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorResponse.ProtoReflect.Descriptor instead.
 func (*ErrorResponse) Descriptor() ([]byte, []int) {
-	return file_remind_v1_remind_proto_rawDescGZIP(), []int{7}
+	return file_remind_v1_remind_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ErrorResponse) GetError() string {
@@ -535,16 +1659,41 @@ const file_remind_v1_remind_proto_rawDesc = "" +
 	"\x16remind/v1/remind.proto\x12\tremind.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16common/v1/common.proto\"U\n" +
 	"\x06Device\x12%\n" +
 	"\tdevice_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\bdeviceId\x12$\n" +
-	"\tfcm_token\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfcmToken\"\x90\x02\n" +
-	"\x13CreateRemindRequest\x12:\n" +
-	"\x05times\x18\x01 \x03(\v2\x1a.google.protobuf.TimestampB\b\xbaH\x05\x92\x01\x02\b\x01R\x05times\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x125\n" +
-	"\adevices\x18\x03 \x03(\v2\x11.remind.v1.DeviceB\b\xbaH\x05\x92\x01\x02\b\x01R\adevices\x12!\n" +
-	"\atask_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12@\n" +
-	"\ttask_type\x18\x05 \x01(\x0e2\x13.common.v1.TaskTypeB\x0e\xbaH\v\x82\x01\b\x18\x01\x18\x02\x18\x03\x18\x04R\btaskType\"[\n" +
+	"\tfcm_token\x18\x02 \x01(\tB\a\xbaH\x04r\x02\x10\x01R\bfcmToken\"\xa1\x02\n" +
+	"\x13NotificationPayload\x12\x1d\n" +
+	"\x05title\x18\x01 \x01(\tB\a\xbaH\x04r\x02\x18dR\x05title\x12\x1c\n" +
+	"\x04body\x18\x02 \x01(\tB\b\xbaH\x05r\x03\x18\xe8\aR\x04body\x12%\n" +
+	"\tdeep_link\x18\x03 \x01(\tB\b\xbaH\x05r\x03(\x80\x10R\bdeepLink\x12\x16\n" +
+	"\x06locale\x18\x04 \x01(\tR\x06locale\x12U\n" +
+	"\x04data\x18\x05 \x03(\v2(.remind.v1.NotificationPayload.DataEntryB\x17\xbaH\x14\x9a\x01\x11\x10\x14\"\x06r\x04\x10\x01(@*\x05r\x03(\x80\x04R\x04data\x1a7\n" +
+	"\tDataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9c\x04\n" +
+	"\x13CreateRemindRequest\x120\n" +
+	"\x05times\x18\x01 \x03(\v2\x1a.google.protobuf.TimestampR\x05times\x12!\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\x12+\n" +
+	"\adevices\x18\x03 \x03(\v2\x11.remind.v1.DeviceR\adevices\x12!\n" +
+	"\atask_id\x18\x04 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12:\n" +
+	"\ttask_type\x18\x05 \x01(\x0e2\x13.common.v1.TaskTypeB\b\xbaH\x05\x82\x01\x02 \x00R\btaskType\x12;\n" +
+	"\n" +
+	"escalation\x18\x06 \x01(\v2\x1b.remind.v1.EscalationPolicyR\n" +
+	"escalation\x128\n" +
+	"\apayload\x18\a \x01(\v2\x1e.remind.v1.NotificationPayloadR\apayload\x127\n" +
+	"\ttarget_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\btargetAt\x12\x1a\n" +
+	"\btemplate\x18\t \x01(\tR\btemplate\x12#\n" +
+	"\rauto_schedule\x18\n" +
+	" \x01(\bR\fautoSchedule\x123\n" +
+	"\bcatch_up\x18\v \x01(\x0e2\x18.remind.v1.CatchUpPolicyR\acatchUp\"\xb2\x01\n" +
+	"\vTimeOutcome\x12A\n" +
+	"\x0erequested_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\rrequestedTime\x120\n" +
+	"\x06result\x18\x02 \x01(\x0e2\x18.remind.v1.CatchUpResultR\x06result\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\"\x8a\x01\n" +
+	"\x10EscalationPolicy\x12,\n" +
+	"\rafter_seconds\x18\x01 \x01(\x05B\a\xbaH\x04\x1a\x02(<R\fafterSeconds\x12H\n" +
+	"\x05steps\x18\x02 \x03(\x0e2\x1b.remind.v1.EscalationActionB\x15\xbaH\x12\x92\x01\x0f\b\x01\x10\x05\"\t\x82\x01\x06\x18\x01\x18\x02\x18\x03R\x05steps\"[\n" +
 	"\x13CancelRemindRequest\x12!\n" +
 	"\atask_id\x18\x01 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06taskId\x12!\n" +
-	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\x9b\x03\n" +
+	"\auser_id\x18\x02 \x01(\tB\b\xbaH\x05r\x03\xb0\x01\x01R\x06userId\"\xdd\x04\n" +
 	"\x06Remind\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\tR\x02id\x12.\n" +
 	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x17\n" +
@@ -558,18 +1707,90 @@ const file_remind_v1_remind_proto_rawDesc = "" +
 	"\n" +
 	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12,\n" +
 	"\x12slide_window_width\x18\n" +
-	" \x01(\x05R\x10slideWindowWidth\"T\n" +
+	" \x01(\x05R\x10slideWindowWidth\x12C\n" +
+	"\x0facknowledged_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x0eacknowledgedAt\x12)\n" +
+	"\x10escalation_level\x18\f \x01(\x05R\x0fescalationLevel\x12\x16\n" +
+	"\x06paused\x18\r \x01(\bR\x06paused\x128\n" +
+	"\apayload\x18\x0e \x01(\v2\x1e.remind.v1.NotificationPayloadR\apayload\"T\n" +
 	"\x0fRemindsResponse\x12+\n" +
 	"\areminds\x18\x01 \x03(\v2\x11.remind.v1.RemindR\areminds\x12\x14\n" +
-	"\x05count\x18\x02 \x01(\x05R\x05count\";\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x97\x02\n" +
+	"\x15CreateRemindsResponse\x12+\n" +
+	"\areminds\x18\x01 \x03(\v2\x11.remind.v1.RemindR\areminds\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12;\n" +
+	"\rtime_outcomes\x18\x03 \x03(\v2\x16.remind.v1.TimeOutcomeR\ftimeOutcomes\x12?\n" +
+	"\rdropped_times\x18\x04 \x03(\v2\x1a.google.protobuf.TimestampR\fdroppedTimes\x12=\n" +
+	"\fshrunk_times\x18\x05 \x03(\v2\x1a.google.protobuf.TimestampR\vshrunkTimes\"\x87\x02\n" +
+	"\x06Digest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12K\n" +
+	"\x13representative_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12representativeTime\x12=\n" +
+	"\fwindow_start\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd\x12\x1d\n" +
+	"\n" +
+	"remind_ids\x18\x05 \x03(\tR\tremindIds\"T\n" +
+	"\x0fDigestsResponse\x12+\n" +
+	"\adigests\x18\x01 \x03(\v2\x11.remind.v1.DigestR\adigests\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"\x94\x01\n" +
+	"\x16PreviewScheduleRequest\x12>\n" +
+	"\bdeadline\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampB\x06\xbaH\x03\xc8\x01\x01R\bdeadline\x12:\n" +
+	"\ttask_type\x18\x02 \x01(\x0e2\x13.common.v1.TaskTypeB\b\xbaH\x05\x82\x01\x02 \x00R\btaskType\"m\n" +
+	"\rScheduledTime\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12,\n" +
+	"\x12slide_window_width\x18\x02 \x01(\x05R\x10slideWindowWidth\"B\n" +
+	"\x10ScheduleResponse\x12.\n" +
+	"\x05times\x18\x01 \x03(\v2\x18.remind.v1.ScheduledTimeR\x05times\"\xfb\x01\n" +
+	"\rRemindPreview\x12.\n" +
+	"\x04time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12,\n" +
+	"\x12slide_window_width\x18\x02 \x01(\x05R\x10slideWindowWidth\x125\n" +
+	"\bcategory\x18\x03 \x01(\x0e2\x19.remind.v1.WindowCategoryR\bcategory\x12=\n" +
+	"\vadjustments\x18\x04 \x03(\x0e2\x1b.remind.v1.PolicyAdjustmentR\vadjustments\x12\x16\n" +
+	"\x06paused\x18\x05 \x01(\bR\x06paused\"\xd4\x02\n" +
+	"\x16PreviewRemindsResponse\x122\n" +
+	"\areminds\x18\x01 \x03(\v2\x18.remind.v1.RemindPreviewR\areminds\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\x12C\n" +
+	"\x0frequested_times\x18\x03 \x03(\v2\x1a.google.protobuf.TimestampR\x0erequestedTimes\x12?\n" +
+	"\rdropped_times\x18\x04 \x03(\v2\x1a.google.protobuf.TimestampR\fdroppedTimes\x12;\n" +
+	"\rtime_outcomes\x18\x05 \x03(\v2\x16.remind.v1.TimeOutcomeR\ftimeOutcomes\x12-\n" +
+	"\x12window_explanation\x18\x06 \x01(\tR\x11windowExplanation\";\n" +
 	"\x0eRemindResponse\x12)\n" +
-	"\x06remind\x18\x01 \x01(\v2\x11.remind.v1.RemindR\x06remind\"6\n" +
+	"\x06remind\x18\x01 \x01(\v2\x11.remind.v1.RemindR\x06remind\"x\n" +
+	"\x19AcknowledgeRemindResponse\x12)\n" +
+	"\x06remind\x18\x01 \x01(\v2\x11.remind.v1.RemindR\x06remind\x120\n" +
+	"\x14cancelled_remind_ids\x18\x02 \x03(\tR\x12cancelledRemindIds\"6\n" +
 	"\x16UpdateThrottledRequest\x12\x1c\n" +
 	"\tthrottled\x18\x01 \x01(\bR\tthrottled\"U\n" +
 	"\rErrorResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12\x14\n" +
-	"\x05field\x18\x03 \x01(\tR\x05fieldB\xb4\x01\n" +
+	"\x05field\x18\x03 \x01(\tR\x05field*\x81\x01\n" +
+	"\rCatchUpPolicy\x12\x1f\n" +
+	"\x1bCATCH_UP_POLICY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16CATCH_UP_POLICY_REJECT\x10\x01\x12\x18\n" +
+	"\x14CATCH_UP_POLICY_DROP\x10\x02\x12\x19\n" +
+	"\x15CATCH_UP_POLICY_CLAMP\x10\x03*\x84\x01\n" +
+	"\rCatchUpResult\x12\x1f\n" +
+	"\x1bCATCH_UP_RESULT_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CATCH_UP_RESULT_KEPT\x10\x01\x12\x1b\n" +
+	"\x17CATCH_UP_RESULT_DROPPED\x10\x02\x12\x1b\n" +
+	"\x17CATCH_UP_RESULT_CLAMPED\x10\x03*\x9e\x01\n" +
+	"\x10EscalationAction\x12!\n" +
+	"\x1dESCALATION_ACTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dESCALATION_ACTION_ADD_DEVICES\x10\x01\x12#\n" +
+	"\x1fESCALATION_ACTION_NARROW_WINDOW\x10\x02\x12\x1f\n" +
+	"\x1bESCALATION_ACTION_FOLLOW_UP\x10\x03*o\n" +
+	"\x0eWindowCategory\x12\x1f\n" +
+	"\x1bWINDOW_CATEGORY_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16WINDOW_CATEGORY_TARGET\x10\x01\x12 \n" +
+	"\x1cWINDOW_CATEGORY_INTERMEDIATE\x10\x02*\xf8\x01\n" +
+	"\x10PolicyAdjustment\x12!\n" +
+	"\x1dPOLICY_ADJUSTMENT_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPOLICY_ADJUSTMENT_QUIET_HOURS\x10\x01\x12 \n" +
+	"\x1cPOLICY_ADJUSTMENT_RATE_LIMIT\x10\x02\x12\x1d\n" +
+	"\x19POLICY_ADJUSTMENT_DENSITY\x10\x03\x12\x1e\n" +
+	"\x1aPOLICY_ADJUSTMENT_CATCH_UP\x10\x04\x12\x1e\n" +
+	"\x1aPOLICY_ADJUSTMENT_ADAPTIVE\x10\x05\x12\x1d\n" +
+	"\x19POLICY_ADJUSTMENT_OVERLAP\x10\x06B\xb4\x01\n" +
 	"\rcom.remind.v1B\vRemindProtoP\x01ZQgithub.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1;remindv1\xa2\x02\x03RXX\xaa\x02\tRemind.V1\xca\x02\tRemind\\V1\xe2\x02\x15Remind\\V1\\GPBMetadata\xea\x02\n" +
 	"Remind::V1b\x06proto3"
 
@@ -585,35 +1806,85 @@ func file_remind_v1_remind_proto_rawDescGZIP() []byte {
 	return file_remind_v1_remind_proto_rawDescData
 }
 
-var file_remind_v1_remind_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_remind_v1_remind_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
+var file_remind_v1_remind_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_remind_v1_remind_proto_goTypes = []any{
-	(*Device)(nil),                 // 0: remind.v1.Device
-	(*CreateRemindRequest)(nil),    // 1: remind.v1.CreateRemindRequest
-	(*CancelRemindRequest)(nil),    // 2: remind.v1.CancelRemindRequest
-	(*Remind)(nil),                 // 3: remind.v1.Remind
-	(*RemindsResponse)(nil),        // 4: remind.v1.RemindsResponse
-	(*RemindResponse)(nil),         // 5: remind.v1.RemindResponse
-	(*UpdateThrottledRequest)(nil), // 6: remind.v1.UpdateThrottledRequest
-	(*ErrorResponse)(nil),          // 7: remind.v1.ErrorResponse
-	(*timestamppb.Timestamp)(nil),  // 8: google.protobuf.Timestamp
-	(v1.TaskType)(0),               // 9: common.v1.TaskType
+	(CatchUpPolicy)(0),                // 0: remind.v1.CatchUpPolicy
+	(CatchUpResult)(0),                // 1: remind.v1.CatchUpResult
+	(EscalationAction)(0),             // 2: remind.v1.EscalationAction
+	(WindowCategory)(0),               // 3: remind.v1.WindowCategory
+	(PolicyAdjustment)(0),             // 4: remind.v1.PolicyAdjustment
+	(*Device)(nil),                    // 5: remind.v1.Device
+	(*NotificationPayload)(nil),       // 6: remind.v1.NotificationPayload
+	(*CreateRemindRequest)(nil),       // 7: remind.v1.CreateRemindRequest
+	(*TimeOutcome)(nil),               // 8: remind.v1.TimeOutcome
+	(*EscalationPolicy)(nil),          // 9: remind.v1.EscalationPolicy
+	(*CancelRemindRequest)(nil),       // 10: remind.v1.CancelRemindRequest
+	(*Remind)(nil),                    // 11: remind.v1.Remind
+	(*RemindsResponse)(nil),           // 12: remind.v1.RemindsResponse
+	(*CreateRemindsResponse)(nil),     // 13: remind.v1.CreateRemindsResponse
+	(*Digest)(nil),                    // 14: remind.v1.Digest
+	(*DigestsResponse)(nil),           // 15: remind.v1.DigestsResponse
+	(*PreviewScheduleRequest)(nil),    // 16: remind.v1.PreviewScheduleRequest
+	(*ScheduledTime)(nil),             // 17: remind.v1.ScheduledTime
+	(*ScheduleResponse)(nil),          // 18: remind.v1.ScheduleResponse
+	(*RemindPreview)(nil),             // 19: remind.v1.RemindPreview
+	(*PreviewRemindsResponse)(nil),    // 20: remind.v1.PreviewRemindsResponse
+	(*RemindResponse)(nil),            // 21: remind.v1.RemindResponse
+	(*AcknowledgeRemindResponse)(nil), // 22: remind.v1.AcknowledgeRemindResponse
+	(*UpdateThrottledRequest)(nil),    // 23: remind.v1.UpdateThrottledRequest
+	(*ErrorResponse)(nil),             // 24: remind.v1.ErrorResponse
+	nil,                               // 25: remind.v1.NotificationPayload.DataEntry
+	(*timestamppb.Timestamp)(nil),     // 26: google.protobuf.Timestamp
+	(v1.TaskType)(0),                  // 27: common.v1.TaskType
 }
 var file_remind_v1_remind_proto_depIdxs = []int32{
-	8,  // 0: remind.v1.CreateRemindRequest.times:type_name -> google.protobuf.Timestamp
-	0,  // 1: remind.v1.CreateRemindRequest.devices:type_name -> remind.v1.Device
-	9,  // 2: remind.v1.CreateRemindRequest.task_type:type_name -> common.v1.TaskType
-	8,  // 3: remind.v1.Remind.time:type_name -> google.protobuf.Timestamp
-	0,  // 4: remind.v1.Remind.devices:type_name -> remind.v1.Device
-	9,  // 5: remind.v1.Remind.task_type:type_name -> common.v1.TaskType
-	8,  // 6: remind.v1.Remind.created_at:type_name -> google.protobuf.Timestamp
-	8,  // 7: remind.v1.Remind.updated_at:type_name -> google.protobuf.Timestamp
-	3,  // 8: remind.v1.RemindsResponse.reminds:type_name -> remind.v1.Remind
-	3,  // 9: remind.v1.RemindResponse.remind:type_name -> remind.v1.Remind
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	25, // 0: remind.v1.NotificationPayload.data:type_name -> remind.v1.NotificationPayload.DataEntry
+	26, // 1: remind.v1.CreateRemindRequest.times:type_name -> google.protobuf.Timestamp
+	5,  // 2: remind.v1.CreateRemindRequest.devices:type_name -> remind.v1.Device
+	27, // 3: remind.v1.CreateRemindRequest.task_type:type_name -> common.v1.TaskType
+	9,  // 4: remind.v1.CreateRemindRequest.escalation:type_name -> remind.v1.EscalationPolicy
+	6,  // 5: remind.v1.CreateRemindRequest.payload:type_name -> remind.v1.NotificationPayload
+	26, // 6: remind.v1.CreateRemindRequest.target_at:type_name -> google.protobuf.Timestamp
+	0,  // 7: remind.v1.CreateRemindRequest.catch_up:type_name -> remind.v1.CatchUpPolicy
+	26, // 8: remind.v1.TimeOutcome.requested_time:type_name -> google.protobuf.Timestamp
+	1,  // 9: remind.v1.TimeOutcome.result:type_name -> remind.v1.CatchUpResult
+	26, // 10: remind.v1.TimeOutcome.time:type_name -> google.protobuf.Timestamp
+	2,  // 11: remind.v1.EscalationPolicy.steps:type_name -> remind.v1.EscalationAction
+	26, // 12: remind.v1.Remind.time:type_name -> google.protobuf.Timestamp
+	5,  // 13: remind.v1.Remind.devices:type_name -> remind.v1.Device
+	27, // 14: remind.v1.Remind.task_type:type_name -> common.v1.TaskType
+	26, // 15: remind.v1.Remind.created_at:type_name -> google.protobuf.Timestamp
+	26, // 16: remind.v1.Remind.updated_at:type_name -> google.protobuf.Timestamp
+	26, // 17: remind.v1.Remind.acknowledged_at:type_name -> google.protobuf.Timestamp
+	6,  // 18: remind.v1.Remind.payload:type_name -> remind.v1.NotificationPayload
+	11, // 19: remind.v1.RemindsResponse.reminds:type_name -> remind.v1.Remind
+	11, // 20: remind.v1.CreateRemindsResponse.reminds:type_name -> remind.v1.Remind
+	8,  // 21: remind.v1.CreateRemindsResponse.time_outcomes:type_name -> remind.v1.TimeOutcome
+	26, // 22: remind.v1.CreateRemindsResponse.dropped_times:type_name -> google.protobuf.Timestamp
+	26, // 23: remind.v1.CreateRemindsResponse.shrunk_times:type_name -> google.protobuf.Timestamp
+	26, // 24: remind.v1.Digest.representative_time:type_name -> google.protobuf.Timestamp
+	26, // 25: remind.v1.Digest.window_start:type_name -> google.protobuf.Timestamp
+	26, // 26: remind.v1.Digest.window_end:type_name -> google.protobuf.Timestamp
+	14, // 27: remind.v1.DigestsResponse.digests:type_name -> remind.v1.Digest
+	26, // 28: remind.v1.PreviewScheduleRequest.deadline:type_name -> google.protobuf.Timestamp
+	27, // 29: remind.v1.PreviewScheduleRequest.task_type:type_name -> common.v1.TaskType
+	26, // 30: remind.v1.ScheduledTime.time:type_name -> google.protobuf.Timestamp
+	17, // 31: remind.v1.ScheduleResponse.times:type_name -> remind.v1.ScheduledTime
+	26, // 32: remind.v1.RemindPreview.time:type_name -> google.protobuf.Timestamp
+	3,  // 33: remind.v1.RemindPreview.category:type_name -> remind.v1.WindowCategory
+	4,  // 34: remind.v1.RemindPreview.adjustments:type_name -> remind.v1.PolicyAdjustment
+	19, // 35: remind.v1.PreviewRemindsResponse.reminds:type_name -> remind.v1.RemindPreview
+	26, // 36: remind.v1.PreviewRemindsResponse.requested_times:type_name -> google.protobuf.Timestamp
+	26, // 37: remind.v1.PreviewRemindsResponse.dropped_times:type_name -> google.protobuf.Timestamp
+	8,  // 38: remind.v1.PreviewRemindsResponse.time_outcomes:type_name -> remind.v1.TimeOutcome
+	11, // 39: remind.v1.RemindResponse.remind:type_name -> remind.v1.Remind
+	11, // 40: remind.v1.AcknowledgeRemindResponse.remind:type_name -> remind.v1.Remind
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_remind_v1_remind_proto_init() }
@@ -626,13 +1897,14 @@ func file_remind_v1_remind_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remind_v1_remind_proto_rawDesc), len(file_remind_v1_remind_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   8,
+			NumEnums:      5,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_remind_v1_remind_proto_goTypes,
 		DependencyIndexes: file_remind_v1_remind_proto_depIdxs,
+		EnumInfos:         file_remind_v1_remind_proto_enumTypes,
 		MessageInfos:      file_remind_v1_remind_proto_msgTypes,
 	}.Build()
 	File_remind_v1_remind_proto = out.File
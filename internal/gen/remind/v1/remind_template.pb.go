@@ -0,0 +1,378 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: remind/v1/remind_template.proto
+
+package remindv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TemplateOffsets lists, for one task type, when to remind relative to the target time
+type TemplateOffsets struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TaskType      v1.TaskType            `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
+	OffsetSeconds []int32                `protobuf:"varint,2,rep,packed,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"` // seconds before the target time
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TemplateOffsets) Reset() {
+	*x = TemplateOffsets{}
+	mi := &file_remind_v1_remind_template_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TemplateOffsets) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TemplateOffsets) ProtoMessage() {}
+
+func (x *TemplateOffsets) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_template_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TemplateOffsets.ProtoReflect.Descriptor instead.
+func (*TemplateOffsets) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_template_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TemplateOffsets) GetTaskType() v1.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v1.TaskType(0)
+}
+
+func (x *TemplateOffsets) GetOffsetSeconds() []int32 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return nil
+}
+
+// RemindTemplate is a named set of offsets used to generate remind times
+type RemindTemplate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Offsets       []*TemplateOffsets     `protobuf:"bytes,2,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindTemplate) Reset() {
+	*x = RemindTemplate{}
+	mi := &file_remind_v1_remind_template_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindTemplate) ProtoMessage() {}
+
+func (x *RemindTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_template_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindTemplate.ProtoReflect.Descriptor instead.
+func (*RemindTemplate) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_template_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RemindTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RemindTemplate) GetOffsets() []*TemplateOffsets {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+func (x *RemindTemplate) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RemindTemplate) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// PutRemindTemplateRequest creates a template or replaces all offsets of an existing one
+type PutRemindTemplateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offsets       []*TemplateOffsets     `protobuf:"bytes,1,rep,name=offsets,proto3" json:"offsets,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PutRemindTemplateRequest) Reset() {
+	*x = PutRemindTemplateRequest{}
+	mi := &file_remind_v1_remind_template_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutRemindTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutRemindTemplateRequest) ProtoMessage() {}
+
+func (x *PutRemindTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_template_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutRemindTemplateRequest.ProtoReflect.Descriptor instead.
+func (*PutRemindTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_template_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PutRemindTemplateRequest) GetOffsets() []*TemplateOffsets {
+	if x != nil {
+		return x.Offsets
+	}
+	return nil
+}
+
+// RemindTemplateResponse is the response containing a single template
+type RemindTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Template      *RemindTemplate        `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindTemplateResponse) Reset() {
+	*x = RemindTemplateResponse{}
+	mi := &file_remind_v1_remind_template_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindTemplateResponse) ProtoMessage() {}
+
+func (x *RemindTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_template_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindTemplateResponse.ProtoReflect.Descriptor instead.
+func (*RemindTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_template_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemindTemplateResponse) GetTemplate() *RemindTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+// RemindTemplatesResponse is the response containing all templates
+type RemindTemplatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Templates     []*RemindTemplate      `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemindTemplatesResponse) Reset() {
+	*x = RemindTemplatesResponse{}
+	mi := &file_remind_v1_remind_template_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemindTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemindTemplatesResponse) ProtoMessage() {}
+
+func (x *RemindTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_remind_template_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemindTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*RemindTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_remind_template_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RemindTemplatesResponse) GetTemplates() []*RemindTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+func (x *RemindTemplatesResponse) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+var File_remind_v1_remind_template_proto protoreflect.FileDescriptor
+
+const file_remind_v1_remind_template_proto_rawDesc = "" +
+	"\n" +
+	"\x1fremind/v1/remind_template.proto\x12\tremind.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16common/v1/common.proto\"\x86\x01\n" +
+	"\x0fTemplateOffsets\x12:\n" +
+	"\ttask_type\x18\x01 \x01(\x0e2\x13.common.v1.TaskTypeB\b\xbaH\x05\x82\x01\x02 \x00R\btaskType\x127\n" +
+	"\x0eoffset_seconds\x18\x02 \x03(\x05B\x10\xbaH\r\x92\x01\n" +
+	"\b\x01\x10\n" +
+	"\"\x04\x1a\x02(\x00R\roffsetSeconds\"\xd0\x01\n" +
+	"\x0eRemindTemplate\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\aoffsets\x18\x02 \x03(\v2\x1a.remind.v1.TemplateOffsetsR\aoffsets\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"Z\n" +
+	"\x18PutRemindTemplateRequest\x12>\n" +
+	"\aoffsets\x18\x01 \x03(\v2\x1a.remind.v1.TemplateOffsetsB\b\xbaH\x05\x92\x01\x02\b\x01R\aoffsets\"O\n" +
+	"\x16RemindTemplateResponse\x125\n" +
+	"\btemplate\x18\x01 \x01(\v2\x19.remind.v1.RemindTemplateR\btemplate\"h\n" +
+	"\x17RemindTemplatesResponse\x127\n" +
+	"\ttemplates\x18\x01 \x03(\v2\x19.remind.v1.RemindTemplateR\ttemplates\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05countB\xbc\x01\n" +
+	"\rcom.remind.v1B\x13RemindTemplateProtoP\x01ZQgithub.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1;remindv1\xa2\x02\x03RXX\xaa\x02\tRemind.V1\xca\x02\tRemind\\V1\xe2\x02\x15Remind\\V1\\GPBMetadata\xea\x02\n" +
+	"Remind::V1b\x06proto3"
+
+var (
+	file_remind_v1_remind_template_proto_rawDescOnce sync.Once
+	file_remind_v1_remind_template_proto_rawDescData []byte
+)
+
+func file_remind_v1_remind_template_proto_rawDescGZIP() []byte {
+	file_remind_v1_remind_template_proto_rawDescOnce.Do(func() {
+		file_remind_v1_remind_template_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_remind_v1_remind_template_proto_rawDesc), len(file_remind_v1_remind_template_proto_rawDesc)))
+	})
+	return file_remind_v1_remind_template_proto_rawDescData
+}
+
+var file_remind_v1_remind_template_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_remind_v1_remind_template_proto_goTypes = []any{
+	(*TemplateOffsets)(nil),          // 0: remind.v1.TemplateOffsets
+	(*RemindTemplate)(nil),           // 1: remind.v1.RemindTemplate
+	(*PutRemindTemplateRequest)(nil), // 2: remind.v1.PutRemindTemplateRequest
+	(*RemindTemplateResponse)(nil),   // 3: remind.v1.RemindTemplateResponse
+	(*RemindTemplatesResponse)(nil),  // 4: remind.v1.RemindTemplatesResponse
+	(v1.TaskType)(0),                 // 5: common.v1.TaskType
+	(*timestamppb.Timestamp)(nil),    // 6: google.protobuf.Timestamp
+}
+var file_remind_v1_remind_template_proto_depIdxs = []int32{
+	5, // 0: remind.v1.TemplateOffsets.task_type:type_name -> common.v1.TaskType
+	0, // 1: remind.v1.RemindTemplate.offsets:type_name -> remind.v1.TemplateOffsets
+	6, // 2: remind.v1.RemindTemplate.created_at:type_name -> google.protobuf.Timestamp
+	6, // 3: remind.v1.RemindTemplate.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 4: remind.v1.PutRemindTemplateRequest.offsets:type_name -> remind.v1.TemplateOffsets
+	1, // 5: remind.v1.RemindTemplateResponse.template:type_name -> remind.v1.RemindTemplate
+	1, // 6: remind.v1.RemindTemplatesResponse.templates:type_name -> remind.v1.RemindTemplate
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
+}
+
+func init() { file_remind_v1_remind_template_proto_init() }
+func file_remind_v1_remind_template_proto_init() {
+	if File_remind_v1_remind_template_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remind_v1_remind_template_proto_rawDesc), len(file_remind_v1_remind_template_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_remind_v1_remind_template_proto_goTypes,
+		DependencyIndexes: file_remind_v1_remind_template_proto_depIdxs,
+		MessageInfos:      file_remind_v1_remind_template_proto_msgTypes,
+	}.Build()
+	File_remind_v1_remind_template_proto = out.File
+	file_remind_v1_remind_template_proto_goTypes = nil
+	file_remind_v1_remind_template_proto_depIdxs = nil
+}
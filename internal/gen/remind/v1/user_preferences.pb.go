@@ -0,0 +1,478 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: remind/v1/user_preferences.proto
+
+package remindv1
+
+import (
+	_ "buf.build/gen/go/bufbuild/protovalidate/protocolbuffers/go/buf/validate"
+	v1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/common/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QuietWindow is a daily period in the user's timezone during which reminds should not fire.
+// A window whose end is before its start runs past midnight into the next day.
+type QuietWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Weekday       int32                  `protobuf:"varint,1,opt,name=weekday,proto3" json:"weekday,omitempty"`                            // 0 = Sunday
+	StartMinute   int32                  `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"` // minutes from midnight
+	EndMinute     int32                  `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`       // minutes from midnight
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QuietWindow) Reset() {
+	*x = QuietWindow{}
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QuietWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuietWindow) ProtoMessage() {}
+
+func (x *QuietWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuietWindow.ProtoReflect.Descriptor instead.
+func (*QuietWindow) Descriptor() ([]byte, []int) {
+	return file_remind_v1_user_preferences_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QuietWindow) GetWeekday() int32 {
+	if x != nil {
+		return x.Weekday
+	}
+	return 0
+}
+
+func (x *QuietWindow) GetStartMinute() int32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *QuietWindow) GetEndMinute() int32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+// WindowOverride replaces the default slide window widths for one task type
+type WindowOverride struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TaskType             v1.TaskType            `protobuf:"varint,1,opt,name=task_type,json=taskType,proto3,enum=common.v1.TaskType" json:"task_type,omitempty"`
+	TargetWidth          int32                  `protobuf:"varint,2,opt,name=target_width,json=targetWidth,proto3" json:"target_width,omitempty"`                              // seconds, 0 keeps the default
+	IntermediateMaxWidth int32                  `protobuf:"varint,3,opt,name=intermediate_max_width,json=intermediateMaxWidth,proto3" json:"intermediate_max_width,omitempty"` // seconds, 0 keeps the default
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *WindowOverride) Reset() {
+	*x = WindowOverride{}
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WindowOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WindowOverride) ProtoMessage() {}
+
+func (x *WindowOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WindowOverride.ProtoReflect.Descriptor instead.
+func (*WindowOverride) Descriptor() ([]byte, []int) {
+	return file_remind_v1_user_preferences_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WindowOverride) GetTaskType() v1.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v1.TaskType(0)
+}
+
+func (x *WindowOverride) GetTargetWidth() int32 {
+	if x != nil {
+		return x.TargetWidth
+	}
+	return 0
+}
+
+func (x *WindowOverride) GetIntermediateMaxWidth() int32 {
+	if x != nil {
+		return x.IntermediateMaxWidth
+	}
+	return 0
+}
+
+// UserPreferences holds the per-user settings applied when creating reminds
+type UserPreferences struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	UserId          string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DefaultDevices  []*Device              `protobuf:"bytes,2,rep,name=default_devices,json=defaultDevices,proto3" json:"default_devices,omitempty"`
+	Timezone        string                 `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	QuietHours      []*QuietWindow         `protobuf:"bytes,4,rep,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	WindowOverrides []*WindowOverride      `protobuf:"bytes,5,rep,name=window_overrides,json=windowOverrides,proto3" json:"window_overrides,omitempty"`
+	Paused          bool                   `protobuf:"varint,6,opt,name=paused,proto3" json:"paused,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ResumeAt        *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=resume_at,json=resumeAt,proto3" json:"resume_at,omitempty"` // set while paused with an automatic resume
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UserPreferences) Reset() {
+	*x = UserPreferences{}
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferences) ProtoMessage() {}
+
+func (x *UserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferences.ProtoReflect.Descriptor instead.
+func (*UserPreferences) Descriptor() ([]byte, []int) {
+	return file_remind_v1_user_preferences_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserPreferences) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetDefaultDevices() []*Device {
+	if x != nil {
+		return x.DefaultDevices
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *UserPreferences) GetQuietHours() []*QuietWindow {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetWindowOverrides() []*WindowOverride {
+	if x != nil {
+		return x.WindowOverrides
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *UserPreferences) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *UserPreferences) GetResumeAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResumeAt
+	}
+	return nil
+}
+
+// PutUserPreferencesRequest replaces all preferences of a user
+type PutUserPreferencesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	DefaultDevices  []*Device              `protobuf:"bytes,1,rep,name=default_devices,json=defaultDevices,proto3" json:"default_devices,omitempty"`
+	Timezone        string                 `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"` // IANA time zone name, empty means UTC
+	QuietHours      []*QuietWindow         `protobuf:"bytes,3,rep,name=quiet_hours,json=quietHours,proto3" json:"quiet_hours,omitempty"`
+	WindowOverrides []*WindowOverride      `protobuf:"bytes,4,rep,name=window_overrides,json=windowOverrides,proto3" json:"window_overrides,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PutUserPreferencesRequest) Reset() {
+	*x = PutUserPreferencesRequest{}
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PutUserPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutUserPreferencesRequest) ProtoMessage() {}
+
+func (x *PutUserPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutUserPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*PutUserPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_user_preferences_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PutUserPreferencesRequest) GetDefaultDevices() []*Device {
+	if x != nil {
+		return x.DefaultDevices
+	}
+	return nil
+}
+
+func (x *PutUserPreferencesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PutUserPreferencesRequest) GetQuietHours() []*QuietWindow {
+	if x != nil {
+		return x.QuietHours
+	}
+	return nil
+}
+
+func (x *PutUserPreferencesRequest) GetWindowOverrides() []*WindowOverride {
+	if x != nil {
+		return x.WindowOverrides
+	}
+	return nil
+}
+
+// UserPreferencesResponse is the response containing a user's preferences
+type UserPreferencesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Preferences   *UserPreferences       `protobuf:"bytes,1,opt,name=preferences,proto3" json:"preferences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserPreferencesResponse) Reset() {
+	*x = UserPreferencesResponse{}
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserPreferencesResponse) ProtoMessage() {}
+
+func (x *UserPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_user_preferences_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*UserPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_user_preferences_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UserPreferencesResponse) GetPreferences() *UserPreferences {
+	if x != nil {
+		return x.Preferences
+	}
+	return nil
+}
+
+var File_remind_v1_user_preferences_proto protoreflect.FileDescriptor
+
+const file_remind_v1_user_preferences_proto_rawDesc = "" +
+	"\n" +
+	" remind/v1/user_preferences.proto\x12\tremind.v1\x1a\x1bbuf/validate/validate.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x16common/v1/common.proto\x1a\x16remind/v1/remind.proto\"\x8c\x01\n" +
+	"\vQuietWindow\x12#\n" +
+	"\aweekday\x18\x01 \x01(\x05B\t\xbaH\x06\x1a\x04\x18\x06(\x00R\aweekday\x12-\n" +
+	"\fstart_minute\x18\x02 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x10\xa0\v(\x00R\vstartMinute\x12)\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\x05B\n" +
+	"\xbaH\a\x1a\x05\x10\xa0\v(\x00R\tendMinute\"\xa5\x01\n" +
+	"\x0eWindowOverride\x12:\n" +
+	"\ttask_type\x18\x01 \x01(\x0e2\x13.common.v1.TaskTypeB\b\xbaH\x05\x82\x01\x02 \x00R\btaskType\x12!\n" +
+	"\ftarget_width\x18\x02 \x01(\x05R\vtargetWidth\x124\n" +
+	"\x16intermediate_max_width\x18\x03 \x01(\x05R\x14intermediateMaxWidth\"\xc8\x03\n" +
+	"\x0fUserPreferences\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12:\n" +
+	"\x0fdefault_devices\x18\x02 \x03(\v2\x11.remind.v1.DeviceR\x0edefaultDevices\x12\x1a\n" +
+	"\btimezone\x18\x03 \x01(\tR\btimezone\x127\n" +
+	"\vquiet_hours\x18\x04 \x03(\v2\x16.remind.v1.QuietWindowR\n" +
+	"quietHours\x12D\n" +
+	"\x10window_overrides\x18\x05 \x03(\v2\x19.remind.v1.WindowOverrideR\x0fwindowOverrides\x12\x16\n" +
+	"\x06paused\x18\x06 \x01(\bR\x06paused\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x127\n" +
+	"\tresume_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bresumeAt\"\xf8\x01\n" +
+	"\x19PutUserPreferencesRequest\x12:\n" +
+	"\x0fdefault_devices\x18\x01 \x03(\v2\x11.remind.v1.DeviceR\x0edefaultDevices\x12\x1a\n" +
+	"\btimezone\x18\x02 \x01(\tR\btimezone\x127\n" +
+	"\vquiet_hours\x18\x03 \x03(\v2\x16.remind.v1.QuietWindowR\n" +
+	"quietHours\x12D\n" +
+	"\x10window_overrides\x18\x04 \x03(\v2\x19.remind.v1.WindowOverrideR\x0fwindowOverridesJ\x04\b\x05\x10\x06\"W\n" +
+	"\x17UserPreferencesResponse\x12<\n" +
+	"\vpreferences\x18\x01 \x01(\v2\x1a.remind.v1.UserPreferencesR\vpreferencesB\xbd\x01\n" +
+	"\rcom.remind.v1B\x14UserPreferencesProtoP\x01ZQgithub.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1;remindv1\xa2\x02\x03RXX\xaa\x02\tRemind.V1\xca\x02\tRemind\\V1\xe2\x02\x15Remind\\V1\\GPBMetadata\xea\x02\n" +
+	"Remind::V1b\x06proto3"
+
+var (
+	file_remind_v1_user_preferences_proto_rawDescOnce sync.Once
+	file_remind_v1_user_preferences_proto_rawDescData []byte
+)
+
+func file_remind_v1_user_preferences_proto_rawDescGZIP() []byte {
+	file_remind_v1_user_preferences_proto_rawDescOnce.Do(func() {
+		file_remind_v1_user_preferences_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_remind_v1_user_preferences_proto_rawDesc), len(file_remind_v1_user_preferences_proto_rawDesc)))
+	})
+	return file_remind_v1_user_preferences_proto_rawDescData
+}
+
+var file_remind_v1_user_preferences_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_remind_v1_user_preferences_proto_goTypes = []any{
+	(*QuietWindow)(nil),               // 0: remind.v1.QuietWindow
+	(*WindowOverride)(nil),            // 1: remind.v1.WindowOverride
+	(*UserPreferences)(nil),           // 2: remind.v1.UserPreferences
+	(*PutUserPreferencesRequest)(nil), // 3: remind.v1.PutUserPreferencesRequest
+	(*UserPreferencesResponse)(nil),   // 4: remind.v1.UserPreferencesResponse
+	(v1.TaskType)(0),                  // 5: common.v1.TaskType
+	(*Device)(nil),                    // 6: remind.v1.Device
+	(*timestamppb.Timestamp)(nil),     // 7: google.protobuf.Timestamp
+}
+var file_remind_v1_user_preferences_proto_depIdxs = []int32{
+	5,  // 0: remind.v1.WindowOverride.task_type:type_name -> common.v1.TaskType
+	6,  // 1: remind.v1.UserPreferences.default_devices:type_name -> remind.v1.Device
+	0,  // 2: remind.v1.UserPreferences.quiet_hours:type_name -> remind.v1.QuietWindow
+	1,  // 3: remind.v1.UserPreferences.window_overrides:type_name -> remind.v1.WindowOverride
+	7,  // 4: remind.v1.UserPreferences.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 5: remind.v1.UserPreferences.updated_at:type_name -> google.protobuf.Timestamp
+	7,  // 6: remind.v1.UserPreferences.resume_at:type_name -> google.protobuf.Timestamp
+	6,  // 7: remind.v1.PutUserPreferencesRequest.default_devices:type_name -> remind.v1.Device
+	0,  // 8: remind.v1.PutUserPreferencesRequest.quiet_hours:type_name -> remind.v1.QuietWindow
+	1,  // 9: remind.v1.PutUserPreferencesRequest.window_overrides:type_name -> remind.v1.WindowOverride
+	2,  // 10: remind.v1.UserPreferencesResponse.preferences:type_name -> remind.v1.UserPreferences
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
+}
+
+func init() { file_remind_v1_user_preferences_proto_init() }
+func file_remind_v1_user_preferences_proto_init() {
+	if File_remind_v1_user_preferences_proto != nil {
+		return
+	}
+	file_remind_v1_remind_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remind_v1_user_preferences_proto_rawDesc), len(file_remind_v1_user_preferences_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_remind_v1_user_preferences_proto_goTypes,
+		DependencyIndexes: file_remind_v1_user_preferences_proto_depIdxs,
+		MessageInfos:      file_remind_v1_user_preferences_proto_msgTypes,
+	}.Build()
+	File_remind_v1_user_preferences_proto = out.File
+	file_remind_v1_user_preferences_proto_goTypes = nil
+	file_remind_v1_user_preferences_proto_depIdxs = nil
+}
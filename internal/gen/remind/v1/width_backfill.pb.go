@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: remind/v1/width_backfill.proto
+
+package remindv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// StartWidthBackfillRequest starts recomputing the stored slide window widths of future reminds
+type StartWidthBackfillRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// after_task_id starts the run after this task; empty starts from the first task
+	AfterTaskId   string `protobuf:"bytes,1,opt,name=after_task_id,json=afterTaskId,proto3" json:"after_task_id,omitempty"`
+	DryRun        bool   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"` // report the width changes without storing them
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartWidthBackfillRequest) Reset() {
+	*x = StartWidthBackfillRequest{}
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartWidthBackfillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartWidthBackfillRequest) ProtoMessage() {}
+
+func (x *StartWidthBackfillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartWidthBackfillRequest.ProtoReflect.Descriptor instead.
+func (*StartWidthBackfillRequest) Descriptor() ([]byte, []int) {
+	return file_remind_v1_width_backfill_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartWidthBackfillRequest) GetAfterTaskId() string {
+	if x != nil {
+		return x.AfterTaskId
+	}
+	return ""
+}
+
+func (x *StartWidthBackfillRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+// WidthChange is one remind whose stored width differs from the current policy
+type WidthChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RemindId      string                 `protobuf:"bytes,1,opt,name=remind_id,json=remindId,proto3" json:"remind_id,omitempty"`
+	TaskId        string                 `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Time          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	OldWidth      int32                  `protobuf:"varint,4,opt,name=old_width,json=oldWidth,proto3" json:"old_width,omitempty"` // seconds
+	NewWidth      int32                  `protobuf:"varint,5,opt,name=new_width,json=newWidth,proto3" json:"new_width,omitempty"` // seconds
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WidthChange) Reset() {
+	*x = WidthChange{}
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WidthChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidthChange) ProtoMessage() {}
+
+func (x *WidthChange) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidthChange.ProtoReflect.Descriptor instead.
+func (*WidthChange) Descriptor() ([]byte, []int) {
+	return file_remind_v1_width_backfill_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *WidthChange) GetRemindId() string {
+	if x != nil {
+		return x.RemindId
+	}
+	return ""
+}
+
+func (x *WidthChange) GetTaskId() string {
+	if x != nil {
+		return x.TaskId
+	}
+	return ""
+}
+
+func (x *WidthChange) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *WidthChange) GetOldWidth() int32 {
+	if x != nil {
+		return x.OldWidth
+	}
+	return 0
+}
+
+func (x *WidthChange) GetNewWidth() int32 {
+	if x != nil {
+		return x.NewWidth
+	}
+	return 0
+}
+
+// WidthBackfillProgressResponse reports the running or last finished backfill
+type WidthBackfillProgressResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Running          bool                   `protobuf:"varint,1,opt,name=running,proto3" json:"running,omitempty"`
+	DryRun           bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	StartedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`   // unset while running
+	LastTaskId       string                 `protobuf:"bytes,5,opt,name=last_task_id,json=lastTaskId,proto3" json:"last_task_id,omitempty"` // cursor the run continues from; another instance resumes an abandoned run from it
+	Tasks            int32                  `protobuf:"varint,6,opt,name=tasks,proto3" json:"tasks,omitempty"`
+	Reminds          int32                  `protobuf:"varint,7,opt,name=reminds,proto3" json:"reminds,omitempty"` // future reminds checked
+	Changed          int32                  `protobuf:"varint,8,opt,name=changed,proto3" json:"changed,omitempty"`
+	Failed           int32                  `protobuf:"varint,9,opt,name=failed,proto3" json:"failed,omitempty"`                                              // tasks whose update failed
+	Changes          []*WidthChange         `protobuf:"bytes,10,rep,name=changes,proto3" json:"changes,omitempty"`                                            // the diff, collected in dry runs only and capped at WIDTH_BACKFILL_MAX_CHANGES
+	Error            string                 `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`                                                // why the run stopped early
+	Overlapping      int32                  `protobuf:"varint,12,opt,name=overlapping,proto3" json:"overlapping,omitempty"`                                   // future reminds left unchanged because their windows overlap the next remind's and no width fits
+	ChangesTruncated bool                   `protobuf:"varint,13,opt,name=changes_truncated,json=changesTruncated,proto3" json:"changes_truncated,omitempty"` // changes stopped at WIDTH_BACKFILL_MAX_CHANGES; changed still counts every change
+	Skipped          int32                  `protobuf:"varint,14,opt,name=skipped,proto3" json:"skipped,omitempty"`                                           // changes not stored because the remind changed since it was read
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *WidthBackfillProgressResponse) Reset() {
+	*x = WidthBackfillProgressResponse{}
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WidthBackfillProgressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WidthBackfillProgressResponse) ProtoMessage() {}
+
+func (x *WidthBackfillProgressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_remind_v1_width_backfill_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WidthBackfillProgressResponse.ProtoReflect.Descriptor instead.
+func (*WidthBackfillProgressResponse) Descriptor() ([]byte, []int) {
+	return file_remind_v1_width_backfill_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *WidthBackfillProgressResponse) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+func (x *WidthBackfillProgressResponse) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *WidthBackfillProgressResponse) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *WidthBackfillProgressResponse) GetFinishedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return nil
+}
+
+func (x *WidthBackfillProgressResponse) GetLastTaskId() string {
+	if x != nil {
+		return x.LastTaskId
+	}
+	return ""
+}
+
+func (x *WidthBackfillProgressResponse) GetTasks() int32 {
+	if x != nil {
+		return x.Tasks
+	}
+	return 0
+}
+
+func (x *WidthBackfillProgressResponse) GetReminds() int32 {
+	if x != nil {
+		return x.Reminds
+	}
+	return 0
+}
+
+func (x *WidthBackfillProgressResponse) GetChanged() int32 {
+	if x != nil {
+		return x.Changed
+	}
+	return 0
+}
+
+func (x *WidthBackfillProgressResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *WidthBackfillProgressResponse) GetChanges() []*WidthChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+func (x *WidthBackfillProgressResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WidthBackfillProgressResponse) GetOverlapping() int32 {
+	if x != nil {
+		return x.Overlapping
+	}
+	return 0
+}
+
+func (x *WidthBackfillProgressResponse) GetChangesTruncated() bool {
+	if x != nil {
+		return x.ChangesTruncated
+	}
+	return false
+}
+
+func (x *WidthBackfillProgressResponse) GetSkipped() int32 {
+	if x != nil {
+		return x.Skipped
+	}
+	return 0
+}
+
+var File_remind_v1_width_backfill_proto protoreflect.FileDescriptor
+
+const file_remind_v1_width_backfill_proto_rawDesc = "" +
+	"\n" +
+	"\x1eremind/v1/width_backfill.proto\x12\tremind.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"X\n" +
+	"\x19StartWidthBackfillRequest\x12\"\n" +
+	"\rafter_task_id\x18\x01 \x01(\tR\vafterTaskId\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xad\x01\n" +
+	"\vWidthChange\x12\x1b\n" +
+	"\tremind_id\x18\x01 \x01(\tR\bremindId\x12\x17\n" +
+	"\atask_id\x18\x02 \x01(\tR\x06taskId\x12.\n" +
+	"\x04time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04time\x12\x1b\n" +
+	"\told_width\x18\x04 \x01(\x05R\boldWidth\x12\x1b\n" +
+	"\tnew_width\x18\x05 \x01(\x05R\bnewWidth\"\xff\x03\n" +
+	"\x1dWidthBackfillProgressResponse\x12\x18\n" +
+	"\arunning\x18\x01 \x01(\bR\arunning\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x129\n" +
+	"\n" +
+	"started_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartedAt\x12;\n" +
+	"\vfinished_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"finishedAt\x12 \n" +
+	"\flast_task_id\x18\x05 \x01(\tR\n" +
+	"lastTaskId\x12\x14\n" +
+	"\x05tasks\x18\x06 \x01(\x05R\x05tasks\x12\x18\n" +
+	"\areminds\x18\a \x01(\x05R\areminds\x12\x18\n" +
+	"\achanged\x18\b \x01(\x05R\achanged\x12\x16\n" +
+	"\x06failed\x18\t \x01(\x05R\x06failed\x120\n" +
+	"\achanges\x18\n" +
+	" \x03(\v2\x16.remind.v1.WidthChangeR\achanges\x12\x14\n" +
+	"\x05error\x18\v \x01(\tR\x05error\x12 \n" +
+	"\voverlapping\x18\f \x01(\x05R\voverlapping\x12+\n" +
+	"\x11changes_truncated\x18\r \x01(\bR\x10changesTruncated\x12\x18\n" +
+	"\askipped\x18\x0e \x01(\x05R\askippedB\xbb\x01\n" +
+	"\rcom.remind.v1B\x12WidthBackfillProtoP\x01ZQgithub.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1;remindv1\xa2\x02\x03RXX\xaa\x02\tRemind.V1\xca\x02\tRemind\\V1\xe2\x02\x15Remind\\V1\\GPBMetadata\xea\x02\n" +
+	"Remind::V1b\x06proto3"
+
+var (
+	file_remind_v1_width_backfill_proto_rawDescOnce sync.Once
+	file_remind_v1_width_backfill_proto_rawDescData []byte
+)
+
+func file_remind_v1_width_backfill_proto_rawDescGZIP() []byte {
+	file_remind_v1_width_backfill_proto_rawDescOnce.Do(func() {
+		file_remind_v1_width_backfill_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_remind_v1_width_backfill_proto_rawDesc), len(file_remind_v1_width_backfill_proto_rawDesc)))
+	})
+	return file_remind_v1_width_backfill_proto_rawDescData
+}
+
+var file_remind_v1_width_backfill_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_remind_v1_width_backfill_proto_goTypes = []any{
+	(*StartWidthBackfillRequest)(nil),     // 0: remind.v1.StartWidthBackfillRequest
+	(*WidthChange)(nil),                   // 1: remind.v1.WidthChange
+	(*WidthBackfillProgressResponse)(nil), // 2: remind.v1.WidthBackfillProgressResponse
+	(*timestamppb.Timestamp)(nil),         // 3: google.protobuf.Timestamp
+}
+var file_remind_v1_width_backfill_proto_depIdxs = []int32{
+	3, // 0: remind.v1.WidthChange.time:type_name -> google.protobuf.Timestamp
+	3, // 1: remind.v1.WidthBackfillProgressResponse.started_at:type_name -> google.protobuf.Timestamp
+	3, // 2: remind.v1.WidthBackfillProgressResponse.finished_at:type_name -> google.protobuf.Timestamp
+	1, // 3: remind.v1.WidthBackfillProgressResponse.changes:type_name -> remind.v1.WidthChange
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_remind_v1_width_backfill_proto_init() }
+func file_remind_v1_width_backfill_proto_init() {
+	if File_remind_v1_width_backfill_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_remind_v1_width_backfill_proto_rawDesc), len(file_remind_v1_width_backfill_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_remind_v1_width_backfill_proto_goTypes,
+		DependencyIndexes: file_remind_v1_width_backfill_proto_depIdxs,
+		MessageInfos:      file_remind_v1_width_backfill_proto_msgTypes,
+	}.Build()
+	File_remind_v1_width_backfill_proto = out.File
+	file_remind_v1_width_backfill_proto_goTypes = nil
+	file_remind_v1_width_backfill_proto_depIdxs = nil
+}
@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+type PauseHandler struct {
+	useCase app.PauseUseCase
+}
+
+func NewPauseHandler(useCase app.PauseUseCase) *PauseHandler {
+	return &PauseHandler{
+		useCase: useCase,
+	}
+}
+
+func (h *PauseHandler) PauseUser(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.Param("user_id")
+
+	slog.InfoContext(ctx, "handling pause user request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"user_id", userID,
+	)
+
+	var req remindv1.PauseUserRequest
+	if !bindOptionalProtoBody(c, &req) {
+		return
+	}
+
+	input := app.PauseUserInput{
+		UserID:   userID,
+		ResumeAt: nil,
+	}
+	if req.ResumeAt != nil {
+		resumeAt := req.ResumeAt.AsTime()
+		input.ResumeAt = &resumeAt
+	}
+
+	output, err := h.useCase.PauseUser(ctx, input)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "user paused successfully",
+		"user_id", userID,
+		"paused_count", len(output.PausedRemindIDs),
+	)
+
+	resp := &remindv1.PauseUserResponse{
+		UserId:          output.UserID,
+		PausedRemindIds: output.PausedRemindIDs,
+	}
+	if output.ResumeAt != nil {
+		resp.ResumeAt = timestamppb.New(*output.ResumeAt)
+	}
+
+	respondProto(c, http.StatusOK, resp)
+}
+
+func (h *PauseHandler) ResumeUser(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.Param("user_id")
+
+	slog.InfoContext(ctx, "handling resume user request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"user_id", userID,
+	)
+
+	var req remindv1.ResumeUserRequest
+	if !bindOptionalProtoBody(c, &req) {
+		return
+	}
+
+	output, err := h.useCase.ResumeUser(ctx, app.ResumeUserInput{
+		UserID: userID,
+		Policy: resumePolicyToString(req.Policy),
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "user resumed successfully",
+		"user_id", userID,
+		"restored_count", len(output.RestoredRemindIDs),
+		"skipped_count", len(output.SkippedRemindIDs),
+	)
+
+	respondProto(c, http.StatusOK, &remindv1.ResumeUserResponse{
+		UserId:            output.UserID,
+		RestoredRemindIds: output.RestoredRemindIDs,
+		SkippedRemindIds:  output.SkippedRemindIDs,
+	})
+}
+
+func (h *PauseHandler) RegisterRoutes(router *gin.RouterGroup) {
+	users := router.Group("/users/:user_id")
+	{
+		users.POST("/pause", h.PauseUser)
+		users.POST("/resume", h.ResumeUser)
+	}
+}
+
+// bindOptionalProtoBody decodes and validates the request body into req, leaving
+// req empty when there is no body. It writes the error response and returns
+// false when the body is invalid.
+func bindOptionalProtoBody(c *gin.Context, req proto.Message) bool {
+	ctx := c.Request.Context()
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return false
+	}
+
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := pjson.Unmarshal(body, req); err != nil {
+			slog.WarnContext(ctx, "request unmarshal failed",
+				"error", err,
+				"path", c.Request.URL.Path,
+			)
+			respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+			return false
+		}
+	}
+
+	if err := pjson.Validate(req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return false
+	}
+
+	return true
+}
+
+func respondProto(c *gin.Context, status int, resp proto.Message) {
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+// resumePolicyToString maps the proto enum to the domain policy name; unknown
+// values are passed through so the use case rejects them.
+func resumePolicyToString(p remindv1.ResumePolicy) string {
+	switch p {
+	case remindv1.ResumePolicy_RESUME_POLICY_UNSPECIFIED:
+		return ""
+	case remindv1.ResumePolicy_RESUME_POLICY_SKIP_PAST:
+		return "skip_past"
+	case remindv1.ResumePolicy_RESUME_POLICY_RESTORE_ALL:
+		return "restore_all"
+	default:
+		return p.String()
+	}
+}
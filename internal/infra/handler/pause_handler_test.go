@@ -0,0 +1,162 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func setupPauseTestRouter(t *testing.T, testDB *testutil.TestDB) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	remindUseCase := app.NewRemindUseCase(repository.NewRemindRepository(testDB.DB), prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	pauseUseCase := app.NewPauseUseCase(repository.NewTransactor(testDB.DB), prefsRepo, nil)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	handler.NewRemindHandler(remindUseCase).RegisterRoutes(api)
+	handler.NewPauseHandler(pauseUseCase).RegisterRoutes(api)
+
+	return router
+}
+
+type protoPauseUserResponse struct {
+	UserID          string   `json:"user_id"`
+	PausedRemindIDs []string `json:"paused_remind_ids"`
+}
+
+type protoResumeUserResponse struct {
+	UserID            string   `json:"user_id"`
+	RestoredRemindIDs []string `json:"restored_remind_ids"`
+	SkippedRemindIDs  []string `json:"skipped_remind_ids"`
+}
+
+func TestPauseHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupPauseTestRouter(t, testDB)
+
+	userID := uuid.Must(uuid.NewV7()).String()
+	createRemind := func() handler.RemindResponse {
+		rec := serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+			"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+			"user_id":   userID,
+			"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token"}},
+			"task_id":   uuid.Must(uuid.NewV7()).String(),
+			"task_type": "TASK_TYPE_NEAR",
+		})
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		var resp handler.RemindsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+		require.Len(t, resp.Reminds, 1)
+
+		return resp.Reminds[0]
+	}
+
+	first := createRemind()
+	assert.False(t, first.Paused)
+
+	// The body is optional; without resume_at the user stays paused until resumed.
+	rec := serveJSON(router, http.MethodPost, "/api/v1/users/"+userID+"/pause", nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var pauseResp protoPauseUserResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pauseResp))
+	assert.Equal(t, userID, pauseResp.UserID)
+	assert.Equal(t, []string{first.ID}, pauseResp.PausedRemindIDs)
+
+	// Reminds created while paused start out paused.
+	second := createRemind()
+	assert.True(t, second.Paused)
+
+	rec = serveJSON(router, http.MethodPost, "/api/v1/users/"+userID+"/resume", map[string]any{
+		"policy": "RESUME_POLICY_RESTORE_ALL",
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resumeResp protoResumeUserResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resumeResp))
+	assert.Equal(t, userID, resumeResp.UserID)
+	assert.ElementsMatch(t, []string{first.ID, second.ID}, resumeResp.RestoredRemindIDs)
+	assert.Empty(t, resumeResp.SkippedRemindIDs)
+}
+
+func TestPauseHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupPauseTestRouter(t, testDB)
+
+	tests := []struct {
+		name          string
+		path          string
+		requestBody   map[string]any
+		expectedField string
+	}{
+		{
+			name:          "pause with invalid user_id",
+			path:          "/api/v1/users/not-a-uuid/pause",
+			requestBody:   nil,
+			expectedField: "user_id",
+		},
+		{
+			name:          "pause with resume_at in the past",
+			path:          "/api/v1/users/" + uuid.Must(uuid.NewV7()).String() + "/pause",
+			requestBody:   map[string]any{"resume_at": time.Now().Add(-1 * time.Hour).Format(time.RFC3339)},
+			expectedField: "resume_at",
+		},
+		{
+			name:          "resume with invalid user_id",
+			path:          "/api/v1/users/not-a-uuid/resume",
+			requestBody:   nil,
+			expectedField: "user_id",
+		},
+		{
+			name:          "resume with unknown policy",
+			path:          "/api/v1/users/" + uuid.Must(uuid.NewV7()).String() + "/resume",
+			requestBody:   map[string]any{"policy": 99},
+			expectedField: "policy",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body any
+			if tt.requestBody != nil {
+				body = tt.requestBody
+			}
+
+			rec := serveJSON(router, http.MethodPost, tt.path, body)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var response handler.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "validation_error", response.Error)
+			assert.Equal(t, tt.expectedField, response.Field)
+		})
+	}
+}
@@ -63,39 +63,115 @@ func (h *RemindHandler) CreateRemind(c *gin.Context) {
 		return
 	}
 
-	devices := make([]app.DeviceInput, 0, len(req.Devices))
-	for _, d := range req.Devices {
-		devices = append(devices, app.DeviceInput{
-			DeviceID: d.DeviceId,
-			FCMToken: d.FcmToken,
-		})
+	output, err := h.useCase.CreateRemind(ctx, toCreateRemindInput(&req))
+	if err != nil {
+		handleError(c, err)
+
+		return
 	}
 
-	times := make([]time.Time, 0, len(req.Times))
-	for _, t := range req.Times {
-		times = append(times, t.AsTime())
+	slog.InfoContext(ctx, "reminds created successfully",
+		"task_id", req.TaskId,
+		"count", output.Count,
+	)
+	respondProtoCreateReminds(c, http.StatusCreated, output)
+}
+
+// PreviewRemind answers what CreateRemind would store for the request,
+// without storing anything.
+func (h *RemindHandler) PreviewRemind(c *gin.Context) {
+	ctx := c.Request.Context()
+	slog.InfoContext(ctx, "handling preview remind request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+	)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return
 	}
 
-	input := app.CreateRemindInput{
-		Times:    times,
-		UserID:   req.UserId,
-		Devices:  devices,
-		TaskID:   req.TaskId,
-		TaskType: taskTypeToString(req.TaskType),
+	var req remindv1.CreateRemindRequest
+	if err := pjson.Unmarshal(body, &req); err != nil {
+		slog.WarnContext(ctx, "request unmarshal failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	if err := pjson.Validate(&req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
 	}
 
-	output, err := h.useCase.CreateRemind(ctx, input)
+	output, err := h.useCase.PreviewRemind(ctx, toCreateRemindInput(&req))
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, err)
 
 		return
 	}
 
-	slog.InfoContext(ctx, "reminds created successfully",
-		"task_id", req.TaskId,
-		"count", output.Count,
+	respondProtoRemindPreview(c, http.StatusOK, output)
+}
+
+func (h *RemindHandler) PreviewSchedule(c *gin.Context) {
+	ctx := c.Request.Context()
+	slog.InfoContext(ctx, "handling preview schedule request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
 	)
-	respondProtoReminds(c, http.StatusCreated, output)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return
+	}
+
+	var req remindv1.PreviewScheduleRequest
+	if err := pjson.Unmarshal(body, &req); err != nil {
+		slog.WarnContext(ctx, "request unmarshal failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	if err := pjson.Validate(&req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	output, err := h.useCase.PreviewSchedule(ctx, app.PreviewScheduleInput{
+		Deadline: req.Deadline.AsTime(),
+		TaskType: taskTypeToString(req.TaskType),
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoSchedule(c, http.StatusOK, output)
 }
 
 func (h *RemindHandler) GetRemindsByTimeRange(c *gin.Context) {
@@ -121,9 +197,15 @@ func (h *RemindHandler) GetRemindsByTimeRange(c *gin.Context) {
 		End:   req.End,
 	}
 
+	if req.Mode == ListModeDigest {
+		h.getRemindDigestsByTimeRange(c, input)
+
+		return
+	}
+
 	output, err := h.useCase.GetRemindsByTimeRange(ctx, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, err)
 
 		return
 	}
@@ -136,6 +218,60 @@ func (h *RemindHandler) GetRemindsByTimeRange(c *gin.Context) {
 	respondProtoReminds(c, http.StatusOK, output)
 }
 
+func (h *RemindHandler) getRemindDigestsByTimeRange(c *gin.Context, input app.GetRemindsByTimeRangeInput) {
+	ctx := c.Request.Context()
+
+	output, err := h.useCase.GetRemindDigestsByTimeRange(ctx, input)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "remind digests retrieved successfully",
+		"count", output.Count,
+		"start", input.Start,
+		"end", input.End,
+	)
+	respondProtoDigests(c, http.StatusOK, output)
+}
+
+func (h *RemindHandler) GetDueReminds(c *gin.Context) {
+	ctx := c.Request.Context()
+	slog.InfoContext(ctx, "handling get due reminds request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+	)
+
+	var req GetDueRemindsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	at := req.At
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	output, err := h.useCase.GetDueReminds(ctx, app.GetDueRemindsInput{
+		At:               at,
+		ExcludeThrottled: req.ExcludeThrottled,
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoReminds(c, http.StatusOK, output)
+}
+
 func (h *RemindHandler) UpdateThrottled(c *gin.Context) {
 	ctx := c.Request.Context()
 	id := c.Param("id")
@@ -172,7 +308,7 @@ func (h *RemindHandler) UpdateThrottled(c *gin.Context) {
 
 	output, err := h.useCase.UpdateThrottled(ctx, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, err)
 
 		return
 	}
@@ -184,6 +320,47 @@ func (h *RemindHandler) UpdateThrottled(c *gin.Context) {
 	respondProtoRemind(c, http.StatusOK, output)
 }
 
+func (h *RemindHandler) AcknowledgeRemind(c *gin.Context) {
+	ctx := c.Request.Context()
+	id := c.Param("id")
+
+	slog.InfoContext(ctx, "handling acknowledge remind request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"remind_id", id,
+	)
+
+	input := app.AcknowledgeRemindInput{
+		ID: id,
+	}
+
+	output, err := h.useCase.AcknowledgeRemind(ctx, input)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "remind acknowledged successfully",
+		"remind_id", id,
+		"cancelled_count", len(output.CancelledRemindIDs),
+	)
+
+	resp := &remindv1.AcknowledgeRemindResponse{
+		Remind:             toProtoRemind(output.Remind),
+		CancelledRemindIds: output.CancelledRemindIDs,
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(http.StatusOK, "application/json", respBytes)
+}
+
 func (h *RemindHandler) DeleteRemind(c *gin.Context) {
 	ctx := c.Request.Context()
 	id := c.Param("id")
@@ -200,7 +377,7 @@ func (h *RemindHandler) DeleteRemind(c *gin.Context) {
 
 	err := h.useCase.DeleteRemind(ctx, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, err)
 
 		return
 	}
@@ -254,7 +431,7 @@ func (h *RemindHandler) CancelRemind(c *gin.Context) {
 
 	err = h.useCase.CancelRemindByTaskID(ctx, input)
 	if err != nil {
-		h.handleError(c, err)
+		handleError(c, err)
 
 		return
 	}
@@ -266,7 +443,7 @@ func (h *RemindHandler) CancelRemind(c *gin.Context) {
 	c.Status(http.StatusNoContent)
 }
 
-func (h *RemindHandler) handleError(c *gin.Context, err error) {
+func handleError(c *gin.Context, err error) {
 	var validationErr *app.ValidationError
 	if errors.As(err, &validationErr) {
 		respondProtoError(c, http.StatusBadRequest, "validation_error", validationErr.Message, validationErr.Field)
@@ -280,6 +457,12 @@ func (h *RemindHandler) handleError(c *gin.Context, err error) {
 		return
 	}
 
+	if errors.Is(err, app.ErrUserPaused) {
+		respondProtoError(c, http.StatusConflict, "conflict", err.Error(), "")
+
+		return
+	}
+
 	respondProtoError(c, http.StatusInternalServerError, "internal_error", "an internal error occurred", "")
 }
 
@@ -288,9 +471,25 @@ func (h *RemindHandler) RegisterRoutes(router *gin.RouterGroup) {
 	{
 		reminds.POST("", h.CreateRemind)
 		reminds.GET("", h.GetRemindsByTimeRange)
+		reminds.GET("/due", h.GetDueReminds)
 		reminds.POST("/:id/throttled", h.UpdateThrottled)
+		reminds.POST("/:id/acknowledge", h.AcknowledgeRemind)
 		reminds.DELETE("/:id", h.DeleteRemind)
 		reminds.POST("/cancel", h.CancelRemind)
+		reminds.POST("/schedule-preview", h.PreviewSchedule)
+	}
+
+	// gin reads a colon as the start of a path param, so custom methods such
+	// as reminds:preview share one route and are dispatched by name.
+	router.POST("/reminds:method", h.remindsMethod)
+}
+
+func (h *RemindHandler) remindsMethod(c *gin.Context) {
+	switch c.Param("method") {
+	case ":preview":
+		h.PreviewRemind(c)
+	default:
+		respondProtoError(c, http.StatusNotFound, "not_found", "resource not found", "")
 	}
 }
 
@@ -332,6 +531,149 @@ func respondProtoReminds(c *gin.Context, status int, output app.RemindsOutput) {
 	c.Data(status, "application/json", respBytes)
 }
 
+func respondProtoCreateReminds(c *gin.Context, status int, output app.CreateRemindOutput) {
+	reminds := make([]*remindv1.Remind, 0, len(output.Reminds))
+	for _, r := range output.Reminds {
+		reminds = append(reminds, toProtoRemind(r))
+	}
+
+	resp := &remindv1.CreateRemindsResponse{
+		Reminds:      reminds,
+		Count:        output.Count,
+		TimeOutcomes: toProtoTimeOutcomes(output.TimeOutcomes),
+		DroppedTimes: toProtoTimestamps(output.DroppedTimes),
+		ShrunkTimes:  toProtoTimestamps(output.ShrunkTimes),
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func toProtoTimeOutcomes(outcomes []app.TimeOutcomeOutput) []*remindv1.TimeOutcome {
+	protoOutcomes := make([]*remindv1.TimeOutcome, 0, len(outcomes))
+	for _, o := range outcomes {
+		outcome := &remindv1.TimeOutcome{
+			RequestedTime: timestamppb.New(o.RequestedTime),
+			Result: remindv1.CatchUpResult(
+				remindv1.CatchUpResult_value["CATCH_UP_RESULT_"+strings.ToUpper(o.Result)],
+			),
+		}
+
+		if !o.Time.IsZero() {
+			outcome.Time = timestamppb.New(o.Time)
+		}
+
+		protoOutcomes = append(protoOutcomes, outcome)
+	}
+
+	return protoOutcomes
+}
+
+func respondProtoDigests(c *gin.Context, status int, output app.DigestsOutput) {
+	digests := make([]*remindv1.Digest, 0, len(output.Digests))
+	for _, d := range output.Digests {
+		digests = append(digests, &remindv1.Digest{
+			UserId:             d.UserID,
+			RepresentativeTime: timestamppb.New(d.RepresentativeTime),
+			WindowStart:        timestamppb.New(d.WindowStart),
+			WindowEnd:          timestamppb.New(d.WindowEnd),
+			RemindIds:          d.RemindIDs,
+		})
+	}
+
+	resp := &remindv1.DigestsResponse{
+		Digests: digests,
+		Count:   output.Count,
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func respondProtoSchedule(c *gin.Context, status int, output app.ScheduleOutput) {
+	times := make([]*remindv1.ScheduledTime, 0, len(output.Times))
+	for _, t := range output.Times {
+		times = append(times, &remindv1.ScheduledTime{
+			Time:             timestamppb.New(t.Time),
+			SlideWindowWidth: t.SlideWindowWidth,
+		})
+	}
+
+	resp := &remindv1.ScheduleResponse{
+		Times: times,
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func respondProtoRemindPreview(c *gin.Context, status int, output app.RemindPreviewOutput) {
+	reminds := make([]*remindv1.RemindPreview, 0, len(output.Reminds))
+	for _, r := range output.Reminds {
+		adjustments := make([]remindv1.PolicyAdjustment, 0, len(r.Adjustments))
+		for _, a := range r.Adjustments {
+			adjustments = append(adjustments, remindv1.PolicyAdjustment(
+				remindv1.PolicyAdjustment_value["POLICY_ADJUSTMENT_"+strings.ToUpper(a)],
+			))
+		}
+
+		reminds = append(reminds, &remindv1.RemindPreview{
+			Time:             timestamppb.New(r.Time),
+			SlideWindowWidth: r.SlideWindowWidth,
+			Category: remindv1.WindowCategory(
+				remindv1.WindowCategory_value["WINDOW_CATEGORY_"+strings.ToUpper(r.Category)],
+			),
+			Adjustments: adjustments,
+			Paused:      r.Paused,
+		})
+	}
+
+	resp := &remindv1.PreviewRemindsResponse{
+		Reminds:           reminds,
+		Count:             output.Count,
+		RequestedTimes:    toProtoTimestamps(output.RequestedTimes),
+		DroppedTimes:      toProtoTimestamps(output.DroppedTimes),
+		TimeOutcomes:      toProtoTimeOutcomes(output.TimeOutcomes),
+		WindowExplanation: output.WindowExplanation,
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func toProtoTimestamps(times []time.Time) []*timestamppb.Timestamp {
+	timestamps := make([]*timestamppb.Timestamp, 0, len(times))
+	for _, t := range times {
+		timestamps = append(timestamps, timestamppb.New(t))
+	}
+
+	return timestamps
+}
+
 func respondProtoRemind(c *gin.Context, status int, output app.RemindOutput) {
 	resp := &remindv1.RemindResponse{
 		Remind: toProtoRemind(output),
@@ -356,6 +698,11 @@ func toProtoRemind(r app.RemindOutput) *remindv1.Remind {
 		})
 	}
 
+	var acknowledgedAt *timestamppb.Timestamp
+	if r.AcknowledgedAt != nil {
+		acknowledgedAt = timestamppb.New(*r.AcknowledgedAt)
+	}
+
 	return &remindv1.Remind{
 		Id:               r.ID,
 		Time:             timestamppb.New(r.Time),
@@ -367,10 +714,117 @@ func toProtoRemind(r app.RemindOutput) *remindv1.Remind {
 		CreatedAt:        timestamppb.New(r.CreatedAt),
 		UpdatedAt:        timestamppb.New(r.UpdatedAt),
 		SlideWindowWidth: r.SlideWindowWidth,
+		AcknowledgedAt:   acknowledgedAt,
+		EscalationLevel:  r.EscalationLevel,
+		Paused:           r.Paused,
+		Payload:          toProtoPayload(r.Payload),
+	}
+}
+
+func toProtoPayload(p *app.PayloadOutput) *remindv1.NotificationPayload {
+	if p == nil {
+		return nil
+	}
+
+	return &remindv1.NotificationPayload{
+		Title:    p.Title,
+		Body:     p.Body,
+		DeepLink: p.DeepLink,
+		Locale:   p.Locale,
+		Data:     p.Data,
+	}
+}
+
+func toCreateRemindInput(req *remindv1.CreateRemindRequest) app.CreateRemindInput {
+	devices := make([]app.DeviceInput, 0, len(req.Devices))
+	for _, d := range req.Devices {
+		devices = append(devices, app.DeviceInput{
+			DeviceID: d.DeviceId,
+			FCMToken: d.FcmToken,
+		})
+	}
+
+	times := make([]time.Time, 0, len(req.Times))
+	for _, t := range req.Times {
+		times = append(times, t.AsTime())
+	}
+
+	var targetAt *time.Time
+	if req.TargetAt != nil {
+		t := req.TargetAt.AsTime()
+		targetAt = &t
+	}
+
+	return app.CreateRemindInput{
+		Times:        times,
+		UserID:       req.UserId,
+		Devices:      devices,
+		TaskID:       req.TaskId,
+		TaskType:     taskTypeToString(req.TaskType),
+		Escalation:   toEscalationInput(req.Escalation),
+		Payload:      toPayloadInput(req.Payload),
+		TargetAt:     targetAt,
+		Template:     req.Template,
+		AutoSchedule: req.AutoSchedule,
+		CatchUp:      catchUpPolicyToString(req.CatchUp),
+	}
+}
+
+// catchUpPolicyToString maps the proto enum to the domain policy name; unknown
+// values are passed through so the use case rejects them.
+func catchUpPolicyToString(p remindv1.CatchUpPolicy) string {
+	switch p {
+	case remindv1.CatchUpPolicy_CATCH_UP_POLICY_UNSPECIFIED:
+		return ""
+	case remindv1.CatchUpPolicy_CATCH_UP_POLICY_REJECT:
+		return "reject"
+	case remindv1.CatchUpPolicy_CATCH_UP_POLICY_DROP:
+		return "drop"
+	case remindv1.CatchUpPolicy_CATCH_UP_POLICY_CLAMP:
+		return "clamp"
+	default:
+		return p.String()
+	}
+}
+
+func toEscalationInput(p *remindv1.EscalationPolicy) *app.EscalationInput {
+	if p == nil {
+		return nil
+	}
+
+	steps := make([]string, 0, len(p.Steps))
+	for _, s := range p.Steps {
+		steps = append(steps, strings.ToLower(strings.TrimPrefix(s.String(), "ESCALATION_ACTION_")))
+	}
+
+	return &app.EscalationInput{
+		AfterSeconds: p.AfterSeconds,
+		Steps:        steps,
+	}
+}
+
+func toPayloadInput(p *remindv1.NotificationPayload) *app.PayloadInput {
+	if p == nil {
+		return nil
+	}
+
+	return &app.PayloadInput{
+		Title:    p.Title,
+		Body:     p.Body,
+		DeepLink: p.DeepLink,
+		Locale:   p.Locale,
+		Data:     p.Data,
 	}
 }
 
+// taskTypeToString maps the enum through the task type registry, so
+// configured types need no enum name. Unknown values keep their enum name and
+// fail task type validation.
 func taskTypeToString(t commonv1.TaskType) string {
+	if name, ok := app.TaskTypeName(int32(t)); ok {
+		return name
+	}
+
 	name := t.String()
 	if strings.HasPrefix(name, "TASK_TYPE_") {
 		return strings.ToLower(strings.TrimPrefix(name, "TASK_TYPE_"))
@@ -380,6 +834,10 @@ func taskTypeToString(t commonv1.TaskType) string {
 }
 
 func stringToTaskType(s string) commonv1.TaskType {
+	if v, ok := app.TaskTypeEnumValue(s); ok {
+		return commonv1.TaskType(v)
+	}
+
 	upper := "TASK_TYPE_" + strings.ToUpper(s)
 	if v, ok := commonv1.TaskType_value[upper]; ok {
 		return commonv1.TaskType(v)
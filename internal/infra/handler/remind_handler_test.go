@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -26,7 +27,7 @@ func setupTestRouter(t *testing.T, testDB *testutil.TestDB) *gin.Engine {
 	gin.SetMode(gin.TestMode)
 
 	repo := repository.NewRemindRepository(testDB.DB)
-	useCase := app.NewRemindUseCase(repo, nil)
+	useCase := app.NewRemindUseCase(repo, repository.NewUserPreferencesRepository(testDB.DB), repository.NewRemindTemplateRepository(testDB.DB), nil, nil, nil, nil, nil, nil, nil)
 	h := handler.NewRemindHandler(useCase)
 
 	router := gin.New()
@@ -123,6 +124,127 @@ func TestCreateRemindHandlerSuccess(t *testing.T) {
 	}
 }
 
+func TestCreateRemindWithPayloadHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	baseTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+
+	rec := serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+		"times":     []string{baseTime.Format(time.RFC3339)},
+		"user_id":   uuid.Must(uuid.NewV7()).String(),
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+		"payload": map[string]any{
+			"title":     "Stand-up",
+			"body":      "Daily sync in 5 minutes",
+			"deep_link": "primind://tasks/42",
+			"locale":    "ja-JP",
+			"data":      map[string]string{"room": "A"},
+		},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	expected := &handler.PayloadResponse{
+		Title:    "Stand-up",
+		Body:     "Daily sync in 5 minutes",
+		DeepLink: "primind://tasks/42",
+		Locale:   "ja-JP",
+		Data:     map[string]string{"room": "A"},
+	}
+
+	var created handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+	require.Len(t, created.Reminds, 1)
+	assert.Equal(t, expected, created.Reminds[0].Payload)
+
+	// The payload is stored and returned by time range queries.
+	queryParams := url.Values{}
+	queryParams.Set("start", baseTime.Add(-time.Minute).Format(time.RFC3339))
+	queryParams.Set("end", baseTime.Add(time.Minute).Format(time.RFC3339))
+
+	rec = serveJSON(router, http.MethodGet, "/api/v1/reminds?"+queryParams.Encode(), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var listed handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &listed))
+	require.Len(t, listed.Reminds, 1)
+	assert.Equal(t, expected, listed.Reminds[0].Payload)
+}
+
+type timeOutcomesResponse struct {
+	Reminds      []handler.RemindResponse `json:"reminds"`
+	TimeOutcomes []struct {
+		RequestedTime time.Time  `json:"requested_time"`
+		Result        string     `json:"result"`
+		Time          *time.Time `json:"time"`
+	} `json:"time_outcomes"`
+}
+
+func TestCreateRemindCatchUpHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	past := time.Now().Add(-10 * time.Minute).Truncate(time.Second)
+	future := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		catchUp        string
+		expectedCount  int
+		expectedResult string
+	}{
+		{
+			name:           "drop",
+			catchUp:        "CATCH_UP_POLICY_DROP",
+			expectedCount:  1,
+			expectedResult: "CATCH_UP_RESULT_DROPPED",
+		},
+		{
+			name:           "clamp",
+			catchUp:        "CATCH_UP_POLICY_CLAMP",
+			expectedCount:  2,
+			expectedResult: "CATCH_UP_RESULT_CLAMPED",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+				"times":     []string{past.Format(time.RFC3339), future.Format(time.RFC3339)},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+				"catch_up":  tt.catchUp,
+			})
+			require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+			var response timeOutcomesResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Len(t, response.Reminds, tt.expectedCount)
+
+			require.Len(t, response.TimeOutcomes, 2)
+			assert.True(t, past.Equal(response.TimeOutcomes[0].RequestedTime))
+			assert.Equal(t, tt.expectedResult, response.TimeOutcomes[0].Result)
+			assert.Equal(t, "CATCH_UP_RESULT_KEPT", response.TimeOutcomes[1].Result)
+		})
+	}
+}
+
 func TestCreateRemindHandlerError(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode")
@@ -159,6 +281,43 @@ func TestCreateRemindHandlerError(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "past time without catch-up",
+			requestBody: map[string]any{
+				"times":     []string{time.Now().Add(-10 * time.Minute).Format(time.RFC3339)},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "times closer than the minimum spacing",
+			requestBody: map[string]any{
+				"times": []string{
+					time.Now().Add(1 * time.Hour).Format(time.RFC3339Nano),
+					time.Now().Add(1*time.Hour + 30*time.Second).Format(time.RFC3339Nano),
+				},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "every time dropped as past",
+			requestBody: map[string]any{
+				"times":     []string{time.Now().Add(-10 * time.Minute).Format(time.RFC3339)},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+				"catch_up":  "CATCH_UP_POLICY_DROP",
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "missing user_id",
 			requestBody: map[string]any{
@@ -170,7 +329,7 @@ func TestCreateRemindHandlerError(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name: "missing devices",
+			name: "missing devices without stored defaults",
 			requestBody: map[string]any{
 				"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
 				"user_id":   uuid.Must(uuid.NewV7()).String(),
@@ -180,7 +339,7 @@ func TestCreateRemindHandlerError(t *testing.T) {
 			expectedStatus: http.StatusBadRequest,
 		},
 		{
-			name: "empty devices",
+			name: "empty devices without stored defaults",
 			requestBody: map[string]any{
 				"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
 				"user_id":   uuid.Must(uuid.NewV7()).String(),
@@ -232,6 +391,30 @@ func TestCreateRemindHandlerError(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "escalation delay below minimum",
+			requestBody: map[string]any{
+				"times":      []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+				"user_id":    uuid.Must(uuid.NewV7()).String(),
+				"devices":    []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":    uuid.Must(uuid.NewV7()).String(),
+				"task_type":  "TASK_TYPE_NEAR",
+				"escalation": map[string]any{"after_seconds": 10, "steps": []string{"ESCALATION_ACTION_FOLLOW_UP"}},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "escalation without steps",
+			requestBody: map[string]any{
+				"times":      []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+				"user_id":    uuid.Must(uuid.NewV7()).String(),
+				"devices":    []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":    uuid.Must(uuid.NewV7()).String(),
+				"task_type":  "TASK_TYPE_NEAR",
+				"escalation": map[string]any{"after_seconds": 600},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 		{
 			name: "past time",
 			requestBody: map[string]any{
@@ -243,6 +426,30 @@ func TestCreateRemindHandlerError(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "payload title too long",
+			requestBody: map[string]any{
+				"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+				"payload":   map[string]any{"title": strings.Repeat("t", 101)},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "payload with invalid locale",
+			requestBody: map[string]any{
+				"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+				"payload":   map[string]any{"locale": "not a locale"},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -425,6 +632,76 @@ func TestGetRemindsByTimeRangeHandlerSuccess(t *testing.T) {
 	}
 }
 
+type protoDigestsResponse struct {
+	Digests []struct {
+		UserID             string    `json:"user_id"`
+		RepresentativeTime time.Time `json:"representative_time"`
+		RemindIDs          []string  `json:"remind_ids"`
+	} `json:"digests"`
+	Count int32 `json:"count"`
+}
+
+func TestGetRemindDigestsByTimeRangeHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	baseTime := time.Now().Add(1 * time.Hour).Truncate(time.Second)
+	userID := uuid.Must(uuid.NewV7()).String()
+	otherUserID := uuid.Must(uuid.NewV7()).String()
+
+	createdIDs := make([]string, 0, 2)
+
+	for _, tc := range []struct {
+		userID string
+		offset time.Duration
+	}{
+		{userID: userID, offset: 0},
+		{userID: userID, offset: time.Minute},
+		{userID: otherUserID, offset: 10 * time.Minute},
+	} {
+		rec := serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+			"times":     []string{baseTime.Add(tc.offset).Format(time.RFC3339)},
+			"user_id":   tc.userID,
+			"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token"}},
+			"task_id":   uuid.Must(uuid.NewV7()).String(),
+			"task_type": "TASK_TYPE_NEAR",
+		})
+		require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+		var created handler.RemindsResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &created))
+
+		if tc.userID == userID {
+			createdIDs = append(createdIDs, created.Reminds[0].ID)
+		}
+	}
+
+	queryParams := url.Values{}
+	queryParams.Set("start", baseTime.Add(-30*time.Minute).Format(time.RFC3339))
+	queryParams.Set("end", baseTime.Add(30*time.Minute).Format(time.RFC3339))
+	queryParams.Set("mode", "digest")
+
+	rec := serveJSON(router, http.MethodGet, "/api/v1/reminds?"+queryParams.Encode(), nil)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var response protoDigestsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	require.Equal(t, int32(2), response.Count)
+	require.Len(t, response.Digests, 2)
+
+	// The user's two reminds a minute apart share a window and merge.
+	assert.Equal(t, userID, response.Digests[0].UserID)
+	assert.ElementsMatch(t, createdIDs, response.Digests[0].RemindIDs)
+	assert.Equal(t, otherUserID, response.Digests[1].UserID)
+	assert.Len(t, response.Digests[1].RemindIDs, 1)
+}
+
 func TestGetRemindsByTimeRangeHandlerError(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode")
@@ -493,6 +770,18 @@ func TestGetRemindsByTimeRangeHandlerError(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "unknown mode",
+			setupQuery: func() string {
+				params := url.Values{}
+				params.Set("start", time.Now().Format(time.RFC3339))
+				params.Set("end", time.Now().Add(1*time.Hour).Format(time.RFC3339))
+				params.Set("mode", "clusters")
+
+				return params.Encode()
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1128,3 +1417,445 @@ func TestCancelRemindHandlerError(t *testing.T) {
 		})
 	}
 }
+
+type protoAcknowledgeRemindResponse struct {
+	Remind             handler.RemindResponse `json:"remind"`
+	CancelledRemindIDs []string               `json:"cancelled_remind_ids"`
+}
+
+func TestAcknowledgeRemindHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	createBody := map[string]any{
+		"times": []string{
+			time.Now().Add(1 * time.Hour).Format(time.RFC3339),
+			time.Now().Add(2 * time.Hour).Format(time.RFC3339),
+		},
+		"user_id":   uuid.Must(uuid.NewV7()).String(),
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "t"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+	}
+	body, _ := json.Marshal(createBody)
+
+	createReq := httptest.NewRequest(http.MethodPost, "/api/v1/reminds", bytes.NewReader(body))
+	createReq.Header.Set("Content-Type", "application/json")
+
+	createRec := httptest.NewRecorder()
+	router.ServeHTTP(createRec, createReq)
+	require.Equal(t, http.StatusCreated, createRec.Code)
+
+	var createResp handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(createRec.Body.Bytes(), &createResp))
+	require.Len(t, createResp.Reminds, 2)
+
+	ackReq := httptest.NewRequest(http.MethodPost, "/api/v1/reminds/"+createResp.Reminds[0].ID+"/acknowledge", nil)
+	ackRec := httptest.NewRecorder()
+	router.ServeHTTP(ackRec, ackReq)
+
+	require.Equal(t, http.StatusOK, ackRec.Code)
+
+	var ackResp protoAcknowledgeRemindResponse
+	require.NoError(t, json.Unmarshal(ackRec.Body.Bytes(), &ackResp))
+	assert.NotNil(t, ackResp.Remind.AcknowledgedAt)
+	assert.Equal(t, []string{createResp.Reminds[1].ID}, ackResp.CancelledRemindIDs)
+}
+
+func TestAcknowledgeRemindHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	tests := []struct {
+		name           string
+		id             string
+		expectedStatus int
+	}{
+		{
+			name:           "invalid id",
+			id:             "not-a-uuid",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "not found",
+			id:             uuid.Must(uuid.NewV7()).String(),
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/reminds/"+tt.id+"/acknowledge", nil)
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestPreviewScheduleHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	deadline := time.Now().Add(3 * time.Hour).Truncate(time.Second)
+	rec := serveJSON(router, http.MethodPost, "/api/v1/reminds/schedule-preview", map[string]any{
+		"deadline":  deadline.Format(time.RFC3339),
+		"task_type": "TASK_TYPE_SHORT",
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp struct {
+		Times []struct {
+			Time             time.Time `json:"time"`
+			SlideWindowWidth int32     `json:"slide_window_width"`
+		} `json:"times"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Times, 6)
+	assert.True(t, deadline.Add(-2*time.Hour).Equal(resp.Times[0].Time))
+	assert.True(t, deadline.Equal(resp.Times[5].Time))
+	assert.Equal(t, int32(120), resp.Times[5].SlideWindowWidth)
+
+	// Nothing is stored by a preview.
+	rec = serveJSON(router, http.MethodGet, "/api/v1/reminds?start="+
+		time.Now().Format(time.RFC3339)+"&end="+deadline.Add(time.Hour).Format(time.RFC3339), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var remindsResp handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &remindsResp))
+	assert.Equal(t, int32(0), remindsResp.Count)
+}
+
+func TestPreviewScheduleHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	tests := []struct {
+		name string
+		body map[string]any
+	}{
+		{
+			name: "missing deadline",
+			body: map[string]any{"task_type": "TASK_TYPE_SHORT"},
+		},
+		{
+			name: "unspecified task type",
+			body: map[string]any{"deadline": time.Now().Add(time.Hour).Format(time.RFC3339)},
+		},
+		{
+			name: "deadline in the past",
+			body: map[string]any{
+				"deadline":  time.Now().Add(-time.Hour).Format(time.RFC3339),
+				"task_type": "TASK_TYPE_SHORT",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodPost, "/api/v1/reminds/schedule-preview", tt.body)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestPreviewRemindHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	targetAt := time.Now().Add(3 * time.Hour).Truncate(time.Second)
+	rec := serveJSON(router, http.MethodPost, "/api/v1/reminds:preview", map[string]any{
+		"user_id":   uuid.Must(uuid.NewV7()).String(),
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token-1"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+		"times":     []string{targetAt.Add(-time.Hour).Format(time.RFC3339), targetAt.Format(time.RFC3339)},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp struct {
+		Reminds []struct {
+			Time             time.Time `json:"time"`
+			SlideWindowWidth int32     `json:"slide_window_width"`
+			Category         string    `json:"category"`
+		} `json:"reminds"`
+		Count          int32       `json:"count"`
+		RequestedTimes []time.Time `json:"requested_times"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Reminds, 2)
+	assert.Equal(t, int32(2), resp.Count)
+	assert.Len(t, resp.RequestedTimes, 2)
+	assert.Equal(t, "WINDOW_CATEGORY_INTERMEDIATE", resp.Reminds[0].Category)
+	assert.Equal(t, int32(600), resp.Reminds[0].SlideWindowWidth)
+	assert.Equal(t, "WINDOW_CATEGORY_TARGET", resp.Reminds[1].Category)
+	assert.Equal(t, int32(300), resp.Reminds[1].SlideWindowWidth)
+
+	// Nothing is stored by a preview.
+	rec = serveJSON(router, http.MethodGet, "/api/v1/reminds?start="+
+		time.Now().Format(time.RFC3339)+"&end="+targetAt.Add(time.Hour).Format(time.RFC3339), nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var remindsResp handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &remindsResp))
+	assert.Equal(t, int32(0), remindsResp.Count)
+}
+
+func TestPreviewRemindHandlerAdaptiveSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	gin.SetMode(gin.TestMode)
+
+	adaptive, err := domain.NewAdaptiveWindowPolicy(10, 0.9, 0.2, 0.8, 1.5)
+	require.NoError(t, err)
+
+	useCase := app.NewRemindUseCase(
+		repository.NewRemindRepository(testDB.DB),
+		repository.NewUserPreferencesRepository(testDB.DB),
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		adaptive,
+		repository.NewWindowStatsRepository(testDB.DB),
+		nil,
+	)
+	router := gin.New()
+	handler.NewRemindHandler(useCase).RegisterRoutes(router.Group("/api/v1"))
+
+	userID := uuid.Must(uuid.NewV7()).String()
+	require.NoError(t, testDB.DB.Create(&repository.WindowStatsModel{
+		UserID:     userID,
+		TaskType:   "near",
+		Samples:    20,
+		Collided:   10,
+		ComputedAt: time.Now(),
+	}).Error)
+
+	rec := serveJSON(router, http.MethodPost, "/api/v1/reminds:preview", map[string]any{
+		"user_id":   userID,
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token-1"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+		"times":     []string{time.Now().Add(time.Hour).Format(time.RFC3339)},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var resp struct {
+		Reminds []struct {
+			SlideWindowWidth int32    `json:"slide_window_width"`
+			Adjustments      []string `json:"adjustments"`
+		} `json:"reminds"`
+		WindowExplanation string `json:"window_explanation"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Reminds, 1)
+	assert.Equal(t, int32(450), resp.Reminds[0].SlideWindowWidth)
+	assert.Equal(t, []string{"POLICY_ADJUSTMENT_ADAPTIVE"}, resp.Reminds[0].Adjustments)
+	assert.Equal(t, "widened x1.5: 50% of 20 reminds collided with another remind", resp.WindowExplanation)
+}
+
+func TestCreateRemindHandlerCustomTaskTypeSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	// The process-wide registry outlives the test, so register only once.
+	// Custom task types have no name in the shared enum and travel as numbers.
+	if _, ok := domain.TaskTypes().Lookup("urgent"); !ok {
+		window, err := domain.NewWindowParams(time.Minute, 0.3, time.Minute, 3*time.Minute)
+		require.NoError(t, err)
+
+		require.NoError(t, domain.TaskTypes().Register(domain.TaskTypeDefinition{
+			Type:      "urgent",
+			EnumValue: 105,
+			Window:    window,
+		}))
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	create := func(taskType int) *httptest.ResponseRecorder {
+		return serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+			"times":     []string{time.Now().Add(time.Hour).Format(time.RFC3339)},
+			"user_id":   uuid.Must(uuid.NewV7()).String(),
+			"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token-1"}},
+			"task_id":   uuid.Must(uuid.NewV7()).String(),
+			"task_type": taskType,
+		})
+	}
+
+	rec := create(105)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var resp struct {
+		Reminds []struct {
+			TaskType         int   `json:"task_type"`
+			SlideWindowWidth int32 `json:"slide_window_width"`
+		} `json:"reminds"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Reminds, 1)
+	assert.Equal(t, 105, resp.Reminds[0].TaskType)
+	assert.Equal(t, int32(60), resp.Reminds[0].SlideWindowWidth)
+
+	// Enum values are only accepted once a task type is registered for them.
+	rec = create(106)
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
+
+func TestPreviewRemindHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	tests := []struct {
+		name           string
+		path           string
+		body           map[string]any
+		expectedStatus int
+	}{
+		{
+			name:           "missing user id",
+			path:           "/api/v1/reminds:preview",
+			body:           map[string]any{"task_id": uuid.Must(uuid.NewV7()).String(), "task_type": "TASK_TYPE_NEAR"},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "no times",
+			path: "/api/v1/reminds:preview",
+			body: map[string]any{
+				"user_id":   uuid.Must(uuid.NewV7()).String(),
+				"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token-1"}},
+				"task_id":   uuid.Must(uuid.NewV7()).String(),
+				"task_type": "TASK_TYPE_NEAR",
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "unknown method",
+			path:           "/api/v1/reminds:simulate",
+			body:           map[string]any{},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodPost, tt.path, tt.body)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code, rec.Body.String())
+		})
+	}
+}
+
+func TestGetDueRemindsHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	remindTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	rec := serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+		"user_id":   uuid.Must(uuid.NewV7()).String(),
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token-1"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+		"times":     []string{remindTime.Format(time.RFC3339)},
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	tests := []struct {
+		name          string
+		at            time.Time
+		expectedCount int32
+	}{
+		{
+			name:          "inside the window",
+			at:            remindTime.Add(5 * time.Minute),
+			expectedCount: 1,
+		},
+		{
+			name:          "outside the window",
+			at:            remindTime.Add(6 * time.Minute),
+			expectedCount: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodGet,
+				"/api/v1/reminds/due?exclude_throttled=true&at="+url.QueryEscape(tt.at.Format(time.RFC3339)), nil)
+			require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+			var resp handler.RemindsResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			assert.Equal(t, tt.expectedCount, resp.Count)
+		})
+	}
+}
+
+func TestGetDueRemindsHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupTestRouter(t, testDB)
+
+	rec := serveJSON(router, http.MethodGet, "/api/v1/reminds/due?at=yesterday", nil)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+}
@@ -5,7 +5,7 @@ import "time"
 type CreateRemindRequest struct {
 	Times    []time.Time     `json:"times" binding:"required,min=1,dive"`
 	UserID   string          `json:"user_id" binding:"required,uuid"`
-	Devices  []DeviceRequest `json:"devices" binding:"required,min=1,dive"`
+	Devices  []DeviceRequest `json:"devices" binding:"omitempty,dive"`
 	TaskID   string          `json:"task_id" binding:"required,uuid"`
 	TaskType string          `json:"task_type" binding:"required"`
 }
@@ -15,9 +15,24 @@ type DeviceRequest struct {
 	FCMToken string `json:"fcm_token" binding:"required"`
 }
 
+const (
+	ListModeReminds = "reminds"
+	ListModeDigest  = "digest"
+)
+
 type GetRemindsByTimeRangeRequest struct {
 	Start time.Time `form:"start" binding:"required" time_format:"2006-01-02T15:04:05Z07:00"`
 	End   time.Time `form:"end" binding:"required,gtfield=Start" time_format:"2006-01-02T15:04:05Z07:00"`
+	// Mode selects the listing: reminds (default) or digest, which groups each
+	// user's reminds with overlapping slide windows.
+	Mode string `form:"mode" binding:"omitempty,oneof=reminds digest"`
+}
+
+// GetDueRemindsRequest asks for the reminds whose slide window contains At,
+// the current time when omitted.
+type GetDueRemindsRequest struct {
+	At               time.Time `form:"at" time_format:"2006-01-02T15:04:05Z07:00"`
+	ExcludeThrottled bool      `form:"exclude_throttled"`
 }
 
 type UpdateThrottledRequest struct {
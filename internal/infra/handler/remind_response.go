@@ -14,11 +14,23 @@ type RemindResponse struct {
 	TaskID           string           `json:"task_id"`
 	TaskType         string           `json:"task_type"`
 	Throttled        bool             `json:"throttled"`
-	SlideWindowWidth int32            `json:"slide_window_width"` // slide window width in seconds (range: 60-600)
+	SlideWindowWidth int32            `json:"slide_window_width"` // slide window width in seconds (range: 60-1800)
+	AcknowledgedAt   *time.Time       `json:"acknowledged_at,omitempty"`
+	EscalationLevel  int32            `json:"escalation_level"`
+	Paused           bool             `json:"paused"`
+	Payload          *PayloadResponse `json:"payload,omitempty"`
 	CreatedAt        time.Time        `json:"created_at"`
 	UpdatedAt        time.Time        `json:"updated_at"`
 }
 
+type PayloadResponse struct {
+	Title    string            `json:"title,omitempty"`
+	Body     string            `json:"body,omitempty"`
+	DeepLink string            `json:"deep_link,omitempty"`
+	Locale   string            `json:"locale,omitempty"`
+	Data     map[string]string `json:"data,omitempty"`
+}
+
 type DeviceResponse struct {
 	DeviceID string `json:"device_id"`
 	FCMToken string `json:"fcm_token"`
@@ -44,6 +56,17 @@ func FromDTO(output app.RemindOutput) RemindResponse {
 		})
 	}
 
+	var payload *PayloadResponse
+	if output.Payload != nil {
+		payload = &PayloadResponse{
+			Title:    output.Payload.Title,
+			Body:     output.Payload.Body,
+			DeepLink: output.Payload.DeepLink,
+			Locale:   output.Payload.Locale,
+			Data:     output.Payload.Data,
+		}
+	}
+
 	return RemindResponse{
 		ID:               output.ID,
 		Time:             output.Time,
@@ -53,6 +76,10 @@ func FromDTO(output app.RemindOutput) RemindResponse {
 		TaskType:         output.TaskType,
 		Throttled:        output.Throttled,
 		SlideWindowWidth: output.SlideWindowWidth,
+		AcknowledgedAt:   output.AcknowledgedAt,
+		EscalationLevel:  output.EscalationLevel,
+		Paused:           output.Paused,
+		Payload:          payload,
 		CreatedAt:        output.CreatedAt,
 		UpdatedAt:        output.UpdatedAt,
 	}
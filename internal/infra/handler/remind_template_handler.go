@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+type RemindTemplateHandler struct {
+	useCase app.RemindTemplateUseCase
+}
+
+func NewRemindTemplateHandler(useCase app.RemindTemplateUseCase) *RemindTemplateHandler {
+	return &RemindTemplateHandler{
+		useCase: useCase,
+	}
+}
+
+func (h *RemindTemplateHandler) ListRemindTemplates(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	slog.InfoContext(ctx, "handling list remind templates request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+	)
+
+	output, err := h.useCase.ListRemindTemplates(ctx)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoRemindTemplates(c, http.StatusOK, output)
+}
+
+func (h *RemindTemplateHandler) GetRemindTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	slog.InfoContext(ctx, "handling get remind template request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"name", name,
+	)
+
+	output, err := h.useCase.GetRemindTemplate(ctx, app.GetRemindTemplateInput{
+		Name: name,
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoRemindTemplate(c, http.StatusOK, output)
+}
+
+func (h *RemindTemplateHandler) PutRemindTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	slog.InfoContext(ctx, "handling put remind template request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"name", name,
+	)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return
+	}
+
+	var req remindv1.PutRemindTemplateRequest
+	if err := pjson.Unmarshal(body, &req); err != nil {
+		slog.WarnContext(ctx, "request unmarshal failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	if err := pjson.Validate(&req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	offsets := make([]app.TemplateOffsetsInput, 0, len(req.Offsets))
+	for _, o := range req.Offsets {
+		offsets = append(offsets, app.TemplateOffsetsInput{
+			TaskType:      taskTypeToString(o.TaskType),
+			OffsetSeconds: o.OffsetSeconds,
+		})
+	}
+
+	input := app.PutRemindTemplateInput{
+		Name:    name,
+		Offsets: offsets,
+	}
+
+	output, err := h.useCase.PutRemindTemplate(ctx, input)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "remind template saved successfully",
+		"name", name,
+	)
+	respondProtoRemindTemplate(c, http.StatusOK, output)
+}
+
+func (h *RemindTemplateHandler) DeleteRemindTemplate(c *gin.Context) {
+	ctx := c.Request.Context()
+	name := c.Param("name")
+
+	slog.InfoContext(ctx, "handling delete remind template request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"name", name,
+	)
+
+	err := h.useCase.DeleteRemindTemplate(ctx, app.DeleteRemindTemplateInput{
+		Name: name,
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "remind template deleted successfully",
+		"name", name,
+	)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *RemindTemplateHandler) RegisterRoutes(router *gin.RouterGroup) {
+	templates := router.Group("/remind-templates")
+	{
+		templates.GET("", h.ListRemindTemplates)
+		templates.GET("/:name", h.GetRemindTemplate)
+		templates.PUT("/:name", h.PutRemindTemplate)
+		templates.DELETE("/:name", h.DeleteRemindTemplate)
+	}
+}
+
+func respondProtoRemindTemplate(c *gin.Context, status int, output app.RemindTemplateOutput) {
+	resp := &remindv1.RemindTemplateResponse{
+		Template: toProtoRemindTemplate(output),
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func respondProtoRemindTemplates(c *gin.Context, status int, output app.RemindTemplatesOutput) {
+	templates := make([]*remindv1.RemindTemplate, 0, len(output.Templates))
+	for _, t := range output.Templates {
+		templates = append(templates, toProtoRemindTemplate(t))
+	}
+
+	resp := &remindv1.RemindTemplatesResponse{
+		Templates: templates,
+		Count:     output.Count,
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func toProtoRemindTemplate(t app.RemindTemplateOutput) *remindv1.RemindTemplate {
+	offsets := make([]*remindv1.TemplateOffsets, 0, len(t.Offsets))
+	for _, o := range t.Offsets {
+		offsets = append(offsets, &remindv1.TemplateOffsets{
+			TaskType:      stringToTaskType(o.TaskType),
+			OffsetSeconds: o.OffsetSeconds,
+		})
+	}
+
+	return &remindv1.RemindTemplate{
+		Name:      t.Name,
+		Offsets:   offsets,
+		CreatedAt: timestamppb.New(t.CreatedAt),
+		UpdatedAt: timestamppb.New(t.UpdatedAt),
+	}
+}
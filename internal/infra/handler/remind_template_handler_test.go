@@ -0,0 +1,154 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func setupRemindTemplateTestRouter(t *testing.T, testDB *testutil.TestDB) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	templateRepo := repository.NewRemindTemplateRepository(testDB.DB)
+	remindUseCase := app.NewRemindUseCase(
+		repository.NewRemindRepository(testDB.DB),
+		repository.NewUserPreferencesRepository(testDB.DB),
+		templateRepo,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	templateUseCase := app.NewRemindTemplateUseCase(templateRepo)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	handler.NewRemindHandler(remindUseCase).RegisterRoutes(api)
+	handler.NewRemindTemplateHandler(templateUseCase).RegisterRoutes(api)
+
+	return router
+}
+
+type protoRemindTemplateResponse struct {
+	Template struct {
+		Name    string `json:"name"`
+		Offsets []struct {
+			TaskType      string  `json:"task_type"`
+			OffsetSeconds []int32 `json:"offset_seconds"`
+		} `json:"offsets"`
+	} `json:"template"`
+}
+
+func TestRemindTemplateHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupRemindTemplateTestRouter(t, testDB)
+	path := "/api/v1/remind-templates/standard"
+
+	rec := serveJSON(router, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serveJSON(router, http.MethodPut, path, map[string]any{
+		"offsets": []map[string]any{
+			{"task_type": "TASK_TYPE_SCHEDULED", "offset_seconds": []int{600, 3600}},
+		},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var putResp protoRemindTemplateResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &putResp))
+	assert.Equal(t, "standard", putResp.Template.Name)
+	require.Len(t, putResp.Template.Offsets, 1)
+	assert.Equal(t, "TASK_TYPE_SCHEDULED", putResp.Template.Offsets[0].TaskType)
+	assert.Equal(t, []int32{3600, 600}, putResp.Template.Offsets[0].OffsetSeconds)
+
+	rec = serveJSON(router, http.MethodGet, "/api/v1/remind-templates", nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Reminds can be created from the template and a target time.
+	targetAt := time.Now().Add(2 * time.Hour).Truncate(time.Second)
+	rec = serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+		"target_at": targetAt.Format(time.RFC3339),
+		"template":  "standard",
+		"user_id":   uuid.Must(uuid.NewV7()).String(),
+		"devices":   []map[string]string{{"device_id": uuid.Must(uuid.NewV7()).String(), "fcm_token": "token"}},
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_SCHEDULED",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var remindsResp handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &remindsResp))
+	assert.Equal(t, int32(2), remindsResp.Count)
+
+	rec = serveJSON(router, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serveJSON(router, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestRemindTemplateHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupRemindTemplateTestRouter(t, testDB)
+
+	tests := []struct {
+		name string
+		path string
+		body map[string]any
+	}{
+		{
+			name: "no offsets",
+			path: "/api/v1/remind-templates/standard",
+			body: map[string]any{"offsets": []map[string]any{}},
+		},
+		{
+			name: "negative offset",
+			path: "/api/v1/remind-templates/standard",
+			body: map[string]any{
+				"offsets": []map[string]any{{"task_type": "TASK_TYPE_NEAR", "offset_seconds": []int{-60}}},
+			},
+		},
+		{
+			name: "invalid name",
+			path: "/api/v1/remind-templates/Standard",
+			body: map[string]any{
+				"offsets": []map[string]any{{"task_type": "TASK_TYPE_NEAR", "offset_seconds": []int{60}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodPut, tt.path, tt.body)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package handler
+
+import (
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+type UserPreferencesHandler struct {
+	useCase app.UserPreferencesUseCase
+}
+
+func NewUserPreferencesHandler(useCase app.UserPreferencesUseCase) *UserPreferencesHandler {
+	return &UserPreferencesHandler{
+		useCase: useCase,
+	}
+}
+
+func (h *UserPreferencesHandler) GetUserPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.Param("user_id")
+
+	slog.InfoContext(ctx, "handling get user preferences request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"user_id", userID,
+	)
+
+	output, err := h.useCase.GetUserPreferences(ctx, app.GetUserPreferencesInput{
+		UserID: userID,
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoUserPreferences(c, http.StatusOK, output)
+}
+
+func (h *UserPreferencesHandler) PutUserPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.Param("user_id")
+
+	slog.InfoContext(ctx, "handling put user preferences request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"user_id", userID,
+	)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return
+	}
+
+	var req remindv1.PutUserPreferencesRequest
+	if err := pjson.Unmarshal(body, &req); err != nil {
+		slog.WarnContext(ctx, "request unmarshal failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	if err := pjson.Validate(&req); err != nil {
+		slog.WarnContext(ctx, "request validation failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	devices := make([]app.DeviceInput, 0, len(req.DefaultDevices))
+	for _, d := range req.DefaultDevices {
+		devices = append(devices, app.DeviceInput{
+			DeviceID: d.DeviceId,
+			FCMToken: d.FcmToken,
+		})
+	}
+
+	quietHours := make([]app.QuietWindowInput, 0, len(req.QuietHours))
+	for _, w := range req.QuietHours {
+		quietHours = append(quietHours, app.QuietWindowInput{
+			Weekday: time.Weekday(w.Weekday),
+			Start:   time.Duration(w.StartMinute) * time.Minute,
+			End:     time.Duration(w.EndMinute) * time.Minute,
+		})
+	}
+
+	overrides := make([]app.WindowOverrideInput, 0, len(req.WindowOverrides))
+	for _, o := range req.WindowOverrides {
+		overrides = append(overrides, app.WindowOverrideInput{
+			TaskType:                    taskTypeToString(o.TaskType),
+			TargetWidthSeconds:          o.TargetWidth,
+			IntermediateMaxWidthSeconds: o.IntermediateMaxWidth,
+		})
+	}
+
+	input := app.PutUserPreferencesInput{
+		UserID:          userID,
+		DefaultDevices:  devices,
+		Timezone:        req.Timezone,
+		QuietHours:      quietHours,
+		WindowOverrides: overrides,
+	}
+
+	output, err := h.useCase.PutUserPreferences(ctx, input)
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "user preferences saved successfully",
+		"user_id", userID,
+	)
+	respondProtoUserPreferences(c, http.StatusOK, output)
+}
+
+func (h *UserPreferencesHandler) DeleteUserPreferences(c *gin.Context) {
+	ctx := c.Request.Context()
+	userID := c.Param("user_id")
+
+	slog.InfoContext(ctx, "handling delete user preferences request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+		"user_id", userID,
+	)
+
+	err := h.useCase.DeleteUserPreferences(ctx, app.DeleteUserPreferencesInput{
+		UserID: userID,
+	})
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	slog.InfoContext(ctx, "user preferences deleted successfully",
+		"user_id", userID,
+	)
+	c.Status(http.StatusNoContent)
+}
+
+func (h *UserPreferencesHandler) RegisterRoutes(router *gin.RouterGroup) {
+	preferences := router.Group("/users/:user_id/preferences")
+	{
+		preferences.GET("", h.GetUserPreferences)
+		preferences.PUT("", h.PutUserPreferences)
+		preferences.DELETE("", h.DeleteUserPreferences)
+	}
+}
+
+func respondProtoUserPreferences(c *gin.Context, status int, output app.UserPreferencesOutput) {
+	resp := &remindv1.UserPreferencesResponse{
+		Preferences: toProtoUserPreferences(output),
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
+
+func toProtoUserPreferences(p app.UserPreferencesOutput) *remindv1.UserPreferences {
+	devices := make([]*remindv1.Device, 0, len(p.DefaultDevices))
+	for _, d := range p.DefaultDevices {
+		devices = append(devices, &remindv1.Device{
+			DeviceId: d.DeviceID,
+			FcmToken: d.FCMToken,
+		})
+	}
+
+	quietHours := make([]*remindv1.QuietWindow, 0, len(p.QuietHours))
+	for _, w := range p.QuietHours {
+		quietHours = append(quietHours, &remindv1.QuietWindow{
+			Weekday:     int32(w.Weekday),
+			StartMinute: int32(w.Start / time.Minute), // #nosec G115
+			EndMinute:   int32(w.End / time.Minute),   // #nosec G115
+		})
+	}
+
+	overrides := make([]*remindv1.WindowOverride, 0, len(p.WindowOverrides))
+	for _, o := range p.WindowOverrides {
+		overrides = append(overrides, &remindv1.WindowOverride{
+			TaskType:             stringToTaskType(o.TaskType),
+			TargetWidth:          o.TargetWidthSeconds,
+			IntermediateMaxWidth: o.IntermediateMaxWidthSeconds,
+		})
+	}
+
+	var resumeAt *timestamppb.Timestamp
+	if p.ResumeAt != nil {
+		resumeAt = timestamppb.New(*p.ResumeAt)
+	}
+
+	return &remindv1.UserPreferences{
+		UserId:          p.UserID,
+		DefaultDevices:  devices,
+		Timezone:        p.Timezone,
+		QuietHours:      quietHours,
+		WindowOverrides: overrides,
+		Paused:          p.Paused,
+		CreatedAt:       timestamppb.New(p.CreatedAt),
+		UpdatedAt:       timestamppb.New(p.UpdatedAt),
+		ResumeAt:        resumeAt,
+	}
+}
@@ -0,0 +1,207 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func setupUserPreferencesTestRouter(t *testing.T, testDB *testutil.TestDB) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	prefsRepo := repository.NewUserPreferencesRepository(testDB.DB)
+	remindUseCase := app.NewRemindUseCase(repository.NewRemindRepository(testDB.DB), prefsRepo, nil, nil, nil, nil, nil, nil, nil, nil)
+	prefsUseCase := app.NewUserPreferencesUseCase(repository.NewTransactor(testDB.DB), prefsRepo)
+
+	router := gin.New()
+	api := router.Group("/api/v1")
+	handler.NewRemindHandler(remindUseCase).RegisterRoutes(api)
+	handler.NewUserPreferencesHandler(prefsUseCase).RegisterRoutes(api)
+
+	return router
+}
+
+type protoUserPreferencesResponse struct {
+	Preferences struct {
+		UserID         string                   `json:"user_id"`
+		DefaultDevices []handler.DeviceResponse `json:"default_devices"`
+		Timezone       string                   `json:"timezone"`
+		Paused         bool                     `json:"paused"`
+	} `json:"preferences"`
+}
+
+func serveJSON(router *gin.Engine, method, path string, body any) *httptest.ResponseRecorder {
+	var reader *bytes.Reader
+	if body != nil {
+		b, _ := json.Marshal(body)
+		reader = bytes.NewReader(b)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req := httptest.NewRequest(method, path, reader)
+	req.Header.Set("Content-Type", "application/json")
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestUserPreferencesHandlerSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupUserPreferencesTestRouter(t, testDB)
+
+	userID := uuid.Must(uuid.NewV7()).String()
+	deviceID := uuid.Must(uuid.NewV7()).String()
+	path := "/api/v1/users/" + userID + "/preferences"
+
+	rec := serveJSON(router, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+
+	rec = serveJSON(router, http.MethodPut, path, map[string]any{
+		"default_devices": []map[string]string{{"device_id": deviceID, "fcm_token": "token"}},
+		"timezone":        "Asia/Tokyo",
+		"quiet_hours":     []map[string]int{{"weekday": 1, "start_minute": 1320, "end_minute": 420}},
+		"window_overrides": []map[string]any{
+			{"task_type": "TASK_TYPE_RELAXED", "target_width": 180},
+		},
+	})
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	var putResp protoUserPreferencesResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &putResp))
+	assert.Equal(t, userID, putResp.Preferences.UserID)
+	assert.Equal(t, "Asia/Tokyo", putResp.Preferences.Timezone)
+	require.Len(t, putResp.Preferences.DefaultDevices, 1)
+
+	rec = serveJSON(router, http.MethodGet, path, nil)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	// Reminds created without devices use the stored defaults.
+	rec = serveJSON(router, http.MethodPost, "/api/v1/reminds", map[string]any{
+		"times":     []string{time.Now().Add(1 * time.Hour).Format(time.RFC3339)},
+		"user_id":   userID,
+		"task_id":   uuid.Must(uuid.NewV7()).String(),
+		"task_type": "TASK_TYPE_NEAR",
+	})
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var remindsResp handler.RemindsResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &remindsResp))
+	require.Len(t, remindsResp.Reminds, 1)
+	assert.Equal(t, deviceID, remindsResp.Reminds[0].Devices[0].DeviceID)
+
+	rec = serveJSON(router, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serveJSON(router, http.MethodDelete, path, nil)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+
+	rec = serveJSON(router, http.MethodGet, path, nil)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestPutUserPreferencesHandlerError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	router := setupUserPreferencesTestRouter(t, testDB)
+
+	tests := []struct {
+		name          string
+		userID        string
+		requestBody   map[string]any
+		expectedField string
+	}{
+		{
+			name:          "invalid user_id",
+			userID:        "not-a-uuid",
+			requestBody:   map[string]any{},
+			expectedField: "user_id",
+		},
+		{
+			name:          "invalid timezone",
+			userID:        uuid.Must(uuid.NewV7()).String(),
+			requestBody:   map[string]any{"timezone": "Mars/Olympus"},
+			expectedField: "timezone",
+		},
+		{
+			name:   "quiet window minute out of range",
+			userID: uuid.Must(uuid.NewV7()).String(),
+			requestBody: map[string]any{
+				"quiet_hours": []map[string]int{{"weekday": 1, "start_minute": 1440, "end_minute": 60}},
+			},
+			expectedField: "",
+		},
+		{
+			name:   "window override width out of range",
+			userID: uuid.Must(uuid.NewV7()).String(),
+			requestBody: map[string]any{
+				"window_overrides": []map[string]any{{"task_type": "TASK_TYPE_NEAR", "target_width": 3600}},
+			},
+			expectedField: "window_overrides[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := serveJSON(router, http.MethodPut, "/api/v1/users/"+tt.userID+"/preferences", tt.requestBody)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+			var response handler.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+			assert.Equal(t, "validation_error", response.Error)
+			assert.Equal(t, tt.expectedField, response.Field)
+		})
+	}
+}
+
+type pausedUserPreferencesUseCase struct {
+	app.UserPreferencesUseCase
+}
+
+func (pausedUserPreferencesUseCase) DeleteUserPreferences(context.Context, app.DeleteUserPreferencesInput) error {
+	return app.ErrUserPaused
+}
+
+func TestDeleteUserPreferencesHandlerError(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.New()
+	handler.NewUserPreferencesHandler(pausedUserPreferencesUseCase{}).RegisterRoutes(router.Group("/api/v1"))
+
+	rec := serveJSON(router, http.MethodDelete, "/api/v1/users/"+uuid.Must(uuid.NewV7()).String()+"/preferences", nil)
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+
+	var response handler.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, "conflict", response.Error)
+}
@@ -0,0 +1,140 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+// WidthBackfillHandler lets an admin start the slide window width backfill
+// and follow its progress.
+type WidthBackfillHandler struct {
+	job *app.WidthBackfillJob
+}
+
+func NewWidthBackfillHandler(job *app.WidthBackfillJob) *WidthBackfillHandler {
+	return &WidthBackfillHandler{
+		job: job,
+	}
+}
+
+func (h *WidthBackfillHandler) StartWidthBackfill(c *gin.Context) {
+	ctx := c.Request.Context()
+	slog.InfoContext(ctx, "handling start width backfill request",
+		"method", c.Request.Method,
+		"path", c.Request.URL.Path,
+	)
+
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		slog.ErrorContext(ctx, "failed to read request body", "error", err)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", "failed to read request body", "")
+
+		return
+	}
+
+	var req remindv1.StartWidthBackfillRequest
+	if err := pjson.Unmarshal(body, &req); err != nil {
+		slog.WarnContext(ctx, "request unmarshal failed",
+			"error", err,
+			"path", c.Request.URL.Path,
+		)
+		respondProtoError(c, http.StatusBadRequest, "validation_error", err.Error(), "")
+
+		return
+	}
+
+	err = h.job.Start(ctx, app.WidthBackfillStart{
+		AfterTaskID: req.AfterTaskId,
+		DryRun:      req.DryRun,
+	})
+	if errors.Is(err, app.ErrWidthBackfillRunning) {
+		respondProtoError(c, http.StatusConflict, "conflict", err.Error(), "")
+
+		return
+	}
+
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	h.respondProgress(c, http.StatusAccepted)
+}
+
+func (h *WidthBackfillHandler) GetWidthBackfill(c *gin.Context) {
+	h.respondProgress(c, http.StatusOK)
+}
+
+func (h *WidthBackfillHandler) respondProgress(c *gin.Context, status int) {
+	progress, err := h.job.Progress(c.Request.Context())
+	if err != nil {
+		handleError(c, err)
+
+		return
+	}
+
+	respondProtoWidthBackfillProgress(c, status, progress)
+}
+
+func (h *WidthBackfillHandler) RegisterRoutes(router *gin.RouterGroup) {
+	admin := router.Group("/admin")
+	{
+		admin.POST("/width-backfill", h.StartWidthBackfill)
+		admin.GET("/width-backfill", h.GetWidthBackfill)
+	}
+}
+
+func respondProtoWidthBackfillProgress(c *gin.Context, status int, progress app.WidthBackfillProgress) {
+	changes := make([]*remindv1.WidthChange, 0, len(progress.Changes))
+	for _, ch := range progress.Changes {
+		changes = append(changes, &remindv1.WidthChange{
+			RemindId: ch.RemindID,
+			TaskId:   ch.TaskID,
+			Time:     timestamppb.New(ch.Time),
+			OldWidth: ch.OldWidth,
+			NewWidth: ch.NewWidth,
+		})
+	}
+
+	resp := &remindv1.WidthBackfillProgressResponse{
+		Running:          progress.Running,
+		DryRun:           progress.DryRun,
+		LastTaskId:       progress.LastTaskID,
+		Tasks:            int32(progress.Tasks),   //nolint:gosec
+		Reminds:          int32(progress.Reminds), //nolint:gosec
+		Changed:          int32(progress.Changed), //nolint:gosec
+		Failed:           int32(progress.Failed),  //nolint:gosec
+		Overlapping:      int32(progress.Overlap), //nolint:gosec
+		Skipped:          int32(progress.Skipped), //nolint:gosec
+		Changes:          changes,
+		Error:            progress.Error,
+		ChangesTruncated: progress.Truncated,
+	}
+
+	if !progress.StartedAt.IsZero() {
+		resp.StartedAt = timestamppb.New(progress.StartedAt)
+	}
+
+	if !progress.FinishedAt.IsZero() {
+		resp.FinishedAt = timestamppb.New(progress.FinishedAt)
+	}
+
+	respBytes, err := pjson.Marshal(resp)
+	if err != nil {
+		c.Status(http.StatusInternalServerError)
+
+		return
+	}
+
+	c.Data(status, "application/json", respBytes)
+}
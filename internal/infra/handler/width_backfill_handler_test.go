@@ -0,0 +1,181 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/app"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/handler"
+)
+
+type fakeBackfillUseCase struct {
+	app.RemindUseCase
+}
+
+func (fakeBackfillUseCase) BackfillSlideWindowWidths(
+	_ context.Context,
+	input app.BackfillSlideWindowWidthsInput,
+) (app.BackfillSlideWindowWidthsOutput, error) {
+	return app.BackfillSlideWindowWidthsOutput{
+		Tasks:   1,
+		Reminds: 2,
+		Changes: []app.WidthChangeOutput{
+			{RemindID: "remind-1", TaskID: "task-1", Time: input.Now.Add(time.Hour), OldWidth: 300, NewWidth: 420},
+		},
+		LastTaskID: "task-1",
+	}, nil
+}
+
+// memoryWidthBackfillRuns keeps the run in memory; a run stays claimable by
+// any instance once started.
+type memoryWidthBackfillRuns struct {
+	mu  sync.Mutex
+	run *domain.WidthBackfillRun
+}
+
+func (m *memoryWidthBackfillRuns) Find(_ context.Context) (*domain.WidthBackfillRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.run == nil {
+		return nil, domain.ErrWidthBackfillRunNotFound
+	}
+
+	run := *m.run
+
+	return &run, nil
+}
+
+func (m *memoryWidthBackfillRuns) Start(_ context.Context, run *domain.WidthBackfillRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.run != nil && m.run.Running {
+		return domain.ErrWidthBackfillRunActive
+	}
+
+	stored := *run
+	m.run = &stored
+
+	return nil
+}
+
+func (m *memoryWidthBackfillRuns) Claim(
+	_ context.Context,
+	owner string,
+	_, leaseUntil time.Time,
+) (*domain.WidthBackfillRun, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.run == nil || !m.run.Running {
+		return nil, domain.ErrWidthBackfillRunNotFound
+	}
+
+	m.run.Owner = owner
+	m.run.LeaseUntil = leaseUntil
+	run := *m.run
+
+	return &run, nil
+}
+
+func (m *memoryWidthBackfillRuns) Save(_ context.Context, run *domain.WidthBackfillRun) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	stored := *run
+	m.run = &stored
+
+	return nil
+}
+
+type widthBackfillResponse struct {
+	Running    bool   `json:"running"`
+	DryRun     bool   `json:"dry_run"`
+	LastTaskID string `json:"last_task_id"`
+	Tasks      int32  `json:"tasks"`
+	Changed    int32  `json:"changed"`
+	Changes    []struct {
+		RemindID string `json:"remind_id"`
+		OldWidth int32  `json:"old_width"`
+		NewWidth int32  `json:"new_width"`
+	} `json:"changes"`
+}
+
+func setupWidthBackfillRouter(t *testing.T, run bool) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	job := app.NewWidthBackfillJob(fakeBackfillUseCase{}, &memoryWidthBackfillRuns{}, 10, 100, time.Minute)
+
+	if run {
+		ctx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+
+		go job.Run(ctx)
+	}
+
+	router := gin.New()
+	handler.NewWidthBackfillHandler(job).RegisterRoutes(router.Group("/api/v1"))
+
+	return router
+}
+
+func TestWidthBackfillHandlerSuccess(t *testing.T) {
+	router := setupWidthBackfillRouter(t, true)
+
+	rec := serveJSON(router, http.MethodPost, "/api/v1/admin/width-backfill", map[string]any{"dry_run": true})
+	require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+
+	var resp widthBackfillResponse
+
+	require.Eventually(t, func() bool {
+		rec := serveJSON(router, http.MethodGet, "/api/v1/admin/width-backfill", nil)
+		require.Equal(t, http.StatusOK, rec.Code)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+		return !resp.Running
+	}, time.Second, 5*time.Millisecond)
+
+	assert.True(t, resp.DryRun)
+	assert.Equal(t, "task-1", resp.LastTaskID)
+	assert.Equal(t, int32(1), resp.Tasks)
+	assert.Equal(t, int32(1), resp.Changed)
+	require.Len(t, resp.Changes, 1)
+	assert.Equal(t, "remind-1", resp.Changes[0].RemindID)
+	assert.Equal(t, int32(300), resp.Changes[0].OldWidth)
+	assert.Equal(t, int32(420), resp.Changes[0].NewWidth)
+}
+
+func TestWidthBackfillHandlerError(t *testing.T) {
+	t.Run("invalid resume point", func(t *testing.T) {
+		router := setupWidthBackfillRouter(t, true)
+
+		rec := serveJSON(router, http.MethodPost, "/api/v1/admin/width-backfill", map[string]any{
+			"after_task_id": "not-a-task",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code, rec.Body.String())
+	})
+
+	t.Run("already running", func(t *testing.T) {
+		// Without the job running the first start stays pending.
+		router := setupWidthBackfillRouter(t, false)
+
+		rec := serveJSON(router, http.MethodPost, "/api/v1/admin/width-backfill", map[string]any{})
+		require.Equal(t, http.StatusAccepted, rec.Code, rec.Body.String())
+
+		rec = serveJSON(router, http.MethodPost, "/api/v1/admin/width-backfill", map[string]any{})
+
+		assert.Equal(t, http.StatusConflict, rec.Code, rec.Body.String())
+	})
+}
@@ -0,0 +1,225 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/logging"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/tracing"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+// EventMode selects the CloudEvents content mode used on the wire.
+type EventMode string
+
+const (
+	// EventModeBinary carries event attributes as "ce-" prefixed metadata and the data as payload.
+	EventModeBinary EventMode = "binary"
+	// EventModeStructured carries the whole event as an application/cloudevents+json payload.
+	EventModeStructured EventMode = "structured"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+
+	EventTypeRemindCancelled = "com.primind.remind.cancelled.v1"
+	EventTypeRemindsPaused   = "com.primind.remind.paused.v1"
+	EventTypeRemindsResumed  = "com.primind.remind.resumed.v1"
+
+	DefaultEventSource = "/primind/time-mgmt"
+
+	cloudEventsJSONContentType = "application/cloudevents+json"
+	jsonContentType            = "application/json"
+
+	metadataContentType = "content-type"
+	metadataPrefix      = "ce-"
+)
+
+var ErrInvalidCloudEvent = errors.New("invalid CloudEvent")
+
+type EventConfig struct {
+	Mode   EventMode
+	Source string
+}
+
+func ParseEventMode(s string) (EventMode, error) {
+	switch mode := EventMode(strings.ToLower(s)); mode {
+	case EventModeBinary, EventModeStructured:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("unknown CloudEvents mode: %s", s)
+	}
+}
+
+// CloudEvent is a CloudEvents 1.0 event including the distributed tracing
+// extension (traceparent, tracestate) and our requestid extension.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	DataSchema      string          `json:"dataschema,omitempty"`
+	TraceParent     string          `json:"traceparent,omitempty"`
+	TraceState      string          `json:"tracestate,omitempty"`
+	RequestID       string          `json:"requestid,omitempty"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// NewCloudEvent wraps a protobuf message, serialized as protojson, into a CloudEvent
+// carrying the trace context and request ID found in ctx.
+func NewCloudEvent(ctx context.Context, cfg EventConfig, eventType, subject string, data proto.Message) (CloudEvent, error) {
+	payload, err := pjson.Marshal(data)
+	if err != nil {
+		return CloudEvent{}, fmt.Errorf("failed to marshal event data: %w", err)
+	}
+
+	source := cfg.Source
+	if source == "" {
+		source = DefaultEventSource
+	}
+
+	carrier := make(map[string]string)
+	tracing.InjectToMap(ctx, carrier)
+
+	reqID := logging.RequestIDFromContext(ctx)
+	if reqID == "" {
+		reqID = logging.ValidateAndExtractRequestID("")
+	}
+
+	return CloudEvent{
+		SpecVersion:     CloudEventsSpecVersion,
+		ID:              watermill.NewUUID(),
+		Source:          source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            time.Now().UTC(),
+		DataContentType: jsonContentType,
+		DataSchema:      "type.googleapis.com/" + string(proto.MessageName(data)),
+		TraceParent:     carrier["traceparent"],
+		TraceState:      carrier["tracestate"],
+		RequestID:       reqID,
+		Data:            payload,
+	}, nil
+}
+
+func newEventMessage(ctx context.Context, cfg EventConfig, eventType, subject string, data proto.Message) (*message.Message, error) {
+	event, err := NewCloudEvent(ctx, cfg, eventType, subject, data)
+	if err != nil {
+		return nil, err
+	}
+
+	return event.ToMessage(cfg.Mode)
+}
+
+// ToMessage encodes the event as a watermill message. The message UUID is the event ID.
+func (e CloudEvent) ToMessage(mode EventMode) (*message.Message, error) {
+	switch mode {
+	case EventModeStructured:
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal CloudEvent: %w", err)
+		}
+
+		msg := message.NewMessage(e.ID, payload)
+		msg.Metadata.Set(metadataContentType, cloudEventsJSONContentType)
+
+		return msg, nil
+	case EventModeBinary, "":
+		msg := message.NewMessage(e.ID, []byte(e.Data))
+		msg.Metadata.Set(metadataContentType, e.DataContentType)
+
+		for k, v := range e.attributes() {
+			msg.Metadata.Set(metadataPrefix+k, v)
+		}
+
+		return msg, nil
+	default:
+		return nil, fmt.Errorf("unknown CloudEvents mode: %s", mode)
+	}
+}
+
+func (e CloudEvent) attributes() map[string]string {
+	attrs := map[string]string{
+		"specversion": e.SpecVersion,
+		"id":          e.ID,
+		"source":      e.Source,
+		"type":        e.Type,
+		"time":        e.Time.Format(time.RFC3339Nano),
+	}
+
+	optional := map[string]string{
+		"subject":     e.Subject,
+		"dataschema":  e.DataSchema,
+		"traceparent": e.TraceParent,
+		"tracestate":  e.TraceState,
+		"requestid":   e.RequestID,
+	}
+	for k, v := range optional {
+		if v != "" {
+			attrs[k] = v
+		}
+	}
+
+	return attrs
+}
+
+// CloudEventFromMessage decodes a message produced by ToMessage in either mode.
+func CloudEventFromMessage(msg *message.Message) (CloudEvent, error) {
+	if msg.Metadata.Get(metadataContentType) == cloudEventsJSONContentType {
+		var event CloudEvent
+		if err := json.Unmarshal(msg.Payload, &event); err != nil {
+			return CloudEvent{}, fmt.Errorf("%w: %v", ErrInvalidCloudEvent, err)
+		}
+
+		return event, event.validate()
+	}
+
+	event := CloudEvent{
+		SpecVersion:     msg.Metadata.Get(metadataPrefix + "specversion"),
+		ID:              msg.Metadata.Get(metadataPrefix + "id"),
+		Source:          msg.Metadata.Get(metadataPrefix + "source"),
+		Type:            msg.Metadata.Get(metadataPrefix + "type"),
+		Subject:         msg.Metadata.Get(metadataPrefix + "subject"),
+		Time:            time.Time{},
+		DataContentType: msg.Metadata.Get(metadataContentType),
+		DataSchema:      msg.Metadata.Get(metadataPrefix + "dataschema"),
+		TraceParent:     msg.Metadata.Get(metadataPrefix + "traceparent"),
+		TraceState:      msg.Metadata.Get(metadataPrefix + "tracestate"),
+		RequestID:       msg.Metadata.Get(metadataPrefix + "requestid"),
+		Data:            json.RawMessage(msg.Payload),
+	}
+
+	if t := msg.Metadata.Get(metadataPrefix + "time"); t != "" {
+		parsed, err := time.Parse(time.RFC3339Nano, t)
+		if err != nil {
+			return CloudEvent{}, fmt.Errorf("%w: invalid time: %v", ErrInvalidCloudEvent, err)
+		}
+
+		event.Time = parsed
+	}
+
+	return event, event.validate()
+}
+
+func (e CloudEvent) validate() error {
+	if e.SpecVersion != CloudEventsSpecVersion {
+		return fmt.Errorf("%w: unsupported specversion %q", ErrInvalidCloudEvent, e.SpecVersion)
+	}
+
+	if e.ID == "" || e.Source == "" || e.Type == "" {
+		return fmt.Errorf("%w: id, source and type are required", ErrInvalidCloudEvent)
+	}
+
+	return nil
+}
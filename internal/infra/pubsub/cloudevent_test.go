@@ -0,0 +1,145 @@
+package pubsub_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/logging"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/tracing"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+func TestNewCloudEventSuccess(t *testing.T) {
+	tracing.SetupPropagator()
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+	ctx = logging.WithRequestID(ctx, "01936c4e-7b1a-7000-8000-000000000001")
+
+	req := newCancelRemindRequest()
+
+	event, err := pubsub.NewCloudEvent(ctx, pubsub.EventConfig{Mode: pubsub.EventModeBinary, Source: ""},
+		pubsub.EventTypeRemindCancelled, req.GetTaskId(), req)
+
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.CloudEventsSpecVersion, event.SpecVersion)
+	assert.NotEmpty(t, event.ID)
+	assert.Equal(t, pubsub.DefaultEventSource, event.Source)
+	assert.Equal(t, pubsub.EventTypeRemindCancelled, event.Type)
+	assert.Equal(t, req.GetTaskId(), event.Subject)
+	assert.WithinDuration(t, time.Now(), event.Time, time.Minute)
+	assert.Equal(t, "application/json", event.DataContentType)
+	assert.Equal(t, "type.googleapis.com/throttle.v1.CancelRemindRequest", event.DataSchema)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", event.TraceParent)
+	assert.Equal(t, "01936c4e-7b1a-7000-8000-000000000001", event.RequestID)
+
+	var data throttlev1.CancelRemindRequest
+	require.NoError(t, pjson.Unmarshal(event.Data, &data))
+	assert.Equal(t, req.GetRemindIds(), data.GetRemindIds())
+}
+
+func TestCloudEventMessageRoundTripSuccess(t *testing.T) {
+	tests := []struct {
+		name                string
+		mode                pubsub.EventMode
+		expectedContentType string
+	}{
+		{
+			name:                "binary mode",
+			mode:                pubsub.EventModeBinary,
+			expectedContentType: "application/json",
+		},
+		{
+			name:                "structured mode",
+			mode:                pubsub.EventModeStructured,
+			expectedContentType: "application/cloudevents+json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := newCancelRemindRequest()
+
+			event, err := pubsub.NewCloudEvent(context.Background(),
+				pubsub.EventConfig{Mode: tt.mode, Source: "/primind/test"},
+				pubsub.EventTypeRemindCancelled, req.GetTaskId(), req)
+			require.NoError(t, err)
+
+			msg, err := event.ToMessage(tt.mode)
+			require.NoError(t, err)
+			assert.Equal(t, event.ID, msg.UUID)
+			assert.Equal(t, tt.expectedContentType, msg.Metadata.Get("content-type"))
+
+			decoded, err := pubsub.CloudEventFromMessage(msg)
+			require.NoError(t, err)
+			assert.Equal(t, event.ID, decoded.ID)
+			assert.Equal(t, event.Source, decoded.Source)
+			assert.Equal(t, event.Type, decoded.Type)
+			assert.Equal(t, event.Subject, decoded.Subject)
+			assert.True(t, event.Time.Equal(decoded.Time))
+			assert.Equal(t, event.DataSchema, decoded.DataSchema)
+			assert.Equal(t, event.RequestID, decoded.RequestID)
+			assert.JSONEq(t, string(event.Data), string(decoded.Data))
+		})
+	}
+}
+
+func TestCloudEventFromMessageError(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  *message.Message
+	}{
+		{
+			name: "binary message without attributes",
+			msg:  message.NewMessage("id", []byte(`{}`)),
+		},
+		{
+			name: "structured message with malformed payload",
+			msg: func() *message.Message {
+				msg := message.NewMessage("id", []byte(`{`))
+				msg.Metadata.Set("content-type", "application/cloudevents+json")
+
+				return msg
+			}(),
+		},
+		{
+			name: "structured message with unsupported specversion",
+			msg: func() *message.Message {
+				msg := message.NewMessage("id", []byte(`{"specversion":"0.3","id":"1","source":"/s","type":"t","data":{}}`))
+				msg.Metadata.Set("content-type", "application/cloudevents+json")
+
+				return msg
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := pubsub.CloudEventFromMessage(tt.msg)
+
+			assert.ErrorIs(t, err, pubsub.ErrInvalidCloudEvent)
+		})
+	}
+}
+
+func TestParseEventModeSuccess(t *testing.T) {
+	mode, err := pubsub.ParseEventMode("Structured")
+	require.NoError(t, err)
+	assert.Equal(t, pubsub.EventModeStructured, mode)
+
+	_, err = pubsub.ParseEventMode("batched")
+	assert.Error(t, err)
+}
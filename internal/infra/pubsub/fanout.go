@@ -0,0 +1,161 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/metrics"
+)
+
+// FailurePolicy decides whether a backend's publish failure fails the fan-out.
+type FailurePolicy string
+
+const (
+	// FailurePolicyRequired fails the publish when the backend fails.
+	FailurePolicyRequired FailurePolicy = "required"
+	// FailurePolicyBestEffort logs the backend failure and carries on.
+	FailurePolicyBestEffort FailurePolicy = "best-effort"
+)
+
+// FanOutTarget is one backend of a FanOutPublisher.
+type FanOutTarget struct {
+	Name      string
+	Publisher Publisher
+	Policy    FailurePolicy
+}
+
+// FanOutPublisher publishes every event to several backends concurrently,
+// e.g. to both NATS and Google Cloud Pub/Sub while consumers migrate.
+type FanOutPublisher struct {
+	targets []FanOutTarget
+	metrics *metrics.PublishMetrics
+}
+
+type FanOutPublisherConfig struct {
+	Targets []FanOutTarget
+	// Metrics records per-backend publish outcomes. Optional.
+	Metrics *metrics.PublishMetrics
+}
+
+func NewFanOutPublisher(cfg FanOutPublisherConfig) (*FanOutPublisher, error) {
+	if len(cfg.Targets) == 0 {
+		return nil, errors.New("fan-out publisher requires at least one target")
+	}
+
+	for _, target := range cfg.Targets {
+		if target.Policy != FailurePolicyRequired && target.Policy != FailurePolicyBestEffort {
+			return nil, fmt.Errorf("unknown failure policy %q for backend %s", target.Policy, target.Name)
+		}
+	}
+
+	return &FanOutPublisher{
+		targets: cfg.Targets,
+		metrics: cfg.Metrics,
+	}, nil
+}
+
+// PublishRemindCancelled publishes to every target and returns an error joining
+// the failures of required targets. Best-effort failures are only logged.
+func (p *FanOutPublisher) PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
+	return p.publishAll(ctx, EventTypeRemindCancelled, req.GetTaskId(), func(target Publisher) error {
+		return target.PublishRemindCancelled(ctx, req)
+	})
+}
+
+// PublishRemindsPaused publishes to every target with the same failure handling
+// as PublishRemindCancelled.
+func (p *FanOutPublisher) PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error {
+	return p.publishAll(ctx, EventTypeRemindsPaused, req.GetUserId(), func(target Publisher) error {
+		return target.PublishRemindsPaused(ctx, req)
+	})
+}
+
+// PublishRemindsResumed publishes to every target with the same failure handling
+// as PublishRemindCancelled.
+func (p *FanOutPublisher) PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error {
+	return p.publishAll(ctx, EventTypeRemindsResumed, req.GetUserId(), func(target Publisher) error {
+		return target.PublishRemindsResumed(ctx, req)
+	})
+}
+
+func (p *FanOutPublisher) publishAll(
+	ctx context.Context,
+	eventType string,
+	subject string,
+	publish func(target Publisher) error,
+) error {
+	errs := make([]error, len(p.targets))
+
+	var wg sync.WaitGroup
+
+	for i, target := range p.targets {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			errs[i] = p.publishTo(ctx, target, eventType, subject, publish)
+		}()
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func (p *FanOutPublisher) publishTo(
+	ctx context.Context,
+	target FanOutTarget,
+	eventType string,
+	subject string,
+	publish func(target Publisher) error,
+) error {
+	start := time.Now()
+	err := publish(target.Publisher)
+
+	outcome := "success"
+	if err != nil {
+		outcome = "failure"
+	}
+
+	if p.metrics != nil {
+		p.metrics.Record(ctx, target.Name, eventType, outcome, time.Since(start))
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	if target.Policy == FailurePolicyBestEffort {
+		slog.WarnContext(ctx, "best-effort publish failed, continuing",
+			slog.String("event", "pubsub.fanout.best_effort.fail"),
+			slog.String("backend", target.Name),
+			slog.String("event_type", eventType),
+			slog.String("subject", subject),
+			slog.String("error", err.Error()),
+		)
+
+		return nil
+	}
+
+	return fmt.Errorf("backend %s: %w", target.Name, err)
+}
+
+// Close closes every target and returns the joined errors.
+func (p *FanOutPublisher) Close() error {
+	errs := make([]error, 0, len(p.targets))
+
+	for _, target := range p.targets {
+		if err := target.Publisher.Close(); err != nil {
+			errs = append(errs, fmt.Errorf("backend %s: %w", target.Name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,185 @@
+package pubsub_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/metrics"
+)
+
+func TestFanOutPublisherPublishRemindCancelledSuccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		natsErr   error
+		gcloudErr error
+	}{
+		{
+			name: "all backends succeed",
+		},
+		{
+			name:      "best-effort backend failure is ignored",
+			gcloudErr: errors.New("pubsub unavailable"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			req := newCancelRemindRequest()
+
+			natsPublisher := pubsub.NewMockPublisher(ctrl)
+			natsPublisher.EXPECT().PublishRemindCancelled(gomock.Any(), req).Return(tt.natsErr)
+
+			gcloudPublisher := pubsub.NewMockPublisher(ctrl)
+			gcloudPublisher.EXPECT().PublishRemindCancelled(gomock.Any(), req).Return(tt.gcloudErr)
+
+			publishMetrics, err := metrics.NewPublishMetrics()
+			require.NoError(t, err)
+
+			publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+				Targets: []pubsub.FanOutTarget{
+					{Name: pubsub.BackendNATS, Publisher: natsPublisher, Policy: pubsub.FailurePolicyRequired},
+					{Name: pubsub.BackendGCloud, Publisher: gcloudPublisher, Policy: pubsub.FailurePolicyBestEffort},
+				},
+				Metrics: publishMetrics,
+			})
+			require.NoError(t, err)
+
+			assert.NoError(t, publisher.PublishRemindCancelled(context.Background(), req))
+		})
+	}
+}
+
+func TestFanOutPublisherPublishRemindCancelledError(t *testing.T) {
+	natsErr := errors.New("nats unavailable")
+	gcloudErr := errors.New("pubsub unavailable")
+
+	tests := []struct {
+		name         string
+		natsErr      error
+		gcloudErr    error
+		expectedErrs []error
+	}{
+		{
+			name:         "required backend failure fails the publish",
+			natsErr:      natsErr,
+			expectedErrs: []error{natsErr},
+		},
+		{
+			name:         "all required failures are reported",
+			natsErr:      natsErr,
+			gcloudErr:    gcloudErr,
+			expectedErrs: []error{natsErr, gcloudErr},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			req := newCancelRemindRequest()
+
+			natsPublisher := pubsub.NewMockPublisher(ctrl)
+			natsPublisher.EXPECT().PublishRemindCancelled(gomock.Any(), req).Return(tt.natsErr)
+
+			// A failing backend must not stop the others from receiving the event.
+			gcloudPublisher := pubsub.NewMockPublisher(ctrl)
+			gcloudPublisher.EXPECT().PublishRemindCancelled(gomock.Any(), req).Return(tt.gcloudErr)
+
+			publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+				Targets: []pubsub.FanOutTarget{
+					{Name: pubsub.BackendNATS, Publisher: natsPublisher, Policy: pubsub.FailurePolicyRequired},
+					{Name: pubsub.BackendGCloud, Publisher: gcloudPublisher, Policy: pubsub.FailurePolicyRequired},
+				},
+				Metrics: nil,
+			})
+			require.NoError(t, err)
+
+			err = publisher.PublishRemindCancelled(context.Background(), req)
+
+			require.Error(t, err)
+
+			for _, expected := range tt.expectedErrs {
+				assert.ErrorIs(t, err, expected)
+			}
+		})
+	}
+}
+
+func TestFanOutPublisherPublishRemindsPausedSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	req := newRemindsPausedEvent()
+
+	natsPublisher := pubsub.NewMockPublisher(ctrl)
+	natsPublisher.EXPECT().PublishRemindsPaused(gomock.Any(), req).Return(nil)
+
+	gcloudPublisher := pubsub.NewMockPublisher(ctrl)
+	gcloudPublisher.EXPECT().PublishRemindsPaused(gomock.Any(), req).Return(errors.New("pubsub unavailable"))
+
+	publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+		Targets: []pubsub.FanOutTarget{
+			{Name: pubsub.BackendNATS, Publisher: natsPublisher, Policy: pubsub.FailurePolicyRequired},
+			{Name: pubsub.BackendGCloud, Publisher: gcloudPublisher, Policy: pubsub.FailurePolicyBestEffort},
+		},
+		Metrics: nil,
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, publisher.PublishRemindsPaused(context.Background(), req))
+}
+
+func TestNewFanOutPublisherError(t *testing.T) {
+	tests := []struct {
+		name    string
+		targets []pubsub.FanOutTarget
+	}{
+		{
+			name:    "no targets",
+			targets: nil,
+		},
+		{
+			name: "unknown failure policy",
+			targets: []pubsub.FanOutTarget{
+				{Name: pubsub.BackendNATS, Publisher: nil, Policy: "sometimes"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+				Targets: tt.targets,
+				Metrics: nil,
+			})
+
+			assert.Error(t, err)
+			assert.Nil(t, publisher)
+		})
+	}
+}
+
+func TestFanOutPublisherCloseSuccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	natsPublisher := pubsub.NewMockPublisher(ctrl)
+	natsPublisher.EXPECT().Close().Return(nil)
+
+	gcloudPublisher := pubsub.NewMockPublisher(ctrl)
+	gcloudPublisher.EXPECT().Close().Return(nil)
+
+	publisher, err := pubsub.NewFanOutPublisher(pubsub.FanOutPublisherConfig{
+		Targets: []pubsub.FanOutTarget{
+			{Name: pubsub.BackendNATS, Publisher: natsPublisher, Policy: pubsub.FailurePolicyRequired},
+			{Name: pubsub.BackendGCloud, Publisher: gcloudPublisher, Policy: pubsub.FailurePolicyBestEffort},
+		},
+		Metrics: nil,
+	})
+	require.NoError(t, err)
+
+	assert.NoError(t, publisher.Close())
+}
@@ -1,3 +1,7 @@
 package pubsub
 
-const TopicRemindCancelled = "remind.cancelled"
+const (
+	TopicRemindCancelled = "remind.cancelled"
+	TopicRemindsPaused   = "remind.paused"
+	TopicRemindsResumed  = "remind.resumed"
+)
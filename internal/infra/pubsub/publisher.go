@@ -4,6 +4,7 @@ import (
 	"context"
 	"io"
 
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
 	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
 )
 
@@ -11,5 +12,7 @@ import (
 
 type Publisher interface {
 	PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error
+	PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error
+	PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error
 	io.Closer
 }
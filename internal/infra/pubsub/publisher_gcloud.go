@@ -1,5 +1,3 @@
-//go:build gcloud
-
 package pubsub
 
 import (
@@ -10,20 +8,21 @@ import (
 	"github.com/ThreeDotsLabs/watermill"
 	"github.com/ThreeDotsLabs/watermill-googlecloud/v2/pkg/googlecloud"
 	"github.com/ThreeDotsLabs/watermill/message"
+	"google.golang.org/protobuf/proto"
 
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
 	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
-	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/logging"
-	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/observability/tracing"
-	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
 )
 
 type GCloudPublisher struct {
 	publisher message.Publisher
 	logger    watermill.LoggerAdapter
+	event     EventConfig
 }
 
 type GCloudPublisherConfig struct {
 	ProjectID string
+	Event     EventConfig
 }
 
 func NewGCloudPublisher(ctx context.Context, cfg GCloudPublisherConfig) (*GCloudPublisher, error) {
@@ -42,42 +41,40 @@ func NewGCloudPublisher(ctx context.Context, cfg GCloudPublisherConfig) (*GCloud
 	return &GCloudPublisher{
 		publisher: publisher,
 		logger:    logger,
+		event:     cfg.Event,
 	}, nil
 }
 
 func (p *GCloudPublisher) PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
-	payload, err := pjson.Marshal(req)
-	if err != nil {
-		return fmt.Errorf("failed to marshal event: %w", err)
-	}
+	return p.publishEvent(ctx, TopicRemindCancelled, EventTypeRemindCancelled, req.GetTaskId(), req)
+}
 
-	msg := message.NewMessage(watermill.NewUUID(), payload)
-	msg.Metadata.Set("message_type", "remind.cancel")
-	msg.Metadata.Set("task_id", req.GetTaskId())
-	msg.Metadata.Set("user_id", req.GetUserId())
+func (p *GCloudPublisher) PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error {
+	return p.publishEvent(ctx, TopicRemindsPaused, EventTypeRemindsPaused, req.GetUserId(), req)
+}
 
-	// Inject trace context into message metadata
-	carrier := make(map[string]string)
-	tracing.InjectToMap(ctx, carrier)
-	for k, v := range carrier {
-		msg.Metadata.Set(k, v)
-	}
-	reqID := logging.RequestIDFromContext(ctx)
-	if reqID == "" {
-		reqID = logging.ValidateAndExtractRequestID("")
+func (p *GCloudPublisher) PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error {
+	return p.publishEvent(ctx, TopicRemindsResumed, EventTypeRemindsResumed, req.GetUserId(), req)
+}
+
+func (p *GCloudPublisher) publishEvent(ctx context.Context, topic, eventType, subject string, data proto.Message) error {
+	msg, err := newEventMessage(ctx, p.event, eventType, subject, data)
+	if err != nil {
+		return fmt.Errorf("failed to build event: %w", err)
 	}
-	msg.Metadata.Set("x-request-id", reqID)
 
-	if err := p.publisher.Publish(TopicRemindCancelled, msg); err != nil {
-		slog.Error("failed to publish remind cancelled event",
-			slog.String("task_id", req.GetTaskId()),
+	if err := p.publisher.Publish(topic, msg); err != nil {
+		slog.Error("failed to publish event",
+			slog.String("event_type", eventType),
+			slog.String("subject", subject),
 			slog.String("error", err.Error()),
 		)
 		return fmt.Errorf("failed to publish event: %w", err)
 	}
 
-	slog.Debug("published remind cancelled event",
-		slog.String("task_id", req.GetTaskId()),
+	slog.Debug("published event",
+		slog.String("event_type", eventType),
+		slog.String("subject", subject),
 		slog.String("message_id", msg.UUID),
 	)
 	return nil
@@ -0,0 +1,185 @@
+package pubsub
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill/message"
+	"github.com/ThreeDotsLabs/watermill/pubsub/gochannel"
+	"google.golang.org/protobuf/proto"
+
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+)
+
+const defaultGoChannelHistorySize = 100
+
+// GoChannelPublisher publishes events to an in-process Go channel.
+// It is used when no broker is configured so that local developers and
+// integration tests can observe exactly what would have been published.
+type GoChannelPublisher struct {
+	pubSub  *gochannel.GoChannel
+	logger  watermill.LoggerAdapter
+	mu      sync.RWMutex
+	history []PublishedMessage
+	size    int
+	event   EventConfig
+}
+
+type GoChannelPublisherConfig struct {
+	// HistorySize is the number of most recent messages kept for inspection.
+	// Defaults to 100 when zero or negative.
+	HistorySize int
+	Event       EventConfig
+}
+
+// PublishedMessage is a snapshot of a message published by GoChannelPublisher.
+type PublishedMessage struct {
+	UUID        string            `json:"uuid"`
+	Topic       string            `json:"topic"`
+	Metadata    map[string]string `json:"metadata"`
+	Payload     json.RawMessage   `json:"payload"`
+	PublishedAt time.Time         `json:"published_at"`
+}
+
+func NewGoChannelPublisher(cfg GoChannelPublisherConfig) *GoChannelPublisher {
+	logger := watermill.NewSlogLogger(slog.Default())
+
+	size := cfg.HistorySize
+	if size <= 0 {
+		size = defaultGoChannelHistorySize
+	}
+
+	pubSub := gochannel.NewGoChannel(
+		gochannel.Config{
+			OutputChannelBuffer:            int64(size),
+			Persistent:                     false,
+			BlockPublishUntilSubscriberAck: false,
+			PreserveContext:                false,
+		},
+		logger,
+	)
+
+	return &GoChannelPublisher{
+		pubSub:  pubSub,
+		logger:  logger,
+		mu:      sync.RWMutex{},
+		history: make([]PublishedMessage, 0, size),
+		size:    size,
+		event:   cfg.Event,
+	}
+}
+
+func (p *GoChannelPublisher) PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
+	return p.publishEvent(ctx, TopicRemindCancelled, EventTypeRemindCancelled, req.GetTaskId(), req)
+}
+
+func (p *GoChannelPublisher) PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error {
+	return p.publishEvent(ctx, TopicRemindsPaused, EventTypeRemindsPaused, req.GetUserId(), req)
+}
+
+func (p *GoChannelPublisher) PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error {
+	return p.publishEvent(ctx, TopicRemindsResumed, EventTypeRemindsResumed, req.GetUserId(), req)
+}
+
+func (p *GoChannelPublisher) publishEvent(ctx context.Context, topic, eventType, subject string, data proto.Message) error {
+	msg, err := newEventMessage(ctx, p.event, eventType, subject, data)
+	if err != nil {
+		return fmt.Errorf("failed to build event: %w", err)
+	}
+
+	if err := p.publish(topic, msg); err != nil {
+		slog.Error("failed to publish event",
+			slog.String("event_type", eventType),
+			slog.String("subject", subject),
+			slog.String("error", err.Error()),
+		)
+
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	return nil
+}
+
+func (p *GoChannelPublisher) publish(topic string, msg *message.Message) error {
+	// Snapshot before publishing: the subscriber side may ack and mutate the message.
+	published := PublishedMessage{
+		UUID:        msg.UUID,
+		Topic:       topic,
+		Metadata:    make(map[string]string, len(msg.Metadata)),
+		Payload:     json.RawMessage(append([]byte(nil), msg.Payload...)),
+		PublishedAt: time.Now(),
+	}
+	for k, v := range msg.Metadata {
+		published.Metadata[k] = v
+	}
+
+	if err := p.pubSub.Publish(topic, msg); err != nil {
+		return err
+	}
+
+	p.mu.Lock()
+	if len(p.history) == p.size {
+		p.history = append(p.history[:0], p.history[1:]...)
+	}
+
+	p.history = append(p.history, published)
+	p.mu.Unlock()
+
+	slog.Info("published event to in-memory channel",
+		slog.String("event", "pubsub.gochannel.publish"),
+		slog.String("topic", topic),
+		slog.String("message_id", msg.UUID),
+		slog.String("payload", string(msg.Payload)),
+	)
+
+	return nil
+}
+
+// Subscribe returns a channel receiving messages published to topic after the call.
+func (p *GoChannelPublisher) Subscribe(ctx context.Context, topic string) (<-chan *message.Message, error) {
+	return p.pubSub.Subscribe(ctx, topic)
+}
+
+// Messages returns the most recently published messages, oldest first.
+func (p *GoChannelPublisher) Messages() []PublishedMessage {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	messages := make([]PublishedMessage, len(p.history))
+	copy(messages, p.history)
+
+	return messages
+}
+
+// ServeHTTP writes the recently published messages as JSON, for use as a debug endpoint.
+func (p *GoChannelPublisher) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	messages := p.Messages()
+
+	body, err := json.Marshal(struct {
+		Messages []PublishedMessage `json:"messages"`
+		Count    int                `json:"count"`
+	}{
+		Messages: messages,
+		Count:    len(messages),
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(body)
+}
+
+func (p *GoChannelPublisher) Close() error {
+	return p.pubSub.Close()
+}
@@ -0,0 +1,180 @@
+package pubsub_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+	pjson "github.com/KasumiMercury/primind-remind-time-mgmt/internal/proto"
+)
+
+func newCancelRemindRequest() *throttlev1.CancelRemindRequest {
+	return &throttlev1.CancelRemindRequest{
+		TaskId:       uuid.Must(uuid.NewV7()).String(),
+		UserId:       uuid.Must(uuid.NewV7()).String(),
+		DeletedCount: 1,
+		CancelledAt:  timestamppb.Now(),
+		RemindIds:    []string{uuid.NewString()},
+	}
+}
+
+func newRemindsPausedEvent() *remindv1.RemindsPausedEvent {
+	return &remindv1.RemindsPausedEvent{
+		UserId:    uuid.Must(uuid.NewV7()).String(),
+		RemindIds: []string{uuid.NewString(), uuid.NewString()},
+		PausedAt:  timestamppb.Now(),
+		ResumeAt:  timestamppb.New(time.Now().Add(24 * time.Hour)),
+	}
+}
+
+func TestGoChannelPublisherPublishRemindCancelledSuccess(t *testing.T) {
+	publisher := pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{HistorySize: 10})
+	defer publisher.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	messages, err := publisher.Subscribe(ctx, pubsub.TopicRemindCancelled)
+	require.NoError(t, err)
+
+	req := newCancelRemindRequest()
+
+	err = publisher.PublishRemindCancelled(ctx, req)
+	require.NoError(t, err)
+
+	select {
+	case msg := <-messages:
+		msg.Ack()
+
+		var received throttlev1.CancelRemindRequest
+		require.NoError(t, pjson.Unmarshal(msg.Payload, &received))
+		assert.Equal(t, req.GetTaskId(), received.GetTaskId())
+		assert.Equal(t, req.GetRemindIds(), received.GetRemindIds())
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for published message")
+	}
+
+	history := publisher.Messages()
+	require.Len(t, history, 1)
+	assert.Equal(t, pubsub.TopicRemindCancelled, history[0].Topic)
+	assert.Equal(t, pubsub.EventTypeRemindCancelled, history[0].Metadata["ce-type"])
+	assert.Equal(t, req.GetTaskId(), history[0].Metadata["ce-subject"])
+	assert.NotEmpty(t, history[0].Metadata["ce-requestid"])
+}
+
+func TestGoChannelPublisherPublishPauseEventsSuccess(t *testing.T) {
+	publisher := pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{HistorySize: 10})
+	defer publisher.Close()
+
+	ctx := context.Background()
+	paused := newRemindsPausedEvent()
+	resumed := &remindv1.RemindsResumedEvent{
+		UserId:            paused.GetUserId(),
+		RestoredRemindIds: paused.GetRemindIds()[:1],
+		SkippedRemindIds:  paused.GetRemindIds()[1:],
+		ResumedAt:         timestamppb.Now(),
+	}
+
+	require.NoError(t, publisher.PublishRemindsPaused(ctx, paused))
+	require.NoError(t, publisher.PublishRemindsResumed(ctx, resumed))
+
+	history := publisher.Messages()
+	require.Len(t, history, 2)
+
+	assert.Equal(t, pubsub.TopicRemindsPaused, history[0].Topic)
+	assert.Equal(t, pubsub.EventTypeRemindsPaused, history[0].Metadata["ce-type"])
+	assert.Equal(t, paused.GetUserId(), history[0].Metadata["ce-subject"])
+
+	var receivedPaused remindv1.RemindsPausedEvent
+	require.NoError(t, pjson.Unmarshal(history[0].Payload, &receivedPaused))
+	assert.Equal(t, paused.GetRemindIds(), receivedPaused.GetRemindIds())
+
+	assert.Equal(t, pubsub.TopicRemindsResumed, history[1].Topic)
+	assert.Equal(t, pubsub.EventTypeRemindsResumed, history[1].Metadata["ce-type"])
+
+	var receivedResumed remindv1.RemindsResumedEvent
+	require.NoError(t, pjson.Unmarshal(history[1].Payload, &receivedResumed))
+	assert.Equal(t, resumed.GetSkippedRemindIds(), receivedResumed.GetSkippedRemindIds())
+}
+
+func TestGoChannelPublisherHistorySizeSuccess(t *testing.T) {
+	tests := []struct {
+		name         string
+		historySize  int
+		publishCount int
+		expectedLen  int
+	}{
+		{
+			name:         "history keeps all messages under the limit",
+			historySize:  3,
+			publishCount: 2,
+			expectedLen:  2,
+		},
+		{
+			name:         "history drops oldest messages over the limit",
+			historySize:  3,
+			publishCount: 5,
+			expectedLen:  3,
+		},
+		{
+			name:         "zero history size falls back to default",
+			historySize:  0,
+			publishCount: 5,
+			expectedLen:  5,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			publisher := pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{HistorySize: tt.historySize})
+			defer publisher.Close()
+
+			requests := make([]*throttlev1.CancelRemindRequest, tt.publishCount)
+			for i := range requests {
+				requests[i] = newCancelRemindRequest()
+				require.NoError(t, publisher.PublishRemindCancelled(context.Background(), requests[i]))
+			}
+
+			history := publisher.Messages()
+			require.Len(t, history, tt.expectedLen)
+
+			// The newest message is always retained last.
+			assert.Equal(t, requests[tt.publishCount-1].GetTaskId(), history[len(history)-1].Metadata["ce-subject"])
+		})
+	}
+}
+
+func TestGoChannelPublisherServeHTTPSuccess(t *testing.T) {
+	publisher := pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{HistorySize: 10})
+	defer publisher.Close()
+
+	req := newCancelRemindRequest()
+	require.NoError(t, publisher.PublishRemindCancelled(context.Background(), req))
+
+	rec := httptest.NewRecorder()
+	publisher.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/debug/pubsub/messages", nil))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+	var body struct {
+		Messages []pubsub.PublishedMessage `json:"messages"`
+		Count    int                       `json:"count"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &body))
+	assert.Equal(t, 1, body.Count)
+	require.Len(t, body.Messages, 1)
+	assert.Equal(t, pubsub.TopicRemindCancelled, body.Messages[0].Topic)
+	assert.Contains(t, string(body.Messages[0].Payload), req.GetTaskId())
+}
@@ -13,7 +13,8 @@ import (
 	context "context"
 	reflect "reflect"
 
-	v1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -56,7 +57,7 @@ func (mr *MockPublisherMockRecorder) Close() *gomock.Call {
 }
 
 // PublishRemindCancelled mocks base method.
-func (m *MockPublisher) PublishRemindCancelled(ctx context.Context, req *v1.CancelRemindRequest) error {
+func (m *MockPublisher) PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "PublishRemindCancelled", ctx, req)
 	ret0, _ := ret[0].(error)
@@ -68,3 +69,31 @@ func (mr *MockPublisherMockRecorder) PublishRemindCancelled(ctx, req any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishRemindCancelled", reflect.TypeOf((*MockPublisher)(nil).PublishRemindCancelled), ctx, req)
 }
+
+// PublishRemindsPaused mocks base method.
+func (m *MockPublisher) PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishRemindsPaused", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishRemindsPaused indicates an expected call of PublishRemindsPaused.
+func (mr *MockPublisherMockRecorder) PublishRemindsPaused(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishRemindsPaused", reflect.TypeOf((*MockPublisher)(nil).PublishRemindsPaused), ctx, req)
+}
+
+// PublishRemindsResumed mocks base method.
+func (m *MockPublisher) PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishRemindsResumed", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishRemindsResumed indicates an expected call of PublishRemindsResumed.
+func (mr *MockPublisherMockRecorder) PublishRemindsResumed(ctx, req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishRemindsResumed", reflect.TypeOf((*MockPublisher)(nil).PublishRemindsResumed), ctx, req)
+}
@@ -0,0 +1,373 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ThreeDotsLabs/watermill"
+	"github.com/ThreeDotsLabs/watermill-nats/v2/pkg/nats"
+	"github.com/ThreeDotsLabs/watermill/message"
+	nc "github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"google.golang.org/protobuf/proto"
+
+	remindv1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/remind/v1"
+	throttlev1 "github.com/KasumiMercury/primind-remind-time-mgmt/internal/gen/throttle/v1"
+)
+
+var ErrIncompatibleStream = errors.New("incompatible JetStream stream configuration")
+
+type NATSPublisher struct {
+	publisher message.Publisher
+	logger    watermill.LoggerAdapter
+	subjects  natsSubjects
+	event     EventConfig
+}
+
+type natsSubjects struct {
+	remindCancelled string
+	remindsPaused   string
+	remindsResumed  string
+}
+
+type NATSPublisherConfig struct {
+	URL    string
+	Stream NATSStreamConfig
+	Auth   NATSAuthConfig
+	Event  EventConfig
+}
+
+type NATSStreamConfig struct {
+	Name                   string
+	Subjects               []string
+	RemindCancelledSubject string
+	RemindsPausedSubject   string
+	RemindsResumedSubject  string
+	Retention              jetstream.RetentionPolicy
+	MaxAge                 time.Duration
+	MaxBytes               int64
+	Storage                jetstream.StorageType
+	Replicas               int
+}
+
+type NATSAuthConfig struct {
+	CredsFile    string
+	NKeySeedFile string
+	TLSCertFile  string
+	TLSKeyFile   string
+	TLSCAFile    string
+}
+
+func ParseRetentionPolicy(s string) (jetstream.RetentionPolicy, error) {
+	switch strings.ToLower(s) {
+	case "limits":
+		return jetstream.LimitsPolicy, nil
+	case "interest":
+		return jetstream.InterestPolicy, nil
+	case "workqueue":
+		return jetstream.WorkQueuePolicy, nil
+	default:
+		return 0, fmt.Errorf("unknown retention policy: %s", s)
+	}
+}
+
+func ParseStorageType(s string) (jetstream.StorageType, error) {
+	switch strings.ToLower(s) {
+	case "file":
+		return jetstream.FileStorage, nil
+	case "memory":
+		return jetstream.MemoryStorage, nil
+	default:
+		return 0, fmt.Errorf("unknown storage type: %s", s)
+	}
+}
+
+// NewNATSPublisherWithStream connects to NATS once, ensures the configured stream
+// exists and is compatible, and publishes over the same connection.
+func NewNATSPublisherWithStream(ctx context.Context, cfg NATSPublisherConfig) (*NATSPublisher, error) {
+	logger := watermill.NewSlogLogger(slog.Default())
+
+	opts, err := cfg.Auth.natsOptions()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := nc.Connect(cfg.URL, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to NATS: %w", err)
+	}
+
+	if err := ensureStream(ctx, conn, cfg.Stream); err != nil {
+		conn.Close()
+
+		return nil, err
+	}
+
+	publisher, err := nats.NewPublisherWithNatsConn(
+		conn,
+		nats.PublisherPublishConfig{
+			Marshaler:         &nats.NATSMarshaler{},
+			SubjectCalculator: nats.DefaultSubjectCalculator,
+			JetStream: nats.JetStreamConfig{
+				Disabled:      false,
+				AutoProvision: false,
+			},
+		},
+		logger,
+	)
+	if err != nil {
+		conn.Close()
+
+		return nil, fmt.Errorf("failed to create NATS publisher: %w", err)
+	}
+
+	return &NATSPublisher{
+		publisher: publisher,
+		logger:    logger,
+		subjects: natsSubjects{
+			remindCancelled: cfg.Stream.RemindCancelledSubject,
+			remindsPaused:   cfg.Stream.RemindsPausedSubject,
+			remindsResumed:  cfg.Stream.RemindsResumedSubject,
+		},
+		event: cfg.Event,
+	}, nil
+}
+
+func (c NATSAuthConfig) natsOptions() ([]nc.Option, error) {
+	opts := []nc.Option{
+		nc.Name("time-mgmt"),
+		nc.Timeout(10 * time.Second),
+	}
+
+	if c.CredsFile != "" {
+		opts = append(opts, nc.UserCredentials(c.CredsFile))
+	}
+
+	if c.NKeySeedFile != "" {
+		opt, err := nc.NkeyOptionFromSeed(c.NKeySeedFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load NKEY seed: %w", err)
+		}
+
+		opts = append(opts, opt)
+	}
+
+	if c.TLSCertFile != "" {
+		opts = append(opts, nc.ClientCert(c.TLSCertFile, c.TLSKeyFile))
+	}
+
+	if c.TLSCAFile != "" {
+		opts = append(opts, nc.RootCAs(c.TLSCAFile))
+	}
+
+	return opts, nil
+}
+
+func ensureStream(ctx context.Context, conn *nc.Conn, cfg NATSStreamConfig) error {
+	js, err := jetstream.New(conn)
+	if err != nil {
+		return fmt.Errorf("failed to create JetStream context: %w", err)
+	}
+
+	desired := jetstream.StreamConfig{
+		Name:        cfg.Name,
+		Description: "Stream for remind events",
+		Subjects:    cfg.Subjects,
+		Retention:   cfg.Retention,
+		MaxAge:      cfg.MaxAge,
+		MaxBytes:    cfg.MaxBytes,
+		Storage:     cfg.Storage,
+		Replicas:    cfg.Replicas,
+	}
+
+	stream, err := js.Stream(ctx, cfg.Name)
+	if errors.Is(err, jetstream.ErrStreamNotFound) {
+		if _, err := js.CreateStream(ctx, desired); err != nil {
+			return fmt.Errorf("failed to create stream: %w", err)
+		}
+
+		slog.Info("NATS JetStream stream created",
+			slog.String("stream", cfg.Name),
+			slog.Any("subjects", cfg.Subjects),
+			slog.Int("replicas", cfg.Replicas),
+		)
+
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to look up stream: %w", err)
+	}
+
+	existing := stream.CachedInfo().Config
+	published := []string{cfg.RemindCancelledSubject, cfg.RemindsPausedSubject, cfg.RemindsResumedSubject}
+
+	// Streams created before an event type was added lack its subject. Adding
+	// subjects is the one change made in place, so upgrades keep starting.
+	if missing := MissingStreamSubjects(existing, published...); len(missing) > 0 {
+		existing.Subjects = append(slices.Clone(existing.Subjects), missing...)
+
+		updated, err := js.UpdateStream(ctx, existing)
+		if err != nil {
+			return fmt.Errorf("failed to add subjects %v to stream: %w", missing, err)
+		}
+
+		existing = updated.CachedInfo().Config
+
+		slog.Info("NATS JetStream stream subjects added",
+			slog.String("stream", cfg.Name),
+			slog.Any("added", missing),
+			slog.Any("subjects", existing.Subjects),
+		)
+	}
+
+	drift, err := CheckStreamCompatibility(existing, desired, published...)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range drift {
+		slog.Warn("NATS JetStream stream differs from configuration, leaving it unchanged",
+			slog.String("stream", cfg.Name),
+			slog.String("difference", d),
+		)
+	}
+
+	slog.Info("NATS JetStream stream verified",
+		slog.String("stream", cfg.Name),
+		slog.String("subject", cfg.RemindCancelledSubject),
+		slog.String("paused_subject", cfg.RemindsPausedSubject),
+		slog.String("resumed_subject", cfg.RemindsResumedSubject),
+	)
+
+	return nil
+}
+
+// CheckStreamCompatibility reports whether an existing stream can carry events
+// published to every subject with the desired settings. Properties that JetStream cannot
+// change in place, or that would stop our events from being stored, are errors;
+// limits that merely differ are returned as drift descriptions.
+func CheckStreamCompatibility(existing, desired jetstream.StreamConfig, subjects ...string) ([]string, error) {
+	for _, subject := range subjects {
+		if !slices.ContainsFunc(existing.Subjects, func(pattern string) bool {
+			return subjectMatches(pattern, subject)
+		}) {
+			return nil, fmt.Errorf("%w: stream %s does not capture subject %s (subjects: %v)",
+				ErrIncompatibleStream, existing.Name, subject, existing.Subjects)
+		}
+	}
+
+	if existing.Retention != desired.Retention {
+		return nil, fmt.Errorf("%w: stream %s has retention %s, want %s",
+			ErrIncompatibleStream, existing.Name, existing.Retention, desired.Retention)
+	}
+
+	if existing.Storage != desired.Storage {
+		return nil, fmt.Errorf("%w: stream %s has storage %s, want %s",
+			ErrIncompatibleStream, existing.Name, existing.Storage, desired.Storage)
+	}
+
+	var drift []string
+
+	if existing.Replicas != desired.Replicas {
+		drift = append(drift, fmt.Sprintf("replicas: %d (configured %d)", existing.Replicas, desired.Replicas))
+	}
+
+	if existing.MaxAge != desired.MaxAge {
+		drift = append(drift, fmt.Sprintf("max_age: %s (configured %s)", existing.MaxAge, desired.MaxAge))
+	}
+
+	if existing.MaxBytes != desired.MaxBytes {
+		drift = append(drift, fmt.Sprintf("max_bytes: %d (configured %d)", existing.MaxBytes, desired.MaxBytes))
+	}
+
+	return drift, nil
+}
+
+// MissingStreamSubjects returns the subjects that none of the stream's
+// subject patterns capture, in the given order.
+func MissingStreamSubjects(existing jetstream.StreamConfig, subjects ...string) []string {
+	var missing []string
+
+	for _, subject := range subjects {
+		if slices.Contains(missing, subject) {
+			continue
+		}
+
+		if !slices.ContainsFunc(existing.Subjects, func(pattern string) bool {
+			return subjectMatches(pattern, subject)
+		}) {
+			missing = append(missing, subject)
+		}
+	}
+
+	return missing
+}
+
+// subjectMatches reports whether a NATS subject pattern (supporting "*" and ">") matches subject.
+func subjectMatches(pattern, subject string) bool {
+	patternTokens := strings.Split(pattern, ".")
+	subjectTokens := strings.Split(subject, ".")
+
+	for i, token := range patternTokens {
+		if token == ">" {
+			return len(subjectTokens) > i
+		}
+
+		if i >= len(subjectTokens) {
+			return false
+		}
+
+		if token != "*" && token != subjectTokens[i] {
+			return false
+		}
+	}
+
+	return len(patternTokens) == len(subjectTokens)
+}
+
+func (p *NATSPublisher) PublishRemindCancelled(ctx context.Context, req *throttlev1.CancelRemindRequest) error {
+	return p.publishEvent(ctx, p.subjects.remindCancelled, EventTypeRemindCancelled, req.GetTaskId(), req)
+}
+
+func (p *NATSPublisher) PublishRemindsPaused(ctx context.Context, req *remindv1.RemindsPausedEvent) error {
+	return p.publishEvent(ctx, p.subjects.remindsPaused, EventTypeRemindsPaused, req.GetUserId(), req)
+}
+
+func (p *NATSPublisher) PublishRemindsResumed(ctx context.Context, req *remindv1.RemindsResumedEvent) error {
+	return p.publishEvent(ctx, p.subjects.remindsResumed, EventTypeRemindsResumed, req.GetUserId(), req)
+}
+
+func (p *NATSPublisher) publishEvent(ctx context.Context, natsSubject, eventType, subject string, data proto.Message) error {
+	msg, err := newEventMessage(ctx, p.event, eventType, subject, data)
+	if err != nil {
+		return fmt.Errorf("failed to build event: %w", err)
+	}
+
+	if err := p.publisher.Publish(natsSubject, msg); err != nil {
+		slog.Error("failed to publish event",
+			slog.String("event_type", eventType),
+			slog.String("subject", subject),
+			slog.String("error", err.Error()),
+		)
+
+		return fmt.Errorf("failed to publish event: %w", err)
+	}
+
+	slog.Debug("published event",
+		slog.String("event_type", eventType),
+		slog.String("subject", subject),
+		slog.String("message_id", msg.UUID),
+	)
+
+	return nil
+}
+
+func (p *NATSPublisher) Close() error {
+	return p.publisher.Close()
+}
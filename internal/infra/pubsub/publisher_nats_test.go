@@ -0,0 +1,184 @@
+package pubsub_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+)
+
+func newStreamConfig() jetstream.StreamConfig {
+	return jetstream.StreamConfig{
+		Name:      "REMIND_EVENTS",
+		Subjects:  []string{"remind.cancelled"},
+		Retention: jetstream.LimitsPolicy,
+		MaxAge:    24 * time.Hour,
+		MaxBytes:  100 * 1024 * 1024,
+		Storage:   jetstream.FileStorage,
+		Replicas:  1,
+	}
+}
+
+func TestCheckStreamCompatibilitySuccess(t *testing.T) {
+	tests := []struct {
+		name          string
+		modify        func(cfg *jetstream.StreamConfig)
+		subject       string
+		expectedDrift int
+	}{
+		{
+			name:          "identical stream",
+			modify:        func(_ *jetstream.StreamConfig) {},
+			subject:       "remind.cancelled",
+			expectedDrift: 0,
+		},
+		{
+			name: "single token wildcard captures subject",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Subjects = []string{"remind.*"}
+			},
+			subject:       "remind.paused",
+			expectedDrift: 0,
+		},
+		{
+			name: "full wildcard captures subject",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Subjects = []string{"other", ">"}
+			},
+			subject:       "remind.cancelled",
+			expectedDrift: 0,
+		},
+		{
+			name: "different limits are reported as drift",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Replicas = 3
+				cfg.MaxAge = 72 * time.Hour
+				cfg.MaxBytes = -1
+			},
+			subject:       "remind.cancelled",
+			expectedDrift: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := newStreamConfig()
+			tt.modify(&existing)
+
+			drift, err := pubsub.CheckStreamCompatibility(existing, newStreamConfig(), "remind.cancelled", tt.subject)
+
+			require.NoError(t, err)
+			assert.Len(t, drift, tt.expectedDrift)
+		})
+	}
+}
+
+func TestCheckStreamCompatibilityError(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(cfg *jetstream.StreamConfig)
+		subject string
+	}{
+		{
+			name: "subject not captured",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Subjects = []string{"task.>"}
+			},
+			subject: "remind.cancelled",
+		},
+		{
+			name: "single token wildcard does not span tokens",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Subjects = []string{"*"}
+			},
+			subject: "remind.cancelled",
+		},
+		{
+			name: "paused subject not captured",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Subjects = []string{"remind.cancelled", "remind.resumed"}
+			},
+			subject: "remind.paused",
+		},
+		{
+			name: "different retention",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Retention = jetstream.WorkQueuePolicy
+			},
+			subject: "remind.cancelled",
+		},
+		{
+			name: "different storage",
+			modify: func(cfg *jetstream.StreamConfig) {
+				cfg.Storage = jetstream.MemoryStorage
+			},
+			subject: "remind.cancelled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := newStreamConfig()
+			tt.modify(&existing)
+
+			_, err := pubsub.CheckStreamCompatibility(existing, newStreamConfig(), "remind.cancelled", tt.subject)
+
+			assert.ErrorIs(t, err, pubsub.ErrIncompatibleStream)
+		})
+	}
+}
+
+func TestMissingStreamSubjectsSuccess(t *testing.T) {
+	tests := []struct {
+		name     string
+		subjects []string
+		expected []string
+	}{
+		{
+			name:     "stream created before the pause events",
+			subjects: []string{"remind.cancelled"},
+			expected: []string{"remind.paused", "remind.resumed"},
+		},
+		{
+			name:     "every subject captured",
+			subjects: []string{"remind.cancelled", "remind.paused", "remind.resumed"},
+			expected: nil,
+		},
+		{
+			name:     "wildcard captures every subject",
+			subjects: []string{"remind.>"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			existing := newStreamConfig()
+			existing.Subjects = tt.subjects
+
+			missing := pubsub.MissingStreamSubjects(existing, "remind.cancelled", "remind.paused", "remind.resumed")
+
+			assert.Equal(t, tt.expected, missing)
+		})
+	}
+}
+
+func TestParseRetentionPolicyAndStorageType(t *testing.T) {
+	retention, err := pubsub.ParseRetentionPolicy("Interest")
+	require.NoError(t, err)
+	assert.Equal(t, jetstream.InterestPolicy, retention)
+
+	storage, err := pubsub.ParseStorageType("memory")
+	require.NoError(t, err)
+	assert.Equal(t, jetstream.MemoryStorage, storage)
+
+	_, err = pubsub.ParseRetentionPolicy("forever")
+	assert.Error(t, err)
+
+	_, err = pubsub.ParseStorageType("tape")
+	assert.Error(t, err)
+}
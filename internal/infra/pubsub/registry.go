@@ -0,0 +1,58 @@
+package pubsub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+const (
+	BackendNATS      = "nats"
+	BackendGCloud    = "gcloud"
+	BackendGoChannel = "gochannel"
+)
+
+var ErrUnknownBackend = errors.New("unknown publisher backend")
+
+// Factory builds a Publisher for one backend.
+type Factory func(ctx context.Context) (Publisher, error)
+
+// Registry maps backend names to publisher factories so the backend can be
+// chosen from configuration at runtime.
+type Registry struct {
+	factories map[string]Factory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		factories: make(map[string]Factory),
+	}
+}
+
+// Register adds or replaces the factory for name.
+func (r *Registry) Register(name string, factory Factory) {
+	r.factories[name] = factory
+}
+
+// New builds the publisher registered under name.
+func (r *Registry) New(ctx context.Context, name string) (Publisher, error) {
+	factory, ok := r.factories[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (registered: %v)", ErrUnknownBackend, name, r.Names())
+	}
+
+	return factory(ctx)
+}
+
+// Names returns the registered backend names in sorted order.
+func (r *Registry) Names() []string {
+	names := make([]string, 0, len(r.factories))
+	for name := range r.factories {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
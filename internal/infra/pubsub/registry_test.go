@@ -0,0 +1,62 @@
+package pubsub_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/pubsub"
+)
+
+func TestRegistryNewSuccess(t *testing.T) {
+	registry := pubsub.NewRegistry()
+	registry.Register(pubsub.BackendGoChannel, func(_ context.Context) (pubsub.Publisher, error) {
+		return pubsub.NewGoChannelPublisher(pubsub.GoChannelPublisherConfig{}), nil
+	})
+
+	publisher, err := registry.New(context.Background(), pubsub.BackendGoChannel)
+	require.NoError(t, err)
+
+	defer publisher.Close()
+
+	assert.IsType(t, &pubsub.GoChannelPublisher{}, publisher)
+	assert.Equal(t, []string{pubsub.BackendGoChannel}, registry.Names())
+}
+
+func TestRegistryNewError(t *testing.T) {
+	factoryErr := errors.New("connection refused")
+
+	tests := []struct {
+		name        string
+		backend     string
+		expectedErr error
+	}{
+		{
+			name:        "unknown backend",
+			backend:     "kafka",
+			expectedErr: pubsub.ErrUnknownBackend,
+		},
+		{
+			name:        "factory error is returned",
+			backend:     pubsub.BackendNATS,
+			expectedErr: factoryErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registry := pubsub.NewRegistry()
+			registry.Register(pubsub.BackendNATS, func(_ context.Context) (pubsub.Publisher, error) {
+				return nil, factoryErr
+			})
+
+			publisher, err := registry.New(context.Background(), tt.backend)
+
+			require.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, publisher)
+		})
+	}
+}
@@ -39,17 +39,77 @@ func (d DevicesJSONB) Value() (driver.Value, error) {
 	return json.Marshal(d)
 }
 
+type EscalationStepsJSONB []string
+
+func (s *EscalationStepsJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan EscalationStepsJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, s)
+}
+
+func (s EscalationStepsJSONB) Value() (driver.Value, error) {
+	if s == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(s)
+}
+
+// PayloadJSONB is the stored notification payload; a NULL column means none.
+type PayloadJSONB struct {
+	Title    string            `json:"title,omitempty"`
+	Body     string            `json:"body,omitempty"`
+	DeepLink string            `json:"deep_link,omitempty"`
+	Locale   string            `json:"locale,omitempty"`
+	Data     map[string]string `json:"data,omitempty"`
+}
+
+func (p *PayloadJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*p = PayloadJSONB{}
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan PayloadJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, p)
+}
+
+func (p PayloadJSONB) Value() (driver.Value, error) {
+	return json.Marshal(p)
+}
+
 type RemindModel struct {
-	ID          string       `gorm:"column:id;type:uuid;primaryKey"`
-	Time        time.Time    `gorm:"column:time;type:timestamptz;not null;index:idx_reminds_time;uniqueIndex:idx_reminds_task_id_time"`
-	UserID      string       `gorm:"column:user_id;type:uuid;not null;index:idx_reminds_user_id"`
-	Devices     DevicesJSONB `gorm:"column:devices;type:jsonb;not null"`
-	TaskID      string       `gorm:"column:task_id;type:uuid;not null;uniqueIndex:idx_reminds_task_id_time"`
-	TaskType    string       `gorm:"column:task_type;type:varchar(255);not null"`
-	Throttled        bool  `gorm:"column:throttled;type:boolean;not null;default:false;index:idx_reminds_throttled"`
-	SlideWindowWidth int32 `gorm:"column:slide_window_width;type:integer;not null"` // stored as seconds
-	CreatedAt        time.Time    `gorm:"column:created_at;type:timestamptz;not null"`
-	UpdatedAt   time.Time    `gorm:"column:updated_at;type:timestamptz;not null"`
+	ID                     string               `gorm:"column:id;type:uuid;primaryKey"`
+	Time                   time.Time            `gorm:"column:time;type:timestamptz;not null;index:idx_reminds_time;uniqueIndex:idx_reminds_task_id_time"`
+	UserID                 string               `gorm:"column:user_id;type:uuid;not null;index:idx_reminds_user_id"`
+	Devices                DevicesJSONB         `gorm:"column:devices;type:jsonb;not null"`
+	TaskID                 string               `gorm:"column:task_id;type:uuid;not null;uniqueIndex:idx_reminds_task_id_time"`
+	TaskType               string               `gorm:"column:task_type;type:varchar(255);not null"`
+	Throttled              bool                 `gorm:"column:throttled;type:boolean;not null;default:false;index:idx_reminds_throttled"`
+	SlideWindowWidth       int32                `gorm:"column:slide_window_width;type:integer;not null"` // stored as seconds
+	AcknowledgedAt         *time.Time           `gorm:"column:acknowledged_at;type:timestamptz"`
+	EscalationAfterSeconds int32                `gorm:"column:escalation_after_seconds;type:integer;not null;default:0"`
+	EscalationSteps        EscalationStepsJSONB `gorm:"column:escalation_steps;type:jsonb;not null;default:'[]'"`
+	EscalationLevel        int32                `gorm:"column:escalation_level;type:integer;not null;default:0"`
+	EscalateAt             *time.Time           `gorm:"column:escalate_at;type:timestamptz;index:idx_reminds_escalate_at"` // next escalation step; NULL once none is left
+	Paused                 bool                 `gorm:"column:paused;type:boolean;not null;default:false"`
+	Payload                *PayloadJSONB        `gorm:"column:payload;type:jsonb"`
+	CreatedAt              time.Time            `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt              time.Time            `gorm:"column:updated_at;type:timestamptz;not null"`
 }
 
 func (RemindModel) TableName() string {
@@ -97,6 +157,29 @@ func (m *RemindModel) ToEntity() (*domain.Remind, error) {
 		return nil, err
 	}
 
+	var escalationPolicy domain.EscalationPolicy
+	if len(m.EscalationSteps) > 0 {
+		steps := make([]domain.EscalationAction, 0, len(m.EscalationSteps))
+		for _, s := range m.EscalationSteps {
+			step, err := domain.NewEscalationAction(s)
+			if err != nil {
+				return nil, err
+			}
+
+			steps = append(steps, step)
+		}
+
+		escalationPolicy, err = domain.NewEscalationPolicy(time.Duration(m.EscalationAfterSeconds)*time.Second, steps)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var payload domain.Payload
+	if p := m.Payload; p != nil {
+		payload = domain.ReconstitutePayload(p.Title, p.Body, p.DeepLink, p.Locale, p.Data)
+	}
+
 	return domain.Reconstitute(
 		remindID,
 		m.Time,
@@ -106,6 +189,11 @@ func (m *RemindModel) ToEntity() (*domain.Remind, error) {
 		taskType,
 		m.Throttled,
 		slideWindowWidth,
+		m.AcknowledgedAt,
+		escalationPolicy,
+		int(m.EscalationLevel),
+		m.Paused,
+		payload,
 		m.CreatedAt,
 		m.UpdatedAt,
 	), nil
@@ -120,16 +208,44 @@ func FromEntity(e *domain.Remind) *RemindModel {
 		})
 	}
 
+	steps := make(EscalationStepsJSONB, 0, e.EscalationPolicy().MaxLevel())
+	for _, s := range e.EscalationPolicy().Steps() {
+		steps = append(steps, string(s))
+	}
+
+	var payload *PayloadJSONB
+	if p := e.Payload(); !p.IsEmpty() {
+		payload = &PayloadJSONB{
+			Title:    p.Title(),
+			Body:     p.Body(),
+			DeepLink: p.DeepLink(),
+			Locale:   p.Locale(),
+			Data:     p.Data(),
+		}
+	}
+
+	var escalateAt *time.Time
+	if at, ok := e.NextEscalationAt(); ok {
+		escalateAt = &at
+	}
+
 	return &RemindModel{
-		ID:               e.ID().String(),
-		Time:             e.Time(),
-		UserID:           e.UserID().String(),
-		Devices:          devices,
-		TaskID:           e.TaskID().String(),
-		TaskType:         string(e.TaskType()),
-		Throttled:        e.IsThrottled(),
-		SlideWindowWidth: e.SlideWindowWidth().Seconds(),
-		CreatedAt:        e.CreatedAt(),
-		UpdatedAt:        e.UpdatedAt(),
+		ID:                     e.ID().String(),
+		Time:                   e.Time(),
+		UserID:                 e.UserID().String(),
+		Devices:                devices,
+		TaskID:                 e.TaskID().String(),
+		TaskType:               string(e.TaskType()),
+		Throttled:              e.IsThrottled(),
+		SlideWindowWidth:       e.SlideWindowWidth().Seconds(),
+		AcknowledgedAt:         e.AcknowledgedAt(),
+		EscalationAfterSeconds: int32(e.EscalationPolicy().After() / time.Second), // #nosec G115
+		EscalationSteps:        steps,
+		EscalationLevel:        int32(e.EscalationLevel()), // #nosec G115
+		EscalateAt:             escalateAt,
+		Paused:                 e.IsPaused(),
+		Payload:                payload,
+		CreatedAt:              e.CreatedAt(),
+		UpdatedAt:              e.UpdatedAt(),
 	}
 }
@@ -62,6 +62,11 @@ func createValidRemind(t *testing.T, deviceCount int, throttled bool) *domain.Re
 		domain.TypeNear,
 		throttled,
 		domain.MustSlideWindowWidth(5*time.Minute),
+		nil,
+		domain.EscalationPolicy{},
+		0,
+		false,
+		domain.Payload{},
 		time.Now().Add(-1*time.Hour),
 		time.Now(),
 	)
@@ -264,6 +269,23 @@ func TestToEntityError(t *testing.T) {
 			},
 			expectedErr: "UUIDv7",
 		},
+		{
+			name: "unknown escalation step",
+			setupModel: func(t *testing.T) *repository.RemindModel {
+				return &repository.RemindModel{
+					ID:                     domain.NewRemindID().String(),
+					Time:                   time.Now().Add(1 * time.Hour),
+					UserID:                 createValidUserID(t).String(),
+					Devices:                repository.DevicesJSONB{{DeviceID: "d", FCMToken: "t"}},
+					TaskID:                 createValidTaskID(t).String(),
+					TaskType:               "near",
+					SlideWindowWidth:       300,
+					EscalationAfterSeconds: 600,
+					EscalationSteps:        repository.EscalationStepsJSONB{"call_mom"},
+				}
+			},
+			expectedErr: "escalation",
+		},
 		{
 			name: "empty device ID",
 			setupModel: func(t *testing.T) *repository.RemindModel {
@@ -483,25 +505,37 @@ func TestTableNameSuccess(t *testing.T) {
 
 func TestRoundTripConversionSuccess(t *testing.T) {
 	tests := []struct {
-		name        string
-		deviceCount int
-		throttled   bool
+		name         string
+		deviceCount  int
+		throttled    bool
+		acknowledged bool
 	}{
 		{
-			name:        "round trip with single device",
-			deviceCount: 1,
-			throttled:   false,
+			name:         "round trip with single device",
+			deviceCount:  1,
+			throttled:    false,
+			acknowledged: false,
 		},
 		{
-			name:        "round trip with multiple devices",
-			deviceCount: 3,
-			throttled:   true,
+			name:         "round trip with multiple devices",
+			deviceCount:  3,
+			throttled:    true,
+			acknowledged: false,
+		},
+		{
+			name:         "round trip with acknowledged remind",
+			deviceCount:  1,
+			throttled:    false,
+			acknowledged: true,
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			original := createValidRemind(t, tt.deviceCount, tt.throttled)
+			if tt.acknowledged {
+				require.NoError(t, original.Acknowledge(time.Now()))
+			}
 
 			model := repository.FromEntity(original)
 			restored, err := model.ToEntity()
@@ -513,9 +547,82 @@ func TestRoundTripConversionSuccess(t *testing.T) {
 			assert.Equal(t, original.TaskID().String(), restored.TaskID().String())
 			assert.Equal(t, original.TaskType(), restored.TaskType())
 			assert.Equal(t, original.IsThrottled(), restored.IsThrottled())
+			assert.Equal(t, original.AcknowledgedAt(), restored.AcknowledgedAt())
+			assert.Equal(t, original.EscalationPolicy(), restored.EscalationPolicy())
+			assert.Equal(t, original.EscalationLevel(), restored.EscalationLevel())
 			assert.Equal(t, original.CreatedAt(), restored.CreatedAt())
 			assert.Equal(t, original.UpdatedAt(), restored.UpdatedAt())
 			assert.Equal(t, original.Devices().Count(), restored.Devices().Count())
 		})
 	}
 }
+
+func TestRoundTripEscalationSuccess(t *testing.T) {
+	policy, err := domain.NewEscalationPolicy(15*time.Minute, []domain.EscalationAction{
+		domain.EscalationNarrowWindow,
+		domain.EscalationFollowUp,
+	})
+	require.NoError(t, err)
+
+	original := domain.Reconstitute(
+		domain.NewRemindID(),
+		time.Now().Add(1*time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		false,
+		domain.MustSlideWindowWidth(5*time.Minute),
+		nil,
+		policy,
+		1,
+		false,
+		domain.Payload{},
+		time.Now().Add(-1*time.Hour),
+		time.Now(),
+	)
+
+	model := repository.FromEntity(original)
+	assert.Equal(t, int32(900), model.EscalationAfterSeconds)
+	assert.Equal(t, repository.EscalationStepsJSONB{"narrow_window", "follow_up"}, model.EscalationSteps)
+	assert.Equal(t, int32(1), model.EscalationLevel)
+	require.NotNil(t, model.EscalateAt)
+	assert.True(t, original.Time().Add(30*time.Minute).Equal(*model.EscalateAt))
+
+	restored, err := model.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, policy, restored.EscalationPolicy())
+	assert.Equal(t, 1, restored.EscalationLevel())
+}
+
+func TestRoundTripPayloadSuccess(t *testing.T) {
+	payload, err := domain.NewPayload("Stand-up", "Daily sync in 5 minutes", "primind://tasks/42", "ja-JP", map[string]string{"room": "A"})
+	require.NoError(t, err)
+
+	original := createValidRemind(t, 1, false)
+	original.AssignPayload(payload)
+
+	model := repository.FromEntity(original)
+	require.NotNil(t, model.Payload)
+	assert.Equal(t, "Stand-up", model.Payload.Title)
+	assert.Equal(t, map[string]string{"room": "A"}, model.Payload.Data)
+
+	restored, err := model.ToEntity()
+	require.NoError(t, err)
+	assert.Equal(t, payload, restored.Payload())
+
+	// A remind without a payload stores NULL.
+	assert.Nil(t, repository.FromEntity(createValidRemind(t, 1, false)).Payload)
+}
+
+func TestPayloadJSONBScanSuccess(t *testing.T) {
+	var payload repository.PayloadJSONB
+
+	require.NoError(t, payload.Scan([]byte(`{"title":"t","data":{"k":"v"}}`)))
+	assert.Equal(t, repository.PayloadJSONB{Title: "t", Data: map[string]string{"k": "v"}}, payload)
+
+	require.NoError(t, payload.Scan(nil))
+	assert.Equal(t, repository.PayloadJSONB{}, payload)
+
+	assert.Error(t, payload.Scan("not bytes"))
+}
@@ -3,9 +3,13 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
+	"strings"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
 )
@@ -126,6 +130,7 @@ func (r *remindRepositoryImpl) FindByTimeRange(ctx context.Context, timeRange do
 
 	result := r.db.WithContext(ctx).
 		Where("time >= ? AND time <= ?", timeRange.Start, timeRange.End).
+		Where("paused = ? AND acknowledged_at IS NULL", false).
 		Order("time ASC").
 		Find(&models)
 
@@ -163,14 +168,178 @@ func (r *remindRepositoryImpl) FindByTimeRange(ctx context.Context, timeRange do
 	return reminds, nil
 }
 
-func (r *remindRepositoryImpl) Update(ctx context.Context, remind *domain.Remind) error {
+func (r *remindRepositoryImpl) FindDueAt(
+	ctx context.Context,
+	at time.Time,
+	excludeThrottled bool,
+) ([]*domain.Remind, error) {
+	slog.Debug("finding reminds due at",
+		"at", at,
+		"exclude_throttled", excludeThrottled,
+	)
+
+	var models []RemindModel
+
+	// No window is wider than the maximum width, so the plain time bounds let
+	// idx_reminds_time narrow the scan before the per-row window check.
+	query := r.db.WithContext(ctx).
+		Where("time >= ? AND time <= ?", at.Add(-domain.MaxSlideWindowWidth), at.Add(domain.MaxSlideWindowWidth)).
+		Where("time - make_interval(secs => slide_window_width) <= ?", at).
+		Where("time + make_interval(secs => slide_window_width) >= ?", at).
+		Where("acknowledged_at IS NULL AND paused = ?", false)
+
+	if excludeThrottled {
+		query = query.Where("throttled = ?", false)
+	}
+
+	result := query.
+		Order("time ASC").
+		Find(&models)
+
+	if result.Error != nil {
+		slog.Error("failed to find reminds due at",
+			"at", at,
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	reminds := make([]*domain.Remind, 0, len(models))
+	for _, m := range models {
+		remind, err := m.ToEntity()
+		if err != nil {
+			slog.Error("failed to convert model to entity",
+				"remind_id", m.ID,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		reminds = append(reminds, remind)
+	}
+
+	slog.Debug("reminds found due at",
+		"count", len(reminds),
+		"at", at,
+	)
+
+	return reminds, nil
+}
+
+func (r *remindRepositoryImpl) FindEscalationDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*domain.Remind, error) {
+	slog.Debug("finding reminds due for escalation",
+		"now", now,
+		"limit", limit,
+	)
+
+	var models []RemindModel
+
+	result := r.db.WithContext(ctx).
+		Where("escalate_at <= ? AND acknowledged_at IS NULL AND paused = ?", now, false).
+		Order("escalate_at ASC").
+		Limit(limit).
+		Find(&models)
+
+	if result.Error != nil {
+		slog.Error("failed to find reminds due for escalation",
+			"now", now,
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	reminds := make([]*domain.Remind, 0, len(models))
+	for _, m := range models {
+		remind, err := m.ToEntity()
+		if err != nil {
+			slog.Error("failed to convert model to entity",
+				"remind_id", m.ID,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		reminds = append(reminds, remind)
+	}
+
+	slog.Debug("reminds found due for escalation",
+		"count", len(reminds),
+	)
+
+	return reminds, nil
+}
+
+func (r *remindRepositoryImpl) ClaimEscalationDue(
+	ctx context.Context,
+	id domain.RemindID,
+	now time.Time,
+) (*domain.Remind, error) {
+	var m RemindModel
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("id = ? AND escalate_at <= ? AND acknowledged_at IS NULL AND paused = ?", id.String(), now, false).
+		Take(&m)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			slog.Debug("remind not claimed for escalation",
+				"remind_id", id.String(),
+			)
+
+			return nil, domain.ErrRemindNotFound
+		}
+
+		slog.Error("failed to claim remind for escalation",
+			"remind_id", id.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	return m.ToEntity()
+}
+
+// remindFieldColumns maps each updatable field to the columns holding it.
+var remindFieldColumns = map[domain.RemindField][]string{
+	domain.RemindFieldThrottled:        {"throttled"},
+	domain.RemindFieldAcknowledgedAt:   {"acknowledged_at", "escalate_at"},
+	domain.RemindFieldEscalation:       {"escalation_level", "escalate_at"},
+	domain.RemindFieldDevices:          {"devices"},
+	domain.RemindFieldSlideWindowWidth: {"slide_window_width"},
+}
+
+func (r *remindRepositoryImpl) Update(ctx context.Context, remind *domain.Remind, fields ...domain.RemindField) error {
 	slog.Debug("updating remind in database",
 		"remind_id", remind.ID().String(),
 	)
 
+	columns := []string{"updated_at"}
+
+	for _, field := range fields {
+		fieldColumns, ok := remindFieldColumns[field]
+		if !ok {
+			return fmt.Errorf("unknown remind field %d", field)
+		}
+
+		columns = append(columns, fieldColumns...)
+	}
+
 	m := FromEntity(remind)
 
-	result := r.db.WithContext(ctx).Model(&RemindModel{}).Where("id = ?", m.ID).Updates(m)
+	// Only the listed columns are written, zero values included, so a
+	// concurrent change to any other column is never reverted.
+	result := r.db.WithContext(ctx).Model(&RemindModel{}).Where("id = ?", m.ID).
+		Select(columns).
+		Updates(m)
 	if result.Error != nil {
 		slog.Error("failed to update remind in database",
 			"remind_id", remind.ID().String(),
@@ -195,6 +364,30 @@ func (r *remindRepositoryImpl) Update(ctx context.Context, remind *domain.Remind
 	return nil
 }
 
+func (r *remindRepositoryImpl) ReassignSlideWindowWidth(
+	ctx context.Context,
+	id domain.RemindID,
+	from domain.SlideWindowWidth,
+	to domain.SlideWindowWidth,
+) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&RemindModel{}).
+		Where("id = ? AND slide_window_width = ?", id.String(), from.Seconds()).
+		Updates(map[string]any{
+			"slide_window_width": to.Seconds(),
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		slog.Error("failed to reassign slide window width",
+			"remind_id", id.String(),
+			"error", result.Error,
+		)
+
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
 func (r *remindRepositoryImpl) Delete(ctx context.Context, id domain.RemindID) error {
 	slog.Debug("deleting remind from database",
 		"remind_id", id.String(),
@@ -284,6 +477,304 @@ func (r *remindRepositoryImpl) DeleteByTaskID(ctx context.Context, taskID domain
 	return ids, nil
 }
 
+func (r *remindRepositoryImpl) DeleteByTaskIDAfter(
+	ctx context.Context,
+	taskID domain.TaskID,
+	after time.Time,
+	keep domain.RemindID,
+) ([]domain.RemindID, error) {
+	slog.Debug("deleting reminds by task ID after time",
+		"task_id", taskID.String(),
+		"after", after,
+		"keep", keep.String(),
+	)
+
+	scope := r.db.WithContext(ctx).
+		Where("task_id = ? AND time > ? AND id <> ?", taskID.String(), after, keep.String())
+
+	var models []RemindModel
+	if err := scope.Session(&gorm.Session{}).Select("id").Find(&models).Error; err != nil {
+		slog.Error("failed to find reminds by task ID after time",
+			"task_id", taskID.String(),
+			"error", err,
+		)
+
+		return nil, err
+	}
+
+	if len(models) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]domain.RemindID, len(models))
+	for i, m := range models {
+		id, err := domain.RemindIDFromString(m.ID)
+		if err != nil {
+			slog.Error("failed to parse remind ID",
+				"id", m.ID,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		ids[i] = id
+	}
+
+	result := scope.Session(&gorm.Session{}).Delete(&RemindModel{})
+	if result.Error != nil {
+		slog.Error("failed to delete reminds by task ID after time",
+			"task_id", taskID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	slog.Debug("reminds deleted by task ID after time",
+		"task_id", taskID.String(),
+		"count", len(ids),
+	)
+
+	return ids, nil
+}
+
+func (r *remindRepositoryImpl) CountByUserIDPerTime(
+	ctx context.Context,
+	userID domain.UserID,
+	timeRange domain.TimeRange,
+) (map[time.Time]int, error) {
+	var rows []struct {
+		Time  time.Time
+		Count int64
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&RemindModel{}).
+		Select("time, count(*) AS count").
+		Where("user_id = ? AND time >= ? AND time < ?", userID.String(), timeRange.Start, timeRange.End).
+		Where("paused = ?", false).
+		Group("time").
+		Scan(&rows)
+	if result.Error != nil {
+		slog.Error("failed to count reminds by user ID",
+			"user_id", userID.String(),
+			"start", timeRange.Start,
+			"end", timeRange.End,
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	counts := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		counts[row.Time] = int(row.Count)
+	}
+
+	return counts, nil
+}
+
+func (r *remindRepositoryImpl) CountByMinutes(
+	ctx context.Context,
+	minutes []time.Time,
+) (map[time.Time]int, error) {
+	if len(minutes) == 0 {
+		return map[time.Time]int{}, nil
+	}
+
+	// One range per minute keeps the lookup on the time index.
+	conds := make([]string, 0, len(minutes))
+	args := make([]any, 0, 2*len(minutes))
+
+	for _, minute := range minutes {
+		conds = append(conds, "(time >= ? AND time < ?)")
+		args = append(args, minute, minute.Add(time.Minute))
+	}
+
+	var rows []struct {
+		Bucket time.Time
+		Count  int64
+	}
+
+	result := r.db.WithContext(ctx).
+		Model(&RemindModel{}).
+		Select("date_trunc('minute', time) AS bucket, count(*) AS count").
+		Where("("+strings.Join(conds, " OR ")+")", args...).
+		Where("paused = ?", false).
+		Group("bucket").
+		Scan(&rows)
+	if result.Error != nil {
+		slog.Error("failed to count reminds by minute",
+			"minutes", len(minutes),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	counts := make(map[time.Time]int, len(rows))
+	for _, row := range rows {
+		counts[row.Bucket] = int(row.Count)
+	}
+
+	return counts, nil
+}
+
+func (r *remindRepositoryImpl) FindTaskIDsWithRemindsAfter(
+	ctx context.Context,
+	after time.Time,
+	afterTaskID domain.TaskID,
+	limit int,
+) ([]domain.TaskID, error) {
+	var rawIDs []string
+
+	result := r.db.WithContext(ctx).
+		Model(&RemindModel{}).
+		Distinct("task_id").
+		Where("time > ? AND task_id > ?", after, afterTaskID.String()).
+		Order("task_id ASC").
+		Limit(limit).
+		Pluck("task_id", &rawIDs)
+	if result.Error != nil {
+		slog.Error("failed to find task IDs with reminds",
+			"after", after,
+			"after_task_id", afterTaskID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	taskIDs := make([]domain.TaskID, 0, len(rawIDs))
+	for _, raw := range rawIDs {
+		taskID, err := domain.TaskIDFromString(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	return taskIDs, nil
+}
+
+func (r *remindRepositoryImpl) PauseByUserIDAfter(
+	ctx context.Context,
+	userID domain.UserID,
+	after time.Time,
+) ([]domain.RemindID, error) {
+	slog.Debug("pausing reminds by user ID after time",
+		"user_id", userID.String(),
+		"after", after,
+	)
+
+	var models []RemindModel
+
+	result := r.db.WithContext(ctx).
+		Model(&models).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("user_id = ? AND time > ?", userID.String(), after).
+		Where("acknowledged_at IS NULL AND paused = ?", false).
+		Updates(map[string]any{"paused": true, "updated_at": time.Now()})
+	if result.Error != nil {
+		slog.Error("failed to pause reminds by user ID",
+			"user_id", userID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	slog.Debug("reminds paused by user ID",
+		"user_id", userID.String(),
+		"count", len(models),
+	)
+
+	return remindIDsFromModels(models)
+}
+
+func (r *remindRepositoryImpl) DeletePausedByUserIDBefore(
+	ctx context.Context,
+	userID domain.UserID,
+	before time.Time,
+) ([]domain.RemindID, error) {
+	slog.Debug("deleting paused reminds by user ID before time",
+		"user_id", userID.String(),
+		"before", before,
+	)
+
+	var models []RemindModel
+
+	result := r.db.WithContext(ctx).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("user_id = ? AND time < ? AND paused = ?", userID.String(), before, true).
+		Delete(&models)
+	if result.Error != nil {
+		slog.Error("failed to delete paused reminds by user ID",
+			"user_id", userID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	slog.Debug("paused reminds deleted by user ID",
+		"user_id", userID.String(),
+		"count", len(models),
+	)
+
+	return remindIDsFromModels(models)
+}
+
+func (r *remindRepositoryImpl) ResumeByUserID(ctx context.Context, userID domain.UserID) ([]domain.RemindID, error) {
+	slog.Debug("resuming reminds by user ID",
+		"user_id", userID.String(),
+	)
+
+	var models []RemindModel
+
+	result := r.db.WithContext(ctx).
+		Model(&models).
+		Clauses(clause.Returning{Columns: []clause.Column{{Name: "id"}}}).
+		Where("user_id = ? AND paused = ?", userID.String(), true).
+		Updates(map[string]any{"paused": false, "updated_at": time.Now()})
+	if result.Error != nil {
+		slog.Error("failed to resume reminds by user ID",
+			"user_id", userID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	slog.Debug("reminds resumed by user ID",
+		"user_id", userID.String(),
+		"count", len(models),
+	)
+
+	return remindIDsFromModels(models)
+}
+
+func remindIDsFromModels(models []RemindModel) ([]domain.RemindID, error) {
+	ids := make([]domain.RemindID, len(models))
+	for i, m := range models {
+		id, err := domain.RemindIDFromString(m.ID)
+		if err != nil {
+			slog.Error("failed to parse remind ID",
+				"id", m.ID,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		ids[i] = id
+	}
+
+	return ids, nil
+}
+
 func (r *remindRepositoryImpl) WithTx(ctx context.Context, fn func(repo domain.RemindRepository) error) error {
 	tx := r.db.WithContext(ctx).Begin()
 	if tx.Error != nil {
@@ -186,6 +186,11 @@ func TestFindByIDSuccess(t *testing.T) {
 				domain.TypeNear,
 				tt.throttled,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				time.Now().Add(-1*time.Hour),
 				time.Now(),
 			)
@@ -287,6 +292,11 @@ func TestFindByTaskIDSuccess(t *testing.T) {
 					domain.TypeNear,
 					false,
 					domain.MustSlideWindowWidth(5*time.Minute),
+					nil,
+					domain.EscalationPolicy{},
+					0,
+					false,
+					domain.Payload{},
 					time.Now().Add(-1*time.Hour),
 					time.Now(),
 				)
@@ -402,6 +412,11 @@ func TestFindByTimeRangeSuccess(t *testing.T) {
 					domain.TypeNear,
 					false,
 					domain.MustSlideWindowWidth(5*time.Minute),
+					nil,
+					domain.EscalationPolicy{},
+					0,
+					false,
+					domain.Payload{},
 					time.Now().Add(-1*time.Hour),
 					time.Now(),
 				)
@@ -471,6 +486,11 @@ func TestFindByTimeRangeOrderSuccess(t *testing.T) {
 					domain.TypeNear,
 					false,
 					domain.MustSlideWindowWidth(5*time.Minute),
+					nil,
+					domain.EscalationPolicy{},
+					0,
+					false,
+					domain.Payload{},
 					time.Now().Add(-1*time.Hour),
 					time.Now(),
 				)
@@ -493,6 +513,55 @@ func TestFindByTimeRangeOrderSuccess(t *testing.T) {
 	}
 }
 
+func TestFindByTimeRangeAcknowledgedSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID := createValidUserID(t)
+	devices := createValidDevices(t, 1)
+	now := time.Now().Truncate(time.Microsecond)
+	acknowledgedAt := now
+
+	save := func(remindTime time.Time, ackAt *time.Time) domain.RemindID {
+		remind := domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			userID,
+			devices,
+			createValidTaskID(t),
+			domain.TypeNear,
+			false,
+			domain.MustSlideWindowWidth(5*time.Minute),
+			ackAt,
+			domain.EscalationPolicy{},
+			0,
+			false,
+			domain.Payload{},
+			now,
+			now,
+		)
+		require.NoError(t, repo.Save(ctx, remind))
+
+		return remind.ID()
+	}
+
+	pending := save(now.Add(time.Hour), nil)
+	save(now.Add(2*time.Hour), &acknowledgedAt)
+
+	found, err := repo.FindByTimeRange(ctx, domain.TimeRange{Start: now, End: now.Add(3 * time.Hour)})
+
+	require.NoError(t, err)
+	require.Len(t, found, 1, "acknowledged reminds are not delivered any more")
+	assert.Equal(t, pending, found[0].ID())
+}
+
 func TestUpdateSuccess(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode")
@@ -542,7 +611,7 @@ func TestUpdateSuccess(t *testing.T) {
 			err = remind.MarkAsThrottled()
 			require.NoError(t, err)
 
-			err = repo.Update(ctx, remind)
+			err = repo.Update(ctx, remind, domain.RemindFieldThrottled)
 
 			assert.NoError(t, err)
 
@@ -595,11 +664,16 @@ func TestUpdateNotFoundError(t *testing.T) {
 				domain.TypeNear,
 				false,
 				domain.MustSlideWindowWidth(5*time.Minute),
+				nil,
+				domain.EscalationPolicy{},
+				0,
+				false,
+				domain.Payload{},
 				time.Now().Add(-1*time.Hour),
 				time.Now(),
 			)
 
-			err = repo.Update(ctx, remind)
+			err = repo.Update(ctx, remind, domain.RemindFieldThrottled)
 
 			assert.ErrorIs(t, err, domain.ErrRemindNotFound)
 		})
@@ -974,6 +1048,11 @@ func TestDeleteByTaskIDSuccess(t *testing.T) {
 					domain.TypeNear,
 					false,
 					domain.MustSlideWindowWidth(5*time.Minute),
+					nil,
+					domain.EscalationPolicy{},
+					0,
+					false,
+					domain.Payload{},
 					time.Now().Add(-1*time.Hour),
 					time.Now(),
 				)
@@ -1026,3 +1105,688 @@ func TestDeleteByTaskIDNoRecords(t *testing.T) {
 		})
 	}
 }
+
+func TestDeleteByTaskIDAfterSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	base := time.Now().Add(1 * time.Hour).Truncate(time.Microsecond)
+
+	reminds := make([]*domain.Remind, 3)
+	for i := range reminds {
+		reminds[i] = domain.Reconstitute(
+			domain.NewRemindID(),
+			base.Add(time.Duration(i)*time.Hour),
+			userID,
+			devices,
+			taskID,
+			domain.TypeNear,
+			false,
+			domain.MustSlideWindowWidth(5*time.Minute),
+			nil,
+			domain.EscalationPolicy{},
+			0,
+			false,
+			domain.Payload{},
+			time.Now().Add(-1*time.Hour),
+			time.Now(),
+		)
+		require.NoError(t, repo.Save(ctx, reminds[i]))
+	}
+
+	deletedIDs, err := repo.DeleteByTaskIDAfter(ctx, taskID, reminds[0].Time(), reminds[2].ID())
+	require.NoError(t, err)
+	assert.Equal(t, []domain.RemindID{reminds[1].ID()}, deletedIDs)
+
+	found, err := repo.FindByTaskID(ctx, taskID)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, reminds[0].ID(), found[0].ID())
+	assert.Equal(t, reminds[2].ID(), found[1].ID())
+
+	deletedIDs, err = repo.DeleteByTaskIDAfter(ctx, taskID, reminds[0].Time(), reminds[2].ID())
+	require.NoError(t, err)
+	assert.Empty(t, deletedIDs)
+}
+
+func TestUpdateAcknowledgedSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		userID,
+		devices,
+		taskID,
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, remind))
+
+	// A copy read before the acknowledgment is throttled afterwards.
+	stale, err := repo.FindByID(ctx, remind.ID())
+	require.NoError(t, err)
+
+	at := time.Now().Truncate(time.Microsecond)
+	require.NoError(t, remind.Acknowledge(at))
+	require.NoError(t, repo.Update(ctx, remind, domain.RemindFieldAcknowledgedAt))
+
+	require.NoError(t, stale.MarkAsThrottled())
+	require.NoError(t, repo.Update(ctx, stale, domain.RemindFieldThrottled))
+
+	found, err := repo.FindByID(ctx, remind.ID())
+	require.NoError(t, err)
+	require.NotNil(t, found.AcknowledgedAt(), "the stale write keeps the acknowledgment")
+	assert.True(t, at.Equal(*found.AcknowledgedAt()))
+	assert.True(t, found.IsThrottled())
+}
+
+func TestReassignSlideWindowWidthSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	remind, err := domain.NewRemind(
+		time.Now().Add(1*time.Hour),
+		userID,
+		devices,
+		taskID,
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+	require.NoError(t, repo.Save(ctx, remind))
+
+	from := domain.MustSlideWindowWidth(5 * time.Minute)
+	to := domain.MustSlideWindowWidth(7 * time.Minute)
+
+	ok, err := repo.ReassignSlideWindowWidth(ctx, remind.ID(), from, to)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// The width is no longer the one read, so a second reassignment is skipped.
+	ok, err = repo.ReassignSlideWindowWidth(ctx, remind.ID(), from, domain.MustSlideWindowWidth(3*time.Minute))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	found, err := repo.FindByID(ctx, remind.ID())
+	require.NoError(t, err)
+	assert.Equal(t, to, found.SlideWindowWidth())
+}
+
+func TestFindEscalationDueSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	policy, err := domain.NewEscalationPolicy(10*time.Minute, []domain.EscalationAction{
+		domain.EscalationNarrowWindow,
+		domain.EscalationFollowUp,
+	})
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Microsecond)
+	acknowledgedAt := now.Add(-30 * time.Minute)
+
+	newRemind := func(remindTime time.Time, p domain.EscalationPolicy, level int, ack *time.Time) *domain.Remind {
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		remind := domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			userID,
+			devices,
+			taskID,
+			domain.TypeNear,
+			false,
+			domain.MustSlideWindowWidth(5*time.Minute),
+			ack,
+			p,
+			level,
+			false,
+			domain.Payload{},
+			remindTime.Add(-1*time.Hour),
+			remindTime.Add(-1*time.Hour),
+		)
+		require.NoError(t, repo.Save(ctx, remind))
+
+		return remind
+	}
+
+	due := newRemind(now.Add(-15*time.Minute), policy, 0, nil)
+	dueSecondStep := newRemind(now.Add(-25*time.Minute), policy, 1, nil)
+	newRemind(now.Add(-5*time.Minute), policy, 0, nil)                // first step not due yet
+	newRemind(now.Add(-15*time.Minute), policy, 1, nil)               // second step not due yet
+	newRemind(now.Add(-time.Hour), policy, 2, nil)                    // every step done
+	newRemind(now.Add(-time.Hour), policy, 0, &acknowledgedAt)        // acknowledged
+	newRemind(now.Add(-time.Hour), domain.EscalationPolicy{}, 0, nil) // no policy
+
+	found, err := repo.FindEscalationDue(ctx, now, 10)
+	require.NoError(t, err)
+
+	ids := make([]domain.RemindID, 0, len(found))
+	for _, r := range found {
+		ids = append(ids, r.ID())
+	}
+
+	assert.Equal(t, []domain.RemindID{dueSecondStep.ID(), due.ID()}, ids)
+}
+
+func TestClaimEscalationDueSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	policy, err := domain.NewEscalationPolicy(10*time.Minute, []domain.EscalationAction{domain.EscalationFollowUp})
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Microsecond)
+	remindTime := now.Add(-15 * time.Minute)
+
+	remind := domain.Reconstitute(
+		domain.NewRemindID(),
+		remindTime,
+		userID,
+		devices,
+		taskID,
+		domain.TypeNear,
+		false,
+		domain.MustSlideWindowWidth(5*time.Minute),
+		nil,
+		policy,
+		0,
+		false,
+		domain.Payload{},
+		remindTime.Add(-time.Hour),
+		remindTime.Add(-time.Hour),
+	)
+	require.NoError(t, repo.Save(ctx, remind))
+
+	err = repo.WithTx(ctx, func(txRepo domain.RemindRepository) error {
+		claimed, err := txRepo.ClaimEscalationDue(ctx, remind.ID(), now)
+		require.NoError(t, err)
+
+		// Another instance skips the remind while it is claimed.
+		otherErr := repo.WithTx(ctx, func(otherRepo domain.RemindRepository) error {
+			_, err := otherRepo.ClaimEscalationDue(ctx, remind.ID(), now)
+
+			return err
+		})
+		require.ErrorIs(t, otherErr, domain.ErrRemindNotFound)
+
+		_, err = claimed.Escalate(now, nil)
+		require.NoError(t, err)
+
+		return txRepo.Update(ctx, claimed, domain.RemindFieldEscalation)
+	})
+	require.NoError(t, err)
+
+	// Once escalated, no step is left to claim.
+	_, err = repo.ClaimEscalationDue(ctx, remind.ID(), now)
+	assert.ErrorIs(t, err, domain.ErrRemindNotFound)
+}
+
+func TestPauseAndResumeByUserIDSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	otherUserID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Microsecond)
+	acknowledgedAt := now.Add(-time.Minute)
+
+	newRemind := func(owner domain.UserID, remindTime time.Time, ack *time.Time) *domain.Remind {
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		remind := domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			owner,
+			devices,
+			taskID,
+			domain.TypeRelaxed,
+			false,
+			domain.MustSlideWindowWidth(5*time.Minute),
+			ack,
+			domain.EscalationPolicy{},
+			0,
+			false,
+			domain.Payload{},
+			now.Add(-1*time.Hour),
+			now.Add(-1*time.Hour),
+		)
+		require.NoError(t, repo.Save(ctx, remind))
+
+		return remind
+	}
+
+	newRemind(userID, now.Add(-time.Hour), nil) // already past
+	soon := newRemind(userID, now.Add(time.Hour), nil)
+	later := newRemind(userID, now.Add(3*time.Hour), nil)
+	newRemind(userID, now.Add(2*time.Hour), &acknowledgedAt) // acknowledged
+	other := newRemind(otherUserID, now.Add(time.Hour), nil)
+
+	pausedIDs, err := repo.PauseByUserIDAfter(ctx, userID, now)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []domain.RemindID{soon.ID(), later.ID()}, pausedIDs)
+
+	found, err := repo.FindByID(ctx, soon.ID())
+	require.NoError(t, err)
+	assert.True(t, found.IsPaused())
+
+	// Paused reminds are excluded from time range queries.
+	inRange, err := repo.FindByTimeRange(ctx, domain.TimeRange{Start: now, End: now.Add(4 * time.Hour)})
+	require.NoError(t, err)
+
+	rangeIDs := make([]domain.RemindID, 0, len(inRange))
+	for _, r := range inRange {
+		rangeIDs = append(rangeIDs, r.ID())
+	}
+
+	assert.NotContains(t, rangeIDs, soon.ID())
+	assert.NotContains(t, rangeIDs, later.ID())
+	assert.Contains(t, rangeIDs, other.ID())
+
+	skippedIDs, err := repo.DeletePausedByUserIDBefore(ctx, userID, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []domain.RemindID{soon.ID()}, skippedIDs)
+
+	resumedIDs, err := repo.ResumeByUserID(ctx, userID)
+	require.NoError(t, err)
+	assert.Equal(t, []domain.RemindID{later.ID()}, resumedIDs)
+
+	found, err = repo.FindByID(ctx, later.ID())
+	require.NoError(t, err)
+	assert.False(t, found.IsPaused())
+
+	resumedIDs, err = repo.ResumeByUserID(ctx, userID)
+	require.NoError(t, err)
+	assert.Empty(t, resumedIDs)
+}
+
+func TestCountByUserIDPerTimeSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+	otherUserID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	base := time.Now().Add(time.Hour).Truncate(time.Microsecond)
+
+	save := func(owner domain.UserID, remindTime time.Time, paused bool) {
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Save(ctx, domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			owner,
+			devices,
+			taskID,
+			domain.TypeShort,
+			false,
+			domain.MustSlideWindowWidth(time.Minute),
+			nil,
+			domain.EscalationPolicy{},
+			0,
+			paused,
+			domain.Payload{},
+			base,
+			base,
+		)))
+	}
+
+	save(userID, base, false)
+	save(userID, base.Add(30*time.Second), false)
+	save(userID, base.Add(30*time.Second), false)
+	save(userID, base.Add(time.Minute), false)   // on the range end
+	save(userID, base.Add(20*time.Second), true) // paused
+	save(otherUserID, base.Add(10*time.Second), false)
+
+	counts, err := repo.CountByUserIDPerTime(ctx, userID, domain.TimeRange{
+		Start: base,
+		End:   base.Add(time.Minute),
+	})
+	require.NoError(t, err)
+	require.Len(t, counts, 2)
+
+	for at, count := range counts {
+		switch {
+		case at.Equal(base):
+			assert.Equal(t, 1, count)
+		case at.Equal(base.Add(30 * time.Second)):
+			assert.Equal(t, 2, count)
+		default:
+			t.Errorf("unexpected time %s", at)
+		}
+	}
+}
+
+func TestCountByMinutesSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	bucket := time.Now().Add(time.Hour).Truncate(time.Minute)
+
+	save := func(remindTime time.Time, paused bool) {
+		userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		require.NoError(t, repo.Save(ctx, domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			userID,
+			devices,
+			taskID,
+			domain.TypeShort,
+			false,
+			domain.MustSlideWindowWidth(time.Minute),
+			nil,
+			domain.EscalationPolicy{},
+			0,
+			paused,
+			domain.Payload{},
+			bucket,
+			bucket,
+		)))
+	}
+
+	save(bucket, false)
+	save(bucket.Add(59*time.Second), false)
+	save(bucket.Add(30*time.Second), true) // paused
+	save(bucket.Add(time.Minute), false)   // between the counted minutes
+	save(bucket.Add(2*time.Minute), false)
+	save(bucket.Add(3*time.Minute), false) // after the counted minutes
+
+	counts, err := repo.CountByMinutes(ctx, []time.Time{bucket, bucket.Add(2 * time.Minute)})
+	require.NoError(t, err)
+
+	byUnix := make(map[int64]int, len(counts))
+	for b, n := range counts {
+		byUnix[b.Unix()] = n
+	}
+
+	assert.Equal(t, map[int64]int{
+		bucket.Unix():                      2,
+		bucket.Add(2 * time.Minute).Unix(): 1,
+	}, byUnix)
+}
+
+func TestFindTaskIDsWithRemindsAfterSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+	require.NoError(t, err)
+
+	now := time.Now()
+
+	newTaskID := func() domain.TaskID {
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		return taskID
+	}
+
+	save := func(taskID domain.TaskID, remindTime time.Time) {
+		require.NoError(t, repo.Save(ctx, domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			userID,
+			devices,
+			taskID,
+			domain.TypeNear,
+			false,
+			domain.MustSlideWindowWidth(time.Minute),
+			nil,
+			domain.EscalationPolicy{},
+			0,
+			false,
+			domain.Payload{},
+			now,
+			now,
+		)))
+	}
+
+	// UUIDv7 task IDs created in sequence sort in creation order.
+	past := newTaskID()
+	first := newTaskID()
+	second := newTaskID()
+	third := newTaskID()
+
+	save(past, now.Add(-time.Hour))
+	save(first, now.Add(-time.Hour))
+	save(first, now.Add(time.Hour))
+	save(first, now.Add(2*time.Hour))
+	save(second, now.Add(time.Hour))
+	save(third, now.Add(time.Hour))
+
+	taskIDs, err := repo.FindTaskIDsWithRemindsAfter(ctx, now, domain.TaskID{}, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{first.String(), second.String()}, taskIDStrings(taskIDs))
+
+	taskIDs, err = repo.FindTaskIDsWithRemindsAfter(ctx, now, second, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []string{third.String()}, taskIDStrings(taskIDs))
+}
+
+func taskIDStrings(taskIDs []domain.TaskID) []string {
+	ids := make([]string, 0, len(taskIDs))
+	for _, id := range taskIDs {
+		ids = append(ids, id.String())
+	}
+
+	return ids
+}
+
+func TestFindDueAtSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindRepository(testDB.DB)
+	ctx := context.Background()
+
+	d, err := domain.NewDevice("device", "token")
+	require.NoError(t, err)
+	devices, err := domain.NewDevices([]domain.Device{d})
+	require.NoError(t, err)
+
+	at := time.Now().Add(time.Hour).Truncate(time.Second)
+	acknowledgedAt := at.Add(-time.Hour)
+
+	save := func(remindTime time.Time, width time.Duration, throttled, paused bool, ackAt *time.Time) domain.RemindID {
+		userID, err := domain.UserIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+		taskID, err := domain.TaskIDFromUUID(uuid.Must(uuid.NewV7()))
+		require.NoError(t, err)
+
+		id := domain.NewRemindID()
+		require.NoError(t, repo.Save(ctx, domain.Reconstitute(
+			id,
+			remindTime,
+			userID,
+			devices,
+			taskID,
+			domain.TypeNear,
+			throttled,
+			domain.MustSlideWindowWidth(width),
+			ackAt,
+			domain.EscalationPolicy{},
+			0,
+			paused,
+			domain.Payload{},
+			at,
+			at,
+		)))
+
+		return id
+	}
+
+	onTime := save(at, 2*time.Minute, false, false, nil)
+	windowEnd := save(at.Add(-5*time.Minute), 5*time.Minute, false, false, nil)
+	widest := save(at.Add(30*time.Minute), 30*time.Minute, false, false, nil)
+	throttled := save(at.Add(time.Minute), 2*time.Minute, true, false, nil)
+	save(at.Add(3*time.Minute), 2*time.Minute, false, false, nil)    // window starts after at
+	save(at, 2*time.Minute, false, true, nil)                        // paused
+	save(at, 2*time.Minute, false, false, &acknowledgedAt)           // acknowledged
+	save(at.Add(-31*time.Minute), 30*time.Minute, false, false, nil) // window ends before at
+
+	ids := func(reminds []*domain.Remind) []string {
+		result := make([]string, 0, len(reminds))
+		for _, r := range reminds {
+			result = append(result, r.ID().String())
+		}
+
+		return result
+	}
+
+	reminds, err := repo.FindDueAt(ctx, at, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{windowEnd.String(), onTime.String(), throttled.String(), widest.String()}, ids(reminds))
+
+	reminds, err = repo.FindDueAt(ctx, at, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{windowEnd.String(), onTime.String(), widest.String()}, ids(reminds))
+}
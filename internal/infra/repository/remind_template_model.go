@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+// TemplateOffsetsJSONB maps task types to offsets in seconds before the target time.
+type TemplateOffsetsJSONB map[string][]int64
+
+func (o *TemplateOffsetsJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*o = nil
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan TemplateOffsetsJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, o)
+}
+
+func (o TemplateOffsetsJSONB) Value() (driver.Value, error) {
+	if o == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(o)
+}
+
+type RemindTemplateModel struct {
+	Name      string               `gorm:"column:name;type:varchar(64);primaryKey"`
+	Offsets   TemplateOffsetsJSONB `gorm:"column:offsets;type:jsonb;not null"`
+	CreatedAt time.Time            `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt time.Time            `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (RemindTemplateModel) TableName() string {
+	return "remind_templates"
+}
+
+func (m *RemindTemplateModel) ToEntity() (*domain.RemindTemplate, error) {
+	name, err := domain.NewTemplateName(m.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	offsets := make(domain.TemplateOffsets, len(m.Offsets))
+	for t, seconds := range m.Offsets {
+		taskType, err := domain.NewType(t)
+		if err != nil {
+			return nil, err
+		}
+
+		durations := make([]time.Duration, 0, len(seconds))
+		for _, s := range seconds {
+			durations = append(durations, time.Duration(s)*time.Second)
+		}
+
+		offsets[taskType] = durations
+	}
+
+	return domain.ReconstituteRemindTemplate(name, offsets, m.CreatedAt, m.UpdatedAt), nil
+}
+
+func FromRemindTemplateEntity(e *domain.RemindTemplate) *RemindTemplateModel {
+	offsets := make(TemplateOffsetsJSONB, len(e.Offsets()))
+	for t, durations := range e.Offsets() {
+		seconds := make([]int64, 0, len(durations))
+		for _, d := range durations {
+			seconds = append(seconds, int64(d/time.Second))
+		}
+
+		offsets[string(t)] = seconds
+	}
+
+	return &RemindTemplateModel{
+		Name:      e.Name().String(),
+		Offsets:   offsets,
+		CreatedAt: e.CreatedAt(),
+		UpdatedAt: e.UpdatedAt(),
+	}
+}
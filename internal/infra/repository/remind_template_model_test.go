@@ -0,0 +1,78 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+)
+
+func createValidRemindTemplate(t *testing.T) *domain.RemindTemplate {
+	t.Helper()
+
+	name, err := domain.NewTemplateName("standard")
+	require.NoError(t, err)
+
+	return domain.ReconstituteRemindTemplate(
+		name,
+		domain.TemplateOffsets{
+			domain.TypeNear:      {time.Hour, 10 * time.Minute},
+			domain.TypeScheduled: {24 * time.Hour},
+		},
+		time.Now().Add(-1*time.Hour),
+		time.Now(),
+	)
+}
+
+func TestRemindTemplateRoundTripConversionSuccess(t *testing.T) {
+	original := createValidRemindTemplate(t)
+
+	model := repository.FromRemindTemplateEntity(original)
+	restored, err := model.ToEntity()
+
+	require.NoError(t, err)
+	assert.Equal(t, repository.TemplateOffsetsJSONB{"near": {3600, 600}, "scheduled": {86400}}, model.Offsets)
+	assert.Equal(t, original.Name(), restored.Name())
+	assert.Equal(t, original.Offsets(), restored.Offsets())
+	assert.Equal(t, original.CreatedAt(), restored.CreatedAt())
+	assert.Equal(t, original.UpdatedAt(), restored.UpdatedAt())
+}
+
+func TestRemindTemplateToEntityError(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *repository.RemindTemplateModel)
+	}{
+		{
+			name: "invalid name",
+			modify: func(m *repository.RemindTemplateModel) {
+				m.Name = "Not A Name"
+			},
+		},
+		{
+			name: "unknown task type",
+			modify: func(m *repository.RemindTemplateModel) {
+				m.Offsets = repository.TemplateOffsetsJSONB{"urgent": {60}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := repository.FromRemindTemplateEntity(createValidRemindTemplate(t))
+			tt.modify(model)
+
+			_, err := model.ToEntity()
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestRemindTemplateTableNameSuccess(t *testing.T) {
+	assert.Equal(t, "remind_templates", repository.RemindTemplateModel{}.TableName())
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type remindTemplateRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewRemindTemplateRepository(db *gorm.DB) domain.RemindTemplateRepository {
+	return &remindTemplateRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *remindTemplateRepositoryImpl) FindByName(
+	ctx context.Context,
+	name domain.TemplateName,
+) (*domain.RemindTemplate, error) {
+	slog.Debug("finding remind template",
+		"name", name.String(),
+	)
+
+	var m RemindTemplateModel
+
+	result := r.db.WithContext(ctx).Where("name = ?", name.String()).First(&m)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrRemindTemplateNotFound
+		}
+
+		slog.Error("failed to find remind template",
+			"name", name.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	return m.ToEntity()
+}
+
+func (r *remindTemplateRepositoryImpl) FindAll(ctx context.Context) ([]*domain.RemindTemplate, error) {
+	slog.Debug("finding all remind templates")
+
+	var models []RemindTemplateModel
+
+	result := r.db.WithContext(ctx).Order("name ASC").Find(&models)
+	if result.Error != nil {
+		slog.Error("failed to find remind templates",
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	templates := make([]*domain.RemindTemplate, 0, len(models))
+	for _, m := range models {
+		template, err := m.ToEntity()
+		if err != nil {
+			slog.Error("failed to convert model to entity",
+				"name", m.Name,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// Save inserts the template or replaces the stored one, keeping the original creation time.
+func (r *remindTemplateRepositoryImpl) Save(ctx context.Context, template *domain.RemindTemplate) error {
+	slog.Debug("saving remind template",
+		"name", template.Name().String(),
+	)
+
+	m := FromRemindTemplateEntity(template)
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"offsets", "updated_at"}),
+	}).Create(m)
+	if result.Error != nil {
+		slog.Error("failed to save remind template",
+			"name", template.Name().String(),
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *remindTemplateRepositoryImpl) Delete(ctx context.Context, name domain.TemplateName) error {
+	slog.Debug("deleting remind template",
+		"name", name.String(),
+	)
+
+	result := r.db.WithContext(ctx).Where("name = ?", name.String()).Delete(&RemindTemplateModel{})
+	if result.Error != nil {
+		slog.Error("failed to delete remind template",
+			"name", name.String(),
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrRemindTemplateNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func TestRemindTemplateSaveAndFindSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindTemplateRepository(testDB.DB)
+	ctx := context.Background()
+
+	template := createValidRemindTemplate(t)
+	require.NoError(t, repo.Save(ctx, template))
+
+	found, err := repo.FindByName(ctx, template.Name())
+	require.NoError(t, err)
+	assert.Equal(t, template.Offsets(), found.Offsets())
+
+	// Saving again replaces the stored offsets.
+	require.NoError(t, template.Replace(domain.TemplateOffsets{domain.TypeShort: {5 * time.Minute}}))
+	require.NoError(t, repo.Save(ctx, template))
+
+	found, err = repo.FindByName(ctx, template.Name())
+	require.NoError(t, err)
+	assert.Equal(t, domain.TemplateOffsets{domain.TypeShort: {5 * time.Minute}}, found.Offsets())
+	assert.WithinDuration(t, template.CreatedAt(), found.CreatedAt(), time.Millisecond)
+}
+
+func TestRemindTemplateFindAllSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindTemplateRepository(testDB.DB)
+	ctx := context.Background()
+
+	for _, n := range []string{"weekly", "daily"} {
+		name, err := domain.NewTemplateName(n)
+		require.NoError(t, err)
+
+		template, err := domain.NewRemindTemplate(name, domain.TemplateOffsets{domain.TypeNear: {time.Hour}})
+		require.NoError(t, err)
+		require.NoError(t, repo.Save(ctx, template))
+	}
+
+	templates, err := repo.FindAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+	assert.Equal(t, "daily", templates[0].Name().String())
+	assert.Equal(t, "weekly", templates[1].Name().String())
+}
+
+func TestRemindTemplateDeleteSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewRemindTemplateRepository(testDB.DB)
+	ctx := context.Background()
+
+	template := createValidRemindTemplate(t)
+	require.NoError(t, repo.Save(ctx, template))
+	require.NoError(t, repo.Delete(ctx, template.Name()))
+
+	_, err := repo.FindByName(ctx, template.Name())
+	assert.ErrorIs(t, err, domain.ErrRemindTemplateNotFound)
+
+	err = repo.Delete(ctx, template.Name())
+	assert.ErrorIs(t, err, domain.ErrRemindTemplateNotFound)
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type transactorImpl struct {
+	db *gorm.DB
+}
+
+func NewTransactor(db *gorm.DB) domain.Transactor {
+	return &transactorImpl{
+		db: db,
+	}
+}
+
+// WithTx runs fn with repositories sharing one transaction, committing when fn
+// returns nil and rolling back otherwise.
+func (t *transactorImpl) WithTx(ctx context.Context, fn func(repos domain.TxRepositories) error) error {
+	return t.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(domain.TxRepositories{
+			Reminds:     &remindRepositoryImpl{db: tx},
+			Preferences: &userPreferencesRepositoryImpl{db: tx},
+		})
+	})
+}
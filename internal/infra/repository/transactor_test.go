@@ -0,0 +1,98 @@
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func TestTransactorWithTxCommitSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	transactor := repository.NewTransactor(testDB.DB)
+	ctx := context.Background()
+
+	remind, err := domain.NewRemind(
+		time.Now().Add(time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+
+	prefs := domain.NewUserPreferences(remind.UserID(), nil, domain.UTCTimezone(), nil, nil)
+
+	err = transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		if err := repos.Reminds.Save(ctx, remind); err != nil {
+			return err
+		}
+
+		return repos.Preferences.Save(ctx, prefs)
+	})
+	require.NoError(t, err)
+
+	_, err = repository.NewRemindRepository(testDB.DB).FindByID(ctx, remind.ID())
+	require.NoError(t, err)
+
+	_, err = repository.NewUserPreferencesRepository(testDB.DB).FindByUserID(ctx, prefs.UserID())
+	assert.NoError(t, err)
+}
+
+func TestTransactorWithTxRollbackOnError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	transactor := repository.NewTransactor(testDB.DB)
+	ctx := context.Background()
+
+	remind, err := domain.NewRemind(
+		time.Now().Add(time.Hour),
+		createValidUserID(t),
+		createValidDevices(t, 1),
+		createValidTaskID(t),
+		domain.TypeNear,
+		domain.MustSlideWindowWidth(5*time.Minute),
+	)
+	require.NoError(t, err)
+
+	prefs := domain.NewUserPreferences(remind.UserID(), nil, domain.UTCTimezone(), nil, nil)
+	errFailed := errors.New("failed after saving")
+
+	err = transactor.WithTx(ctx, func(repos domain.TxRepositories) error {
+		if err := repos.Reminds.Save(ctx, remind); err != nil {
+			return err
+		}
+
+		if err := repos.Preferences.Save(ctx, prefs); err != nil {
+			return err
+		}
+
+		return errFailed
+	})
+	require.ErrorIs(t, err, errFailed)
+
+	_, err = repository.NewRemindRepository(testDB.DB).FindByID(ctx, remind.ID())
+	require.ErrorIs(t, err, domain.ErrRemindNotFound)
+
+	_, err = repository.NewUserPreferencesRepository(testDB.DB).FindByUserID(ctx, prefs.UserID())
+	assert.ErrorIs(t, err, domain.ErrUserPreferencesNotFound)
+}
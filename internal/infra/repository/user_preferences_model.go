@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type QuietWindowJSON struct {
+	Weekday      int `json:"weekday"`
+	StartMinutes int `json:"start_minutes"`
+	EndMinutes   int `json:"end_minutes"`
+}
+
+type QuietHoursJSONB []QuietWindowJSON
+
+func (q *QuietHoursJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*q = nil
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan QuietHoursJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, q)
+}
+
+func (q QuietHoursJSONB) Value() (driver.Value, error) {
+	if q == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(q)
+}
+
+type WindowOverrideJSON struct {
+	TargetWidthSeconds          int32 `json:"target_width_seconds,omitempty"`
+	IntermediateMaxWidthSeconds int32 `json:"intermediate_max_width_seconds,omitempty"`
+}
+
+// WindowOverridesJSONB is keyed by task type.
+type WindowOverridesJSONB map[string]WindowOverrideJSON
+
+func (o *WindowOverridesJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*o = nil
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan WindowOverridesJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, o)
+}
+
+func (o WindowOverridesJSONB) Value() (driver.Value, error) {
+	if o == nil {
+		return []byte("{}"), nil
+	}
+
+	return json.Marshal(o)
+}
+
+type UserPreferencesModel struct {
+	UserID          string               `gorm:"column:user_id;type:uuid;primaryKey"`
+	DefaultDevices  DevicesJSONB         `gorm:"column:default_devices;type:jsonb;not null;default:'[]'"`
+	Timezone        string               `gorm:"column:timezone;type:varchar(64);not null"`
+	QuietHours      QuietHoursJSONB      `gorm:"column:quiet_hours;type:jsonb;not null"`
+	WindowOverrides WindowOverridesJSONB `gorm:"column:window_overrides;type:jsonb;not null;default:'{}'"`
+	Paused          bool                 `gorm:"column:paused;type:boolean;not null;default:false"`
+	ResumeAt        *time.Time           `gorm:"column:resume_at;type:timestamptz"`
+	CreatedAt       time.Time            `gorm:"column:created_at;type:timestamptz;not null"`
+	UpdatedAt       time.Time            `gorm:"column:updated_at;type:timestamptz;not null"`
+}
+
+func (UserPreferencesModel) TableName() string {
+	return "user_preferences"
+}
+
+func (m *UserPreferencesModel) ToEntity() (*domain.UserPreferences, error) {
+	userID, err := domain.UserIDFromString(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := make(domain.Devices, 0, len(m.DefaultDevices))
+	for _, d := range m.DefaultDevices {
+		device, err := domain.NewDevice(d.DeviceID, d.FCMToken)
+		if err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, device)
+	}
+
+	timezone, err := domain.NewTimezone(m.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	windows := make([]domain.QuietWindow, 0, len(m.QuietHours))
+	for _, w := range m.QuietHours {
+		window, err := domain.NewQuietWindow(
+			time.Weekday(w.Weekday),
+			time.Duration(w.StartMinutes)*time.Minute,
+			time.Duration(w.EndMinutes)*time.Minute,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		windows = append(windows, window)
+	}
+
+	quietHours, err := domain.NewQuietHours(windows)
+	if err != nil {
+		return nil, err
+	}
+
+	overrides := make(domain.WindowOverrides, len(m.WindowOverrides))
+	for t, o := range m.WindowOverrides {
+		taskType, err := domain.NewType(t)
+		if err != nil {
+			return nil, err
+		}
+
+		override, err := domain.NewWindowOverride(
+			time.Duration(o.TargetWidthSeconds)*time.Second,
+			time.Duration(o.IntermediateMaxWidthSeconds)*time.Second,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		overrides[taskType] = override
+	}
+
+	return domain.ReconstituteUserPreferences(
+		userID,
+		devices,
+		timezone,
+		quietHours,
+		overrides,
+		m.Paused,
+		m.ResumeAt,
+		m.CreatedAt,
+		m.UpdatedAt,
+	), nil
+}
+
+func FromUserPreferencesEntity(e *domain.UserPreferences) *UserPreferencesModel {
+	quietHours := make(QuietHoursJSONB, 0, len(e.QuietHours().ToSlice()))
+	for _, w := range e.QuietHours().ToSlice() {
+		quietHours = append(quietHours, QuietWindowJSON{
+			Weekday:      int(w.Weekday()),
+			StartMinutes: int(w.Start() / time.Minute),
+			EndMinutes:   int(w.End() / time.Minute),
+		})
+	}
+
+	devices := make(DevicesJSONB, 0, e.DefaultDevices().Count())
+	for _, d := range e.DefaultDevices().ToSlice() {
+		devices = append(devices, DeviceJSON{
+			DeviceID: d.DeviceID(),
+			FCMToken: d.FCMToken(),
+		})
+	}
+
+	overrides := make(WindowOverridesJSONB, len(e.WindowOverrides()))
+	for t, o := range e.WindowOverrides() {
+		overrides[string(t)] = WindowOverrideJSON{
+			TargetWidthSeconds:          int32(o.TargetWidth() / time.Second),          // #nosec G115
+			IntermediateMaxWidthSeconds: int32(o.IntermediateMaxWidth() / time.Second), // #nosec G115
+		}
+	}
+
+	return &UserPreferencesModel{
+		UserID:          e.UserID().String(),
+		DefaultDevices:  devices,
+		Timezone:        e.Timezone().String(),
+		QuietHours:      quietHours,
+		WindowOverrides: overrides,
+		Paused:          e.IsPaused(),
+		ResumeAt:        e.ResumeAt(),
+		CreatedAt:       e.CreatedAt(),
+		UpdatedAt:       e.UpdatedAt(),
+	}
+}
@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+)
+
+func createValidUserPreferences(t *testing.T) *domain.UserPreferences {
+	t.Helper()
+
+	timezone, err := domain.NewTimezone("Asia/Tokyo")
+	require.NoError(t, err)
+
+	weeknight, err := domain.NewQuietWindow(time.Monday, 22*time.Hour, 7*time.Hour)
+	require.NoError(t, err)
+
+	lunch, err := domain.NewQuietWindow(time.Wednesday, 12*time.Hour, 13*time.Hour)
+	require.NoError(t, err)
+
+	quietHours, err := domain.NewQuietHours([]domain.QuietWindow{weeknight, lunch})
+	require.NoError(t, err)
+
+	override, err := domain.NewWindowOverride(3*time.Minute, 8*time.Minute)
+	require.NoError(t, err)
+
+	resumeAt := time.Now().Add(48 * time.Hour)
+
+	return domain.ReconstituteUserPreferences(
+		createValidUserID(t),
+		createValidDevices(t, 2),
+		timezone,
+		quietHours,
+		domain.WindowOverrides{domain.TypeRelaxed: override},
+		true,
+		&resumeAt,
+		time.Now().Add(-1*time.Hour),
+		time.Now(),
+	)
+}
+
+func TestUserPreferencesRoundTripConversionSuccess(t *testing.T) {
+	original := createValidUserPreferences(t)
+
+	model := repository.FromUserPreferencesEntity(original)
+	restored, err := model.ToEntity()
+
+	require.NoError(t, err)
+	assert.Equal(t, original.UserID().String(), restored.UserID().String())
+	assert.Equal(t, "Asia/Tokyo", restored.Timezone().String())
+	assert.Equal(t, original.QuietHours(), restored.QuietHours())
+	assert.Equal(t, original.DefaultDevices(), restored.DefaultDevices())
+	assert.Equal(t, original.WindowOverrides(), restored.WindowOverrides())
+	assert.Equal(t, original.IsPaused(), restored.IsPaused())
+	assert.Equal(t, original.ResumeAt(), restored.ResumeAt())
+	assert.Equal(t, original.CreatedAt(), restored.CreatedAt())
+	assert.Equal(t, original.UpdatedAt(), restored.UpdatedAt())
+}
+
+func TestUserPreferencesToEntityError(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *repository.UserPreferencesModel)
+	}{
+		{
+			name: "invalid user ID",
+			modify: func(m *repository.UserPreferencesModel) {
+				m.UserID = "invalid-uuid"
+			},
+		},
+		{
+			name: "unknown timezone",
+			modify: func(m *repository.UserPreferencesModel) {
+				m.Timezone = "Mars/Olympus_Mons"
+			},
+		},
+		{
+			name: "unknown task type override",
+			modify: func(m *repository.UserPreferencesModel) {
+				m.WindowOverrides = repository.WindowOverridesJSONB{"urgent": {TargetWidthSeconds: 120}}
+			},
+		},
+		{
+			name: "invalid default device",
+			modify: func(m *repository.UserPreferencesModel) {
+				m.DefaultDevices = repository.DevicesJSONB{{DeviceID: "", FCMToken: "t"}}
+			},
+		},
+		{
+			name: "invalid quiet window",
+			modify: func(m *repository.UserPreferencesModel) {
+				m.QuietHours = repository.QuietHoursJSONB{{Weekday: 1, StartMinutes: 60, EndMinutes: 60}}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := repository.FromUserPreferencesEntity(createValidUserPreferences(t))
+			tt.modify(model)
+
+			_, err := model.ToEntity()
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestQuietHoursJSONBScanSuccess(t *testing.T) {
+	var q repository.QuietHoursJSONB
+
+	require.NoError(t, q.Scan([]byte(`[{"weekday":1,"start_minutes":1320,"end_minutes":420}]`)))
+	assert.Equal(t, repository.QuietHoursJSONB{{Weekday: 1, StartMinutes: 1320, EndMinutes: 420}}, q)
+
+	require.NoError(t, q.Scan(nil))
+	assert.Nil(t, q)
+}
+
+func TestUserPreferencesTableNameSuccess(t *testing.T) {
+	assert.Equal(t, "user_preferences", repository.UserPreferencesModel{}.TableName())
+}
@@ -0,0 +1,154 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type userPreferencesRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewUserPreferencesRepository(db *gorm.DB) domain.UserPreferencesRepository {
+	return &userPreferencesRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *userPreferencesRepositoryImpl) FindByUserID(ctx context.Context, userID domain.UserID) (*domain.UserPreferences, error) {
+	return r.findByUserID(ctx, userID, r.db)
+}
+
+func (r *userPreferencesRepositoryImpl) FindByUserIDForUpdate(
+	ctx context.Context,
+	userID domain.UserID,
+) (*domain.UserPreferences, error) {
+	return r.findByUserID(ctx, userID, r.db.Clauses(clause.Locking{Strength: "UPDATE"}))
+}
+
+func (r *userPreferencesRepositoryImpl) findByUserID(
+	ctx context.Context,
+	userID domain.UserID,
+	db *gorm.DB,
+) (*domain.UserPreferences, error) {
+	slog.Debug("finding user preferences",
+		"user_id", userID.String(),
+	)
+
+	var m UserPreferencesModel
+
+	result := db.WithContext(ctx).Where("user_id = ?", userID.String()).First(&m)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrUserPreferencesNotFound
+		}
+
+		slog.Error("failed to find user preferences",
+			"user_id", userID.String(),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	return m.ToEntity()
+}
+
+// Save inserts the preferences or replaces the stored ones, keeping the original creation time.
+func (r *userPreferencesRepositoryImpl) Save(ctx context.Context, prefs *domain.UserPreferences) error {
+	slog.Debug("saving user preferences",
+		"user_id", prefs.UserID().String(),
+	)
+
+	m := FromUserPreferencesEntity(prefs)
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "user_id"}},
+		DoUpdates: clause.AssignmentColumns([]string{
+			"default_devices", "timezone", "quiet_hours", "window_overrides", "paused", "resume_at", "updated_at",
+		}),
+	}).Create(m)
+	if result.Error != nil {
+		slog.Error("failed to save user preferences",
+			"user_id", prefs.UserID().String(),
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	return nil
+}
+
+func (r *userPreferencesRepositoryImpl) Delete(ctx context.Context, userID domain.UserID) error {
+	slog.Debug("deleting user preferences",
+		"user_id", userID.String(),
+	)
+
+	result := r.db.WithContext(ctx).Where("user_id = ?", userID.String()).Delete(&UserPreferencesModel{})
+	if result.Error != nil {
+		slog.Error("failed to delete user preferences",
+			"user_id", userID.String(),
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrUserPreferencesNotFound
+	}
+
+	return nil
+}
+
+func (r *userPreferencesRepositoryImpl) FindResumeDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]*domain.UserPreferences, error) {
+	slog.Debug("finding user preferences due for resume",
+		"now", now,
+		"limit", limit,
+	)
+
+	var models []UserPreferencesModel
+
+	result := r.db.WithContext(ctx).
+		Where("paused = ? AND resume_at <= ?", true, now).
+		Order("resume_at ASC").
+		Limit(limit).
+		Find(&models)
+	if result.Error != nil {
+		slog.Error("failed to find user preferences due for resume",
+			"now", now,
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	prefs := make([]*domain.UserPreferences, 0, len(models))
+	for _, m := range models {
+		p, err := m.ToEntity()
+		if err != nil {
+			slog.Error("failed to convert model to entity",
+				"user_id", m.UserID,
+				"error", err,
+			)
+
+			return nil, err
+		}
+
+		prefs = append(prefs, p)
+	}
+
+	return prefs, nil
+}
@@ -0,0 +1,122 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func TestUserPreferencesSaveAndFindSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewUserPreferencesRepository(testDB.DB)
+	ctx := context.Background()
+
+	prefs := createValidUserPreferences(t)
+	require.NoError(t, repo.Save(ctx, prefs))
+
+	found, err := repo.FindByUserID(ctx, prefs.UserID())
+	require.NoError(t, err)
+	assert.Equal(t, prefs.Timezone().String(), found.Timezone().String())
+	assert.Equal(t, prefs.QuietHours(), found.QuietHours())
+
+	// Saving again replaces the stored preferences.
+	prefs.Replace(nil, domain.UTCTimezone(), nil, nil)
+	require.NoError(t, repo.Save(ctx, prefs))
+
+	found, err = repo.FindByUserID(ctx, prefs.UserID())
+	require.NoError(t, err)
+	assert.Equal(t, "UTC", found.Timezone().String())
+	assert.True(t, found.QuietHours().IsEmpty())
+	assert.False(t, found.HasDefaultDevices())
+	assert.True(t, found.IsPaused(), "replacing settings keeps the pause")
+	require.NotNil(t, found.ResumeAt())
+	assert.WithinDuration(t, *prefs.ResumeAt(), *found.ResumeAt(), time.Millisecond)
+	assert.WithinDuration(t, prefs.CreatedAt(), found.CreatedAt(), time.Millisecond)
+}
+
+func TestUserPreferencesDeleteSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewUserPreferencesRepository(testDB.DB)
+	ctx := context.Background()
+
+	prefs := createValidUserPreferences(t)
+	require.NoError(t, repo.Save(ctx, prefs))
+	require.NoError(t, repo.Delete(ctx, prefs.UserID()))
+
+	_, err := repo.FindByUserID(ctx, prefs.UserID())
+	assert.ErrorIs(t, err, domain.ErrUserPreferencesNotFound)
+
+	err = repo.Delete(ctx, prefs.UserID())
+	assert.ErrorIs(t, err, domain.ErrUserPreferencesNotFound)
+}
+
+func TestUserPreferencesFindResumeDueSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewUserPreferencesRepository(testDB.DB)
+	ctx := context.Background()
+	now := time.Now()
+
+	newPrefs := func(resumeAt *time.Time) *domain.UserPreferences {
+		prefs := domain.NewUserPreferences(createValidUserID(t), nil, domain.UTCTimezone(), nil, nil)
+		require.NoError(t, prefs.Pause(now.Add(-2*time.Hour), resumeAt))
+		require.NoError(t, repo.Save(ctx, prefs))
+
+		return prefs
+	}
+
+	past := now.Add(-time.Hour)
+	future := now.Add(time.Hour)
+
+	due := newPrefs(&past)
+	newPrefs(&future) // not due yet
+	newPrefs(nil)     // paused without automatic resume
+
+	resumed := newPrefs(&past)
+	resumed.Resume()
+	require.NoError(t, repo.Save(ctx, resumed))
+
+	found, err := repo.FindResumeDue(ctx, now, 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, due.UserID(), found[0].UserID())
+}
+
+func TestUserPreferencesFindByUserIDError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewUserPreferencesRepository(testDB.DB)
+
+	_, err := repo.FindByUserID(context.Background(), createValidUserID(t))
+
+	assert.ErrorIs(t, err, domain.ErrUserPreferencesNotFound)
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+// widthBackfillRunID is the key of the single stored run.
+const widthBackfillRunID = 1
+
+type WidthChangeJSON struct {
+	RemindID string    `json:"remind_id"`
+	TaskID   string    `json:"task_id"`
+	Time     time.Time `json:"time"`
+	OldWidth int32     `json:"old_width"`
+	NewWidth int32     `json:"new_width"`
+}
+
+type WidthChangesJSONB []WidthChangeJSON
+
+func (c *WidthChangesJSONB) Scan(value interface{}) error {
+	if value == nil {
+		*c = nil
+
+		return nil
+	}
+
+	bytes, ok := value.([]byte)
+	if !ok {
+		return errors.New("failed to scan WidthChangesJSONB: expected []byte")
+	}
+
+	return json.Unmarshal(bytes, c)
+}
+
+func (c WidthChangesJSONB) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+
+	return json.Marshal(c)
+}
+
+type WidthBackfillRunModel struct {
+	ID         int16             `gorm:"column:id;type:smallint;primaryKey"`
+	Running    bool              `gorm:"column:running;type:boolean;not null"`
+	DryRun     bool              `gorm:"column:dry_run;type:boolean;not null"`
+	StartedAt  time.Time         `gorm:"column:started_at;type:timestamptz;not null"`
+	FinishedAt *time.Time        `gorm:"column:finished_at;type:timestamptz"`
+	LastTaskID string            `gorm:"column:last_task_id;type:varchar(36);not null"`
+	Tasks      int32             `gorm:"column:tasks;type:integer;not null"`
+	Reminds    int32             `gorm:"column:reminds;type:integer;not null"`
+	Changed    int32             `gorm:"column:changed;type:integer;not null"`
+	Overlap    int32             `gorm:"column:overlap;type:integer;not null"`
+	Skipped    int32             `gorm:"column:skipped;type:integer;not null"`
+	Failed     int32             `gorm:"column:failed;type:integer;not null"`
+	Changes    WidthChangesJSONB `gorm:"column:changes;type:jsonb;not null;default:'[]'"`
+	Truncated  bool              `gorm:"column:truncated;type:boolean;not null"`
+	Error      string            `gorm:"column:error;type:text;not null"`
+	Owner      string            `gorm:"column:owner;type:varchar(64);not null"`
+	LeaseUntil time.Time         `gorm:"column:lease_until;type:timestamptz;not null"`
+}
+
+func (WidthBackfillRunModel) TableName() string {
+	return "width_backfill_runs"
+}
+
+func (m *WidthBackfillRunModel) ToEntity() *domain.WidthBackfillRun {
+	var changes []domain.WidthChange
+	if len(m.Changes) > 0 {
+		changes = make([]domain.WidthChange, 0, len(m.Changes))
+		for _, c := range m.Changes {
+			changes = append(changes, domain.WidthChange(c))
+		}
+	}
+
+	var finishedAt time.Time
+	if m.FinishedAt != nil {
+		finishedAt = *m.FinishedAt
+	}
+
+	return &domain.WidthBackfillRun{
+		Running:    m.Running,
+		DryRun:     m.DryRun,
+		StartedAt:  m.StartedAt,
+		FinishedAt: finishedAt,
+		LastTaskID: m.LastTaskID,
+		Tasks:      int(m.Tasks),
+		Reminds:    int(m.Reminds),
+		Changed:    int(m.Changed),
+		Overlap:    int(m.Overlap),
+		Skipped:    int(m.Skipped),
+		Failed:     int(m.Failed),
+		Changes:    changes,
+		Truncated:  m.Truncated,
+		Error:      m.Error,
+		Owner:      m.Owner,
+		LeaseUntil: m.LeaseUntil,
+	}
+}
+
+func FromWidthBackfillRunEntity(e *domain.WidthBackfillRun) *WidthBackfillRunModel {
+	changes := make(WidthChangesJSONB, 0, len(e.Changes))
+	for _, c := range e.Changes {
+		changes = append(changes, WidthChangeJSON(c))
+	}
+
+	var finishedAt *time.Time
+	if !e.FinishedAt.IsZero() {
+		finishedAt = &e.FinishedAt
+	}
+
+	return &WidthBackfillRunModel{
+		ID:         widthBackfillRunID,
+		Running:    e.Running,
+		DryRun:     e.DryRun,
+		StartedAt:  e.StartedAt,
+		FinishedAt: finishedAt,
+		LastTaskID: e.LastTaskID,
+		Tasks:      int32(e.Tasks),   // #nosec G115
+		Reminds:    int32(e.Reminds), // #nosec G115
+		Changed:    int32(e.Changed), // #nosec G115
+		Overlap:    int32(e.Overlap), // #nosec G115
+		Skipped:    int32(e.Skipped), // #nosec G115
+		Failed:     int32(e.Failed),  // #nosec G115
+		Changes:    changes,
+		Truncated:  e.Truncated,
+		Error:      e.Error,
+		Owner:      e.Owner,
+		LeaseUntil: e.LeaseUntil,
+	}
+}
@@ -0,0 +1,87 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+)
+
+func TestWidthBackfillRunModelRoundTripSuccess(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name string
+		run  *domain.WidthBackfillRun
+	}{
+		{
+			name: "running dry run with changes",
+			run: &domain.WidthBackfillRun{
+				Running:    true,
+				DryRun:     true,
+				StartedAt:  now,
+				LastTaskID: "019a0000-0000-7000-8000-000000000001",
+				Tasks:      3,
+				Reminds:    7,
+				Changed:    2,
+				Overlap:    1,
+				Changes: []domain.WidthChange{
+					{RemindID: "r1", TaskID: "t1", Time: now.Add(time.Hour), OldWidth: 300, NewWidth: 420},
+				},
+				Owner:      "instance-1",
+				LeaseUntil: now.Add(time.Minute),
+			},
+		},
+		{
+			name: "finished run with an error",
+			run: &domain.WidthBackfillRun{
+				StartedAt:  now.Add(-time.Hour),
+				FinishedAt: now,
+				Tasks:      10,
+				Skipped:    1,
+				Failed:     2,
+				Error:      "db down",
+				Owner:      "instance-1",
+				LeaseUntil: now,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := repository.FromWidthBackfillRunEntity(tt.run)
+
+			assert.Equal(t, tt.run.FinishedAt.IsZero(), model.FinishedAt == nil)
+
+			value, err := model.Changes.Value()
+			require.NoError(t, err)
+
+			var changes repository.WidthChangesJSONB
+			require.NoError(t, changes.Scan(value))
+
+			model.Changes = changes
+
+			run := model.ToEntity()
+			assert.Equal(t, tt.run.Running, run.Running)
+			assert.Equal(t, tt.run.DryRun, run.DryRun)
+			assert.Equal(t, tt.run.FinishedAt, run.FinishedAt)
+			assert.Equal(t, tt.run.LastTaskID, run.LastTaskID)
+			assert.Equal(t, tt.run.Tasks, run.Tasks)
+			assert.Equal(t, tt.run.Skipped, run.Skipped)
+			assert.Equal(t, tt.run.Failed, run.Failed)
+			assert.Equal(t, tt.run.Error, run.Error)
+			assert.Equal(t, tt.run.Owner, run.Owner)
+			require.Len(t, run.Changes, len(tt.run.Changes))
+
+			for i, c := range tt.run.Changes {
+				assert.Equal(t, c.RemindID, run.Changes[i].RemindID)
+				assert.Equal(t, c.NewWidth, run.Changes[i].NewWidth)
+				assert.True(t, c.Time.Equal(run.Changes[i].Time))
+			}
+		})
+	}
+}
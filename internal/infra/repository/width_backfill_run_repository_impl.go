@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type widthBackfillRunRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWidthBackfillRunRepository(db *gorm.DB) domain.WidthBackfillRunRepository {
+	return &widthBackfillRunRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *widthBackfillRunRepositoryImpl) Find(ctx context.Context) (*domain.WidthBackfillRun, error) {
+	var m WidthBackfillRunModel
+
+	result := r.db.WithContext(ctx).Where("id = ?", widthBackfillRunID).Take(&m)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWidthBackfillRunNotFound
+		}
+
+		slog.Error("failed to find width backfill run",
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	return m.ToEntity(), nil
+}
+
+// Start only overwrites a stored run that is no longer running, so of two
+// concurrent starts one fails.
+func (r *widthBackfillRunRepositoryImpl) Start(ctx context.Context, run *domain.WidthBackfillRun) error {
+	slog.Debug("starting width backfill run",
+		"after_task_id", run.LastTaskID,
+		"dry_run", run.DryRun,
+		"owner", run.Owner,
+	)
+
+	result := r.db.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "id"}},
+		UpdateAll: true,
+		Where: clause.Where{Exprs: []clause.Expression{
+			clause.Expr{SQL: "width_backfill_runs.running = ?", Vars: []any{false}},
+		}},
+	}).Create(FromWidthBackfillRunEntity(run))
+	if result.Error != nil {
+		slog.Error("failed to start width backfill run",
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWidthBackfillRunActive
+	}
+
+	return nil
+}
+
+func (r *widthBackfillRunRepositoryImpl) Claim(
+	ctx context.Context,
+	owner string,
+	now, leaseUntil time.Time,
+) (*domain.WidthBackfillRun, error) {
+	var m WidthBackfillRunModel
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND running = ? AND (owner = ? OR lease_until < ?)", widthBackfillRunID, true, owner, now).
+			Take(&m)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		m.Owner = owner
+		m.LeaseUntil = leaseUntil
+
+		return tx.Model(&WidthBackfillRunModel{}).
+			Where("id = ?", widthBackfillRunID).
+			Updates(map[string]any{"owner": owner, "lease_until": leaseUntil}).Error
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWidthBackfillRunNotFound
+		}
+
+		slog.Error("failed to claim width backfill run",
+			"owner", owner,
+			"error", err,
+		)
+
+		return nil, err
+	}
+
+	return m.ToEntity(), nil
+}
+
+func (r *widthBackfillRunRepositoryImpl) Save(ctx context.Context, run *domain.WidthBackfillRun) error {
+	slog.Debug("saving width backfill run",
+		"last_task_id", run.LastTaskID,
+		"running", run.Running,
+		"owner", run.Owner,
+	)
+
+	result := r.db.WithContext(ctx).Model(&WidthBackfillRunModel{}).
+		Where("id = ? AND running = ? AND owner = ?", widthBackfillRunID, true, run.Owner).
+		Select("*").Omit("id").
+		Updates(FromWidthBackfillRunEntity(run))
+	if result.Error != nil {
+		slog.Error("failed to save width backfill run",
+			"owner", run.Owner,
+			"error", result.Error,
+		)
+
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		return domain.ErrWidthBackfillLeaseLost
+	}
+
+	return nil
+}
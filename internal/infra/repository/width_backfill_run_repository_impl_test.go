@@ -0,0 +1,123 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func TestWidthBackfillRunRepositorySuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewWidthBackfillRunRepository(testDB.DB)
+	ctx := context.Background()
+	now := time.Now().Truncate(time.Second)
+
+	_, err := repo.Find(ctx)
+	require.ErrorIs(t, err, domain.ErrWidthBackfillRunNotFound)
+
+	require.NoError(t, repo.Start(ctx, &domain.WidthBackfillRun{
+		Running:    true,
+		DryRun:     true,
+		StartedAt:  now,
+		Owner:      "instance-1",
+		LeaseUntil: now.Add(time.Minute),
+	}))
+
+	// The lease of instance-1 still holds.
+	_, err = repo.Claim(ctx, "instance-2", now, now.Add(time.Minute))
+	require.ErrorIs(t, err, domain.ErrWidthBackfillRunNotFound)
+
+	run, err := repo.Claim(ctx, "instance-1", now, now.Add(time.Minute))
+	require.NoError(t, err)
+
+	run.LastTaskID = "019a0000-0000-7000-8000-000000000001"
+	run.Tasks = 2
+	run.Changes = []domain.WidthChange{{RemindID: "r1", TaskID: "t1", Time: now, OldWidth: 300, NewWidth: 420}}
+	require.NoError(t, repo.Save(ctx, run))
+
+	// Once the lease expired another instance resumes from the stored cursor.
+	run, err = repo.Claim(ctx, "instance-2", now.Add(2*time.Minute), now.Add(3*time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, "instance-2", run.Owner)
+	assert.Equal(t, "019a0000-0000-7000-8000-000000000001", run.LastTaskID)
+	assert.Equal(t, 2, run.Tasks)
+	require.Len(t, run.Changes, 1)
+
+	run.Running = false
+	run.FinishedAt = now.Add(2 * time.Minute)
+	require.NoError(t, repo.Save(ctx, run))
+
+	stored, err := repo.Find(ctx)
+	require.NoError(t, err)
+	assert.False(t, stored.Running)
+	assert.WithinDuration(t, run.FinishedAt, stored.FinishedAt, time.Millisecond)
+
+	// A finished run is replaced by the next one.
+	require.NoError(t, repo.Start(ctx, &domain.WidthBackfillRun{
+		Running:    true,
+		StartedAt:  now.Add(time.Hour),
+		Owner:      "instance-2",
+		LeaseUntil: now.Add(time.Hour + time.Minute),
+	}))
+
+	stored, err = repo.Find(ctx)
+	require.NoError(t, err)
+	assert.True(t, stored.Running)
+	assert.Zero(t, stored.Tasks)
+	assert.Empty(t, stored.Changes)
+	assert.True(t, stored.FinishedAt.IsZero())
+}
+
+func TestWidthBackfillRunRepositoryError(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewWidthBackfillRunRepository(testDB.DB)
+	ctx := context.Background()
+	now := time.Now()
+
+	running := &domain.WidthBackfillRun{
+		Running:    true,
+		StartedAt:  now,
+		Owner:      "instance-1",
+		LeaseUntil: now.Add(time.Minute),
+	}
+	require.NoError(t, repo.Start(ctx, running))
+
+	t.Run("start while running", func(t *testing.T) {
+		err := repo.Start(ctx, &domain.WidthBackfillRun{
+			Running:    true,
+			StartedAt:  now,
+			Owner:      "instance-2",
+			LeaseUntil: now.Add(time.Minute),
+		})
+
+		assert.ErrorIs(t, err, domain.ErrWidthBackfillRunActive)
+	})
+
+	t.Run("save without the lease", func(t *testing.T) {
+		lost := *running
+		lost.Owner = "instance-2"
+
+		err := repo.Save(ctx, &lost)
+
+		assert.ErrorIs(t, err, domain.ErrWidthBackfillLeaseLost)
+	})
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type WindowStatsModel struct {
+	UserID     string    `gorm:"column:user_id;type:uuid;primaryKey"`
+	TaskType   string    `gorm:"column:task_type;type:varchar(255);primaryKey"`
+	Samples    int32     `gorm:"column:samples;type:integer;not null"`
+	OnTime     int32     `gorm:"column:on_time;type:integer;not null"`
+	Collided   int32     `gorm:"column:collided;type:integer;not null"`
+	ComputedAt time.Time `gorm:"column:computed_at;type:timestamptz;not null"`
+}
+
+func (WindowStatsModel) TableName() string {
+	return "window_stats"
+}
+
+func (m *WindowStatsModel) ToEntity() (*domain.WindowStats, error) {
+	userID, err := domain.UserIDFromString(m.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	taskType, err := domain.NewType(m.TaskType)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.ReconstituteWindowStats(
+		userID,
+		taskType,
+		int(m.Samples),
+		int(m.OnTime),
+		int(m.Collided),
+		m.ComputedAt,
+	), nil
+}
@@ -0,0 +1,68 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+)
+
+func createValidWindowStatsModel() *repository.WindowStatsModel {
+	return &repository.WindowStatsModel{
+		UserID:     uuid.Must(uuid.NewV7()).String(),
+		TaskType:   "near",
+		Samples:    20,
+		OnTime:     18,
+		Collided:   1,
+		ComputedAt: time.Now(),
+	}
+}
+
+func TestWindowStatsToEntitySuccess(t *testing.T) {
+	model := createValidWindowStatsModel()
+
+	stats, err := model.ToEntity()
+
+	require.NoError(t, err)
+	assert.Equal(t, model.UserID, stats.UserID().String())
+	assert.Equal(t, "near", string(stats.TaskType()))
+	assert.Equal(t, 20, stats.Samples())
+	assert.Equal(t, 18, stats.OnTime())
+	assert.Equal(t, 1, stats.Collided())
+	assert.Equal(t, model.ComputedAt, stats.ComputedAt())
+}
+
+func TestWindowStatsToEntityError(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(m *repository.WindowStatsModel)
+	}{
+		{
+			name: "invalid user id",
+			modify: func(m *repository.WindowStatsModel) {
+				m.UserID = "not-a-uuid"
+			},
+		},
+		{
+			name: "unknown task type",
+			modify: func(m *repository.WindowStatsModel) {
+				m.TaskType = "urgent"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			model := createValidWindowStatsModel()
+			tt.modify(model)
+
+			_, err := model.ToEntity()
+
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+)
+
+type windowStatsRepositoryImpl struct {
+	db *gorm.DB
+}
+
+func NewWindowStatsRepository(db *gorm.DB) domain.WindowStatsRepository {
+	return &windowStatsRepositoryImpl{
+		db: db,
+	}
+}
+
+// refreshWindowStatsSQL aggregates the reminds scheduled since @since whose
+// windows closed before @until. A remind is on time when it was acknowledged
+// before its window closed, and collided when its window overlapped the
+// window of another of the user's unpaused reminds; windows only touching do
+// not collide. Paused reminds were never delivered and are left out.
+const refreshWindowStatsSQL = `
+INSERT INTO window_stats (user_id, task_type, samples, on_time, collided, computed_at)
+SELECT
+	r.user_id,
+	r.task_type,
+	count(*),
+	count(*) FILTER (
+		WHERE r.acknowledged_at IS NOT NULL
+		AND r.acknowledged_at <= r.time + make_interval(secs => r.slide_window_width)
+	),
+	count(*) FILTER (WHERE EXISTS (
+		SELECT 1 FROM reminds o
+		WHERE o.user_id = r.user_id
+			AND o.id <> r.id
+			AND o.paused = false
+			AND o.time > r.time - make_interval(secs => r.slide_window_width + o.slide_window_width)
+			AND o.time < r.time + make_interval(secs => r.slide_window_width + o.slide_window_width)
+	)),
+	@until
+FROM reminds r
+WHERE r.time >= @since
+	AND r.time + make_interval(secs => r.slide_window_width) < @until
+	AND r.paused = false
+GROUP BY r.user_id, r.task_type
+ON CONFLICT (user_id, task_type) DO UPDATE SET
+	samples = EXCLUDED.samples,
+	on_time = EXCLUDED.on_time,
+	collided = EXCLUDED.collided,
+	computed_at = EXCLUDED.computed_at`
+
+func (r *windowStatsRepositoryImpl) Refresh(ctx context.Context, since, until time.Time) (int, error) {
+	slog.Debug("refreshing window stats",
+		"since", since,
+		"until", until,
+	)
+
+	var kept int64
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Exec(refreshWindowStatsSQL, map[string]any{"since": since, "until": until})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		kept = result.RowsAffected
+
+		// Pairs without reminds in the range were not touched above.
+		return tx.Where("computed_at < ?", until).Delete(&WindowStatsModel{}).Error
+	})
+	if err != nil {
+		slog.Error("failed to refresh window stats",
+			"since", since,
+			"until", until,
+			"error", err,
+		)
+
+		return 0, err
+	}
+
+	return int(kept), nil
+}
+
+func (r *windowStatsRepositoryImpl) Find(
+	ctx context.Context,
+	userID domain.UserID,
+	taskType domain.Type,
+) (*domain.WindowStats, error) {
+	var m WindowStatsModel
+
+	result := r.db.WithContext(ctx).
+		Where("user_id = ? AND task_type = ?", userID.String(), string(taskType)).
+		First(&m)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, domain.ErrWindowStatsNotFound
+		}
+
+		slog.Error("failed to find window stats",
+			"user_id", userID.String(),
+			"task_type", string(taskType),
+			"error", result.Error,
+		)
+
+		return nil, result.Error
+	}
+
+	return m.ToEntity()
+}
@@ -0,0 +1,104 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/domain"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/infra/repository"
+	"github.com/KasumiMercury/primind-remind-time-mgmt/internal/testutil"
+)
+
+func TestWindowStatsRefreshSuccess(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	remindRepo := repository.NewRemindRepository(testDB.DB)
+	statsRepo := repository.NewWindowStatsRepository(testDB.DB)
+	ctx := context.Background()
+
+	userID := createValidUserID(t)
+	devices := createValidDevices(t, 1)
+	until := time.Now().Truncate(time.Second)
+	since := until.Add(-24 * time.Hour)
+
+	save := func(remindTime time.Time, taskType domain.Type, width time.Duration, paused bool, ackAt *time.Time) {
+		require.NoError(t, remindRepo.Save(ctx, domain.Reconstitute(
+			domain.NewRemindID(),
+			remindTime,
+			userID,
+			devices,
+			createValidTaskID(t),
+			taskType,
+			false,
+			domain.MustSlideWindowWidth(width),
+			ackAt,
+			domain.EscalationPolicy{},
+			0,
+			paused,
+			domain.Payload{},
+			since,
+			since,
+		)))
+	}
+
+	base := until.Add(-time.Hour)
+	onTime := base.Add(time.Minute)
+	late := base.Add(30 * time.Minute)
+
+	save(base, domain.TypeNear, 2*time.Minute, false, &onTime)
+	save(base.Add(4*time.Minute), domain.TypeNear, 2*time.Minute, false, &late) // touches the first window
+	save(base.Add(7*time.Minute), domain.TypeNear, 2*time.Minute, true, nil)    // paused, overlaps the second
+	save(base.Add(25*time.Minute), domain.TypeNear, 2*time.Minute, false, nil)  // collides with the scheduled one
+	save(base.Add(28*time.Minute), domain.TypeScheduled, 2*time.Minute, false, &late)
+	save(until.Add(-time.Minute), domain.TypeNear, 2*time.Minute, false, nil) // window still open
+	save(since.Add(-time.Hour), domain.TypeNear, 2*time.Minute, false, nil)   // before the lookback
+
+	kept, err := statsRepo.Refresh(ctx, since, until)
+	require.NoError(t, err)
+	assert.Equal(t, 2, kept)
+
+	stats, err := statsRepo.Find(ctx, userID, domain.TypeNear)
+	require.NoError(t, err)
+	assert.Equal(t, 3, stats.Samples())
+	assert.Equal(t, 1, stats.OnTime())
+	assert.Equal(t, 1, stats.Collided())
+	assert.WithinDuration(t, until, stats.ComputedAt(), time.Millisecond)
+
+	stats, err = statsRepo.Find(ctx, userID, domain.TypeScheduled)
+	require.NoError(t, err)
+	assert.Equal(t, 1, stats.Samples())
+	assert.Equal(t, 1, stats.OnTime())
+	assert.Equal(t, 1, stats.Collided())
+
+	// A later refresh whose lookback no longer covers the reminds drops them.
+	kept, err = statsRepo.Refresh(ctx, until, until.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, 0, kept)
+
+	_, err = statsRepo.Find(ctx, userID, domain.TypeNear)
+	assert.ErrorIs(t, err, domain.ErrWindowStatsNotFound)
+}
+
+func TestWindowStatsFindNotFound(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping integration test in short mode")
+	}
+
+	testDB := testutil.SetupTestDB(t)
+	defer testDB.TeardownTestDB(t)
+
+	repo := repository.NewWindowStatsRepository(testDB.DB)
+
+	_, err := repo.Find(context.Background(), createValidUserID(t), domain.TypeNear)
+
+	assert.ErrorIs(t, err, domain.ErrWindowStatsNotFound)
+}
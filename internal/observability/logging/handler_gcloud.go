@@ -1,5 +1,3 @@
-//go:build gcloud
-
 package logging
 
 import (
@@ -1,12 +0,0 @@
-//go:build !gcloud
-
-package logging
-
-import (
-	"context"
-	"log/slog"
-)
-
-func gcpTraceAttrs(_ context.Context, _ string) []slog.Attr {
-	return nil
-}
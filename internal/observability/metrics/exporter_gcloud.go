@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"context"
+
+	mexporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// newGCloudReader exports metrics to Cloud Monitoring.
+func newGCloudReader(_ context.Context, cfg Config) (sdkmetric.Reader, error) {
+	exporter, err := mexporter.New(mexporter.WithProjectID(cfg.GCPProjectID))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkmetric.NewPeriodicReader(exporter), nil
+}
@@ -2,22 +2,96 @@ package metrics
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/metric"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
 )
 
+const (
+	ExporterOTLP   = "otlp"
+	ExporterGCloud = "gcloud"
+	ExporterNone   = "none"
+)
+
+var ErrUnknownExporter = errors.New("unknown metric exporter")
+
 type Config struct {
 	ServiceName    string
 	ServiceVersion string
 	Environment    string
+	Exporter       string // otlp (default), gcloud or none
+	GCPProjectID   string // used by the gcloud exporter
+}
+
+// ReaderFactory builds the metric reader for a named backend.
+type ReaderFactory func(ctx context.Context, cfg Config) (sdkmetric.Reader, error)
+
+var readers = map[string]ReaderFactory{
+	ExporterOTLP:   newOTLPReader,
+	ExporterGCloud: newGCloudReader,
+}
+
+// RegisterExporter adds or replaces an exporter backend. It must be called
+// during startup, before NewProvider.
+func RegisterExporter(name string, factory ReaderFactory) {
+	readers[name] = factory
 }
 
 type Provider struct {
 	mp *sdkmetric.MeterProvider
 }
 
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	if cfg.Exporter == "" {
+		cfg.Exporter = ExporterOTLP
+	}
+
+	if os.Getenv("OTEL_EXPORTER_DISABLED") == "true" || cfg.Exporter == ExporterNone {
+		return newNoopProvider(cfg), nil
+	}
+
+	factory, ok := readers[cfg.Exporter]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, cfg.Exporter)
+	}
+
+	reader, err := factory(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithResource(newResource(cfg)),
+	)
+
+	return &Provider{mp: mp}, nil
+}
+
+func newNoopProvider(cfg Config) *Provider {
+	// MeterProvider without any reader does not export metrics
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithResource(newResource(cfg)),
+	)
+
+	return &Provider{mp: mp}
+}
+
+func newResource(cfg Config) *resource.Resource {
+	return resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+		semconv.DeploymentEnvironmentName(cfg.Environment),
+	)
+}
+
 func (p *Provider) Shutdown(ctx context.Context) error {
 	if p.mp == nil {
 		return nil
@@ -0,0 +1,61 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const (
+	pubsubMeterName = "pubsub.publisher"
+)
+
+type PublishMetrics struct {
+	publishCounter  metric.Int64Counter
+	publishDuration metric.Float64Histogram
+}
+
+func NewPublishMetrics() (*PublishMetrics, error) {
+	meter := otel.Meter(pubsubMeterName)
+
+	publishCounter, err := meter.Int64Counter(
+		"pubsub_publish_total",
+		metric.WithDescription("Total number of event publish attempts per backend"),
+		metric.WithUnit("{message}"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	publishDuration, err := meter.Float64Histogram(
+		"pubsub_publish_duration_seconds",
+		metric.WithDescription("Event publish duration per backend in seconds"),
+		metric.WithUnit("s"),
+		metric.WithExplicitBucketBoundaries(
+			0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5,
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PublishMetrics{
+		publishCounter:  publishCounter,
+		publishDuration: publishDuration,
+	}, nil
+}
+
+// Record records one publish attempt. outcome is "success" or "failure".
+func (m *PublishMetrics) Record(ctx context.Context, backend, eventType, outcome string, duration time.Duration) {
+	attrs := []attribute.KeyValue{
+		attribute.String("backend", backend),
+		attribute.String("event_type", eventType),
+		attribute.String("outcome", outcome),
+	}
+
+	m.publishCounter.Add(ctx, 1, metric.WithAttributes(attrs...))
+	m.publishDuration.Record(ctx, duration.Seconds(), metric.WithAttributes(attrs...))
+}
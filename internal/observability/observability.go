@@ -12,11 +12,13 @@ import (
 )
 
 type Config struct {
-	ServiceInfo   logging.ServiceInfo
-	Environment   logging.Environment
-	GCPProjectID  string  // empty for non-GCP environments
-	SamplingRate  float64 // 1.0 = always sample
-	DefaultModule logging.Module
+	ServiceInfo    logging.ServiceInfo
+	Environment    logging.Environment
+	GCPProjectID   string  // empty for non-GCP environments
+	SamplingRate   float64 // 1.0 = always sample
+	DefaultModule  logging.Module
+	TraceExporter  string // otlp (default), gcloud or none
+	MetricExporter string // otlp (default), gcloud or none
 }
 
 type Resources struct {
@@ -38,6 +40,8 @@ func Init(ctx context.Context, cfg Config) (*Resources, error) {
 		ServiceVersion: cfg.ServiceInfo.Version,
 		Environment:    string(cfg.Environment),
 		SamplingRate:   cfg.SamplingRate,
+		Exporter:       cfg.TraceExporter,
+		GCPProjectID:   cfg.GCPProjectID,
 	})
 	if err != nil {
 		return nil, err
@@ -49,6 +53,8 @@ func Init(ctx context.Context, cfg Config) (*Resources, error) {
 		ServiceName:    cfg.ServiceInfo.Name,
 		ServiceVersion: cfg.ServiceInfo.Version,
 		Environment:    string(cfg.Environment),
+		Exporter:       cfg.MetricExporter,
+		GCPProjectID:   cfg.GCPProjectID,
 	})
 	if err != nil {
 		return nil, err
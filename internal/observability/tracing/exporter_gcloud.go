@@ -0,0 +1,13 @@
+package tracing
+
+import (
+	"context"
+
+	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// newGCloudExporter exports spans to Cloud Trace.
+func newGCloudExporter(_ context.Context, cfg Config) (sdktrace.SpanExporter, error) {
+	return texporter.New(texporter.WithProjectID(cfg.GCPProjectID))
+}
@@ -2,24 +2,110 @@ package tracing
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"os"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
 	"go.opentelemetry.io/otel/trace"
 )
 
+const (
+	ExporterOTLP   = "otlp"
+	ExporterGCloud = "gcloud"
+	ExporterNone   = "none"
+)
+
+var ErrUnknownExporter = errors.New("unknown trace exporter")
+
 type Config struct {
 	ServiceName    string
 	ServiceVersion string
 	Environment    string
 	SamplingRate   float64 // 1.0 = always sample, 0.0 = never sample
+	Exporter       string  // otlp (default), gcloud or none
+	GCPProjectID   string  // used by the gcloud exporter
+}
+
+// ExporterFactory builds the span exporter for a named backend.
+type ExporterFactory func(ctx context.Context, cfg Config) (sdktrace.SpanExporter, error)
+
+var exporters = map[string]ExporterFactory{
+	ExporterOTLP:   newOTLPExporter,
+	ExporterGCloud: newGCloudExporter,
+}
+
+// RegisterExporter adds or replaces an exporter backend. It must be called
+// during startup, before NewProvider.
+func RegisterExporter(name string, factory ExporterFactory) {
+	exporters[name] = factory
 }
 
 type Provider struct {
 	tp *sdktrace.TracerProvider
 }
 
+func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
+	if cfg.Exporter == "" {
+		cfg.Exporter = ExporterOTLP
+	}
+
+	if os.Getenv("OTEL_EXPORTER_DISABLED") == "true" || cfg.Exporter == ExporterNone {
+		return newNoopProvider(cfg), nil
+	}
+
+	factory, ok := exporters[cfg.Exporter]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownExporter, cfg.Exporter)
+	}
+
+	exporter, err := factory(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(newResource(cfg)),
+		sdktrace.WithSampler(newSampler(cfg.SamplingRate)),
+	)
+
+	return &Provider{tp: tp}, nil
+}
+
+func newNoopProvider(cfg Config) *Provider {
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithResource(newResource(cfg)),
+		sdktrace.WithSampler(sdktrace.NeverSample()),
+	)
+
+	return &Provider{tp: tp}
+}
+
+func newResource(cfg Config) *resource.Resource {
+	return resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+		semconv.ServiceVersion(cfg.ServiceVersion),
+		semconv.DeploymentEnvironmentName(cfg.Environment),
+	)
+}
+
+func newSampler(rate float64) sdktrace.Sampler {
+	switch {
+	case rate >= 1.0:
+		return sdktrace.AlwaysSample()
+	case rate <= 0.0:
+		return sdktrace.NeverSample()
+	default:
+		return sdktrace.TraceIDRatioBased(rate)
+	}
+}
+
 func (p *Provider) Shutdown(ctx context.Context) error {
 	if p.tp == nil {
 		return nil
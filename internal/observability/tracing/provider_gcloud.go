@@ -1,62 +0,0 @@
-//go:build gcloud
-
-package tracing
-
-import (
-	"context"
-	"os"
-
-	texporter "github.com/GoogleCloudPlatform/opentelemetry-operations-go/exporter/trace"
-	"go.opentelemetry.io/otel/sdk/resource"
-	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.38.0"
-)
-
-// NewProvider creates a TracerProvider with Cloud Trace exporter for GCP environments.
-func NewProvider(ctx context.Context, cfg Config) (*Provider, error) {
-	if os.Getenv("OTEL_EXPORTER_DISABLED") == "true" {
-		return newNoopProvider(cfg), nil
-	}
-
-	projectID := os.Getenv("GOOGLE_CLOUD_PROJECT")
-	if projectID == "" {
-		projectID = os.Getenv("GCLOUD_PROJECT_ID")
-	}
-
-	exporter, err := texporter.New(texporter.WithProjectID(projectID))
-	if err != nil {
-		return nil, err
-	}
-
-	res := resource.NewWithAttributes(
-		semconv.SchemaURL,
-		semconv.ServiceName(cfg.ServiceName),
-		semconv.ServiceVersion(cfg.ServiceVersion),
-		semconv.DeploymentEnvironmentName(cfg.Environment),
-	)
-
-	// Cloud Trace handles sampling, so we always sample on our end
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sdktrace.AlwaysSample()),
-	)
-
-	return &Provider{tp: tp}, nil
-}
-
-func newNoopProvider(cfg Config) *Provider {
-	res := resource.NewWithAttributes(
-		semconv.SchemaURL,
-		semconv.ServiceName(cfg.ServiceName),
-		semconv.ServiceVersion(cfg.ServiceVersion),
-		semconv.DeploymentEnvironmentName(cfg.Environment),
-	)
-
-	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithResource(res),
-		sdktrace.WithSampler(sdktrace.NeverSample()),
-	)
-
-	return &Provider{tp: tp}
-}
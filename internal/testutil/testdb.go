@@ -69,11 +69,17 @@ func (tdb *TestDB) TeardownTestDB(t *testing.T) {
 func (tdb *TestDB) CleanTable(t *testing.T) {
 	t.Helper()
 
-	if err := tdb.DB.Exec("TRUNCATE TABLE reminds").Error; err != nil {
+	if err := tdb.DB.Exec("TRUNCATE TABLE reminds, user_preferences, remind_templates, window_stats, width_backfill_runs").Error; err != nil {
 		t.Fatalf("failed to clean table: %v", err)
 	}
 }
 
 func runMigrations(db *gorm.DB) error {
-	return db.AutoMigrate(&repository.RemindModel{})
+	return db.AutoMigrate(
+		&repository.RemindModel{},
+		&repository.UserPreferencesModel{},
+		&repository.RemindTemplateModel{},
+		&repository.WindowStatsModel{},
+		&repository.WidthBackfillRunModel{},
+	)
 }
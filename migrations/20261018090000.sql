@@ -0,0 +1,9 @@
+-- Create "user_preferences" table
+CREATE TABLE "public"."user_preferences" (
+  "user_id" uuid NOT NULL,
+  "timezone" character varying(64) NOT NULL,
+  "quiet_hours" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id")
+);
@@ -0,0 +1,2 @@
+-- Modify "user_preferences" table
+ALTER TABLE "public"."user_preferences" ADD COLUMN "default_devices" jsonb NOT NULL DEFAULT '[]', ADD COLUMN "window_overrides" jsonb NOT NULL DEFAULT '{}', ADD COLUMN "paused" boolean NOT NULL DEFAULT false;
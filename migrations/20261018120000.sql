@@ -0,0 +1,2 @@
+-- Modify "reminds" table
+ALTER TABLE "public"."reminds" ADD COLUMN "escalation_after_seconds" integer NOT NULL DEFAULT 0, ADD COLUMN "escalation_steps" jsonb NOT NULL DEFAULT '[]', ADD COLUMN "escalation_level" integer NOT NULL DEFAULT 0;
@@ -0,0 +1,4 @@
+-- Modify "reminds" table
+ALTER TABLE "public"."reminds" ADD COLUMN "paused" boolean NOT NULL DEFAULT false;
+-- Modify "user_preferences" table
+ALTER TABLE "public"."user_preferences" ADD COLUMN "resume_at" timestamptz NULL;
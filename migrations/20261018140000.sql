@@ -0,0 +1,2 @@
+-- Modify "reminds" table
+ALTER TABLE "public"."reminds" ADD COLUMN "payload" jsonb NULL;
@@ -0,0 +1,8 @@
+-- Create "remind_templates" table
+CREATE TABLE "public"."remind_templates" (
+  "name" character varying(64) NOT NULL,
+  "offsets" jsonb NOT NULL,
+  "created_at" timestamptz NOT NULL,
+  "updated_at" timestamptz NOT NULL,
+  PRIMARY KEY ("name")
+);
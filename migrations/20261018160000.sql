@@ -0,0 +1,10 @@
+-- Create "window_stats" table
+CREATE TABLE "public"."window_stats" (
+  "user_id" uuid NOT NULL,
+  "task_type" character varying(255) NOT NULL,
+  "samples" integer NOT NULL,
+  "on_time" integer NOT NULL,
+  "collided" integer NOT NULL,
+  "computed_at" timestamptz NOT NULL,
+  PRIMARY KEY ("user_id", "task_type")
+);
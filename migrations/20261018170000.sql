@@ -0,0 +1,6 @@
+-- Modify "reminds" table
+ALTER TABLE "public"."reminds" ADD COLUMN "escalate_at" timestamptz NULL;
+-- Backfill "escalate_at" of reminds with escalation steps left
+UPDATE "public"."reminds" SET "escalate_at" = "time" + make_interval(secs => "escalation_after_seconds" * ("escalation_level" + 1)) WHERE "acknowledged_at" IS NULL AND "escalation_level" < jsonb_array_length("escalation_steps");
+-- Create index "idx_reminds_escalate_at" to table: "reminds"
+CREATE INDEX "idx_reminds_escalate_at" ON "public"."reminds" ("escalate_at");
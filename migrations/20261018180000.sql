@@ -0,0 +1,21 @@
+-- Create "width_backfill_runs" table
+CREATE TABLE "public"."width_backfill_runs" (
+  "id" smallint NOT NULL,
+  "running" boolean NOT NULL,
+  "dry_run" boolean NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "finished_at" timestamptz NULL,
+  "last_task_id" character varying(36) NOT NULL,
+  "tasks" integer NOT NULL,
+  "reminds" integer NOT NULL,
+  "changed" integer NOT NULL,
+  "overlap" integer NOT NULL,
+  "skipped" integer NOT NULL,
+  "failed" integer NOT NULL,
+  "changes" jsonb NOT NULL DEFAULT '[]',
+  "truncated" boolean NOT NULL,
+  "error" text NOT NULL,
+  "owner" character varying(64) NOT NULL,
+  "lease_until" timestamptz NOT NULL,
+  PRIMARY KEY ("id")
+);